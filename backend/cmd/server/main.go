@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
@@ -11,46 +11,82 @@ import (
 	"time"
 
 	"github.com/rs/cors"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	"wildberries/internal/app"
 	"wildberries/internal/config"
+	"wildberries/internal/logging"
+	"wildberries/internal/metrics"
+	"wildberries/internal/tracing"
 )
 
 func main() {
 	cfg := config.Load()
+	logging.Setup(cfg.LogLevel, cfg.LogFormat)
 	ctx := context.Background()
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		Exporter:    cfg.TracingExporter,
+		ServiceName: cfg.TracingService,
+		SampleRatio: cfg.TracingRatio,
+	})
+	if err != nil {
+		fatal("tracing.Setup", err)
+	}
+	defer shutdownTracing(context.Background())
+
 	application, err := app.New(ctx, cfg)
 	if err != nil {
-		log.Fatalf("app.New: %v", err)
+		fatal("app.New", err)
 	}
-	defer application.Shutdown(context.Background())
 
 	// Setup gRPC gateway handlers
 	if err := application.SetupGatewayHandlers(ctx); err != nil {
-		log.Fatalf("Failed to setup gateway handlers: %v", err)
+		fatal("failed to setup gateway handlers", err)
 	}
 
 	// Start gRPC server
 	go func() {
-		log.Printf("gRPC server listening on port %d", cfg.GRPCPort)
+		slog.Info("gRPC server listening", slog.Int("port", cfg.GRPCPort))
 		if err := application.StartGRPCServer(ctx); err != nil {
-			log.Fatalf("gRPC server error: %v", err)
+			fatal("gRPC server error", err)
 		}
 	}()
 
 	// Start HTTP server with gRPC gateway
+	corsHandler := cors.AllowAll().Handler(application)
+	handler := otelhttp.NewHandler(
+		logging.HTTPMiddleware(metrics.HTTPMiddleware(corsHandler)),
+		"http",
+		// metrics.HTTPMiddleware renames the span after the matched route
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method
+		}),
+	)
+	server := &http.Server{
+		Addr:         ":" + strconv.Itoa(cfg.HTTPPort),
+		Handler:      handler,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
 	go func() {
-		corsHandler := cors.AllowAll().Handler(application)
-		handler := withHTTPLogging(corsHandler)
-		log.Printf("HTTP server listening on port %d", cfg.HTTPPort)
-		server := &http.Server{
-			Addr:         ":" + strconv.Itoa(cfg.HTTPPort),
-			Handler:      handler,
-			ReadTimeout:  5 * time.Second,
-			WriteTimeout: 10 * time.Second,
-		}
+		slog.Info("HTTP server listening", slog.Int("port", cfg.HTTPPort))
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("HTTP server error: %v", err)
+			fatal("HTTP server error", err)
+		}
+	}()
+
+	// Metrics on their own port, reachable by Prometheus but not exposed with the public API
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	metricsServer := &http.Server{
+		Addr:        ":" + strconv.Itoa(cfg.MetricsPort),
+		Handler:     metricsMux,
+		ReadTimeout: 5 * time.Second,
+	}
+	go func() {
+		slog.Info("metrics server listening", slog.Int("port", cfg.MetricsPort))
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fatal("metrics server error", err)
 		}
 	}()
 
@@ -58,48 +94,25 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
-	log.Println("Shutting down...")
-}
-
-type responseLogger struct {
-	http.ResponseWriter
-	statusCode int
-	bytes      int
-}
+	slog.Info("shutting down", slog.Duration("timeout", cfg.ShutdownTimeout), slog.Duration("drain_delay", cfg.DrainDelay))
 
-func newResponseLogger(w http.ResponseWriter) *responseLogger {
-	return &responseLogger{
-		ResponseWriter: w,
-		statusCode:     http.StatusOK,
+	// Stop reporting ready and keep serving while load balancers notice it, then drain HTTP
+	// (gateway calls go through gRPC), then gRPC, workers and the pool
+	application.BeginShutdown()
+	time.Sleep(cfg.DrainDelay)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("HTTP graceful shutdown", slog.String("error", err.Error()))
 	}
+	application.Shutdown(shutdownCtx)
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("metrics server shutdown", slog.String("error", err.Error()))
+	}
+	slog.Info("stopped")
 }
 
-func (l *responseLogger) WriteHeader(code int) {
-	l.statusCode = code
-	l.ResponseWriter.WriteHeader(code)
-}
-
-func (l *responseLogger) Write(data []byte) (int, error) {
-	n, err := l.ResponseWriter.Write(data)
-	l.bytes += n
-	return n, err
-}
-
-func withHTTPLogging(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		startedAt := time.Now()
-		logger := newResponseLogger(w)
-		next.ServeHTTP(logger, r)
-
-		duration := time.Since(startedAt)
-		log.Printf(
-			"http request method=%s path=%s status=%d bytes=%d duration=%s remote=%s",
-			r.Method,
-			r.URL.RequestURI(),
-			logger.statusCode,
-			logger.bytes,
-			duration,
-			r.RemoteAddr,
-		)
-	})
+func fatal(msg string, err error) {
+	slog.Error(msg, slog.String("error", err.Error()))
+	os.Exit(1)
 }
@@ -0,0 +1,117 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+	"wildberries/internal/service/ai"
+	desc "wildberries/pkg/admin"
+)
+
+type adminAIService interface {
+	ListGenerations(ctx context.Context, filter repository.AIGenerationLogFilter) ([]*entity.AIGenerationLog, int64, error)
+	DailyUsage(ctx context.Context, from, to time.Time) ([]*entity.AIUsageDay, error)
+	GeneratePromotionDraft(ctx context.Context, theme string, segmentCount, slotCount int) (*entity.PromotionDraft, error)
+	SubmitJob(ctx context.Context, kind string, req ai.JobRequest) (*entity.AIGenerationJob, error)
+	PromptTemplates(ctx context.Context) ([]*entity.PromptTemplate, error)
+	PromptTemplateVersions(ctx context.Context, name string) ([]*entity.PromptTemplateVersion, error)
+	CreatePromptTemplateVersion(ctx context.Context, name, body, comment string, activate bool) (*entity.PromptTemplateVersion, error)
+	ActivatePromptTemplate(ctx context.Context, name string, version int) error
+	PromotionPromptPins(ctx context.Context, promotionID int64) ([]*entity.PromptPin, error)
+	PinPromotionPrompt(ctx context.Context, promotionID int64, name string, version int) error
+	UnpinPromotionPrompt(ctx context.Context, promotionID int64, name string) error
+}
+
+// ListAIGenerations returns the AI call audit log
+func (s *Service) ListAIGenerations(ctx context.Context, req *desc.ListAIGenerationsRequest) (*desc.ListAIGenerationsResponse, error) {
+	period, err := parsePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	page, perPage := int(req.Page), int(req.PerPage)
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = 50
+	}
+	items, total, err := s.aiService.ListGenerations(ctx, repository.AIGenerationLogFilter{
+		Operation: req.Operation,
+		Provider:  req.Provider,
+		Status:    req.Status,
+		From:      period.From,
+		To:        period.To,
+		Limit:     perPage,
+		Offset:    (page - 1) * perPage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &desc.ListAIGenerationsResponse{
+		Items:   make([]*desc.AIGeneration, 0, len(items)),
+		Total:   total,
+		Page:    int32(page),
+		PerPage: int32(perPage),
+	}
+	for _, it := range items {
+		resp.Items = append(resp.Items, &desc.AIGeneration{
+			Id:               it.ID,
+			Operation:        it.Operation,
+			Provider:         it.Provider,
+			Model:            it.Model,
+			Status:           it.Status,
+			LatencyMs:        it.Latency.Milliseconds(),
+			PromptTokens:     it.PromptTokens,
+			CompletionTokens: it.CompletionTokens,
+			TotalTokens:      it.TotalTokens,
+			CostUsd:          it.CostUSD,
+			Prompt:           it.Prompt,
+			Response:         it.Response,
+			Error:            it.Error,
+			CreatedAt:        it.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+// GetAIUsage returns AI tokens and cost per day
+func (s *Service) GetAIUsage(ctx context.Context, req *desc.GetAIUsageRequest) (*desc.GetAIUsageResponse, error) {
+	period, err := parsePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	days, err := s.aiService.DailyUsage(ctx, period.From, period.To)
+	if errors.Is(err, ai.ErrInvalidUsagePeriod) {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &desc.GetAIUsageResponse{
+		Days: make([]*desc.AIUsageDay, 0, len(days)),
+	}
+	for _, d := range days {
+		resp.Days = append(resp.Days, &desc.AIUsageDay{
+			Day:              d.Day.Format("2006-01-02"),
+			Provider:         d.Provider,
+			Model:            d.Model,
+			Calls:            d.Calls,
+			FailedCalls:      d.FailedCalls,
+			CachedCalls:      d.CachedCalls,
+			PromptTokens:     d.PromptTokens,
+			CompletionTokens: d.CompletionTokens,
+			TotalTokens:      d.TotalTokens,
+			CostUsd:          d.CostUSD,
+			AvgLatencyMs:     float64(d.AvgLatency) / float64(time.Millisecond),
+		})
+		resp.TotalTokens += d.TotalTokens
+		resp.TotalCostUsd += d.CostUSD
+	}
+	return resp, nil
+}
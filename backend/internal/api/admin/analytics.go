@@ -0,0 +1,179 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+	"wildberries/internal/service/analytics"
+	desc "wildberries/pkg/admin"
+)
+
+type analyticsService interface {
+	GetPromotionFunnel(ctx context.Context, promotionID int64, period analytics.Period) (*entity.PromotionFunnel, error)
+	GetSegmentDistribution(ctx context.Context, promotionID int64, period analytics.Period) ([]*entity.SegmentShare, error)
+	GetEventTimeSeries(ctx context.Context, promotionID int64, period analytics.Period, bucket string, eventTypes []entity.BuyerEventType) ([]*entity.TimeSeriesPoint, error)
+	EstimateSegmentPopulation(ctx context.Context, promotionID int64) (map[int64]*entity.SegmentPopulation, error)
+	ComparePromotions(ctx context.Context, promotionIDs []int64) ([]*entity.PromotionCohort, error)
+}
+
+// GetPromotionFunnel returns the buyer funnel of a promotion
+func (s *Service) GetPromotionFunnel(ctx context.Context, req *desc.GetPromotionFunnelRequest) (*desc.GetPromotionFunnelResponse, error) {
+	period, err := parsePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	funnel, err := s.analyticsService.GetPromotionFunnel(ctx, req.PromotionId, period)
+	if err != nil {
+		return nil, mapAnalyticsError(err)
+	}
+	resp := &desc.GetPromotionFunnelResponse{
+		PromotionId: funnel.PromotionID,
+		DateFrom:    funnel.From.UTC().Format(time.RFC3339),
+		DateTo:      funnel.To.UTC().Format(time.RFC3339),
+		Total:       toFunnelStagesPB(funnel.Total),
+		Segments:    make([]*desc.SegmentFunnel, 0, len(funnel.Segments)),
+	}
+	for _, seg := range funnel.Segments {
+		resp.Segments = append(resp.Segments, &desc.SegmentFunnel{
+			SegmentId:   seg.SegmentID,
+			SegmentName: seg.SegmentName,
+			Stages:      toFunnelStagesPB(seg.Stages),
+		})
+	}
+	return resp, nil
+}
+
+// GetSegmentDistribution returns how quiz outcomes split across segments
+func (s *Service) GetSegmentDistribution(ctx context.Context, req *desc.GetSegmentDistributionRequest) (*desc.GetSegmentDistributionResponse, error) {
+	period, err := parsePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := s.analyticsService.GetSegmentDistribution(ctx, req.PromotionId, period)
+	if err != nil {
+		return nil, mapAnalyticsError(err)
+	}
+	resp := &desc.GetSegmentDistributionResponse{
+		Segments: make([]*desc.SegmentShare, 0, len(shares)),
+	}
+	for _, sh := range shares {
+		resp.Segments = append(resp.Segments, &desc.SegmentShare{
+			SegmentId:   sh.SegmentID,
+			SegmentName: sh.SegmentName,
+			Sessions:    sh.Sessions,
+			Share:       sh.Share,
+		})
+	}
+	return resp, nil
+}
+
+// GetEventTimeSeries returns buyer event counts per time bucket
+func (s *Service) GetEventTimeSeries(ctx context.Context, req *desc.GetEventTimeSeriesRequest) (*desc.GetEventTimeSeriesResponse, error) {
+	period, err := parsePeriod(req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes := make([]entity.BuyerEventType, 0, len(req.EventTypes))
+	for _, t := range req.EventTypes {
+		eventTypes = append(eventTypes, entity.BuyerEventType(t))
+	}
+	points, err := s.analyticsService.GetEventTimeSeries(ctx, req.PromotionId, period, req.Bucket, eventTypes)
+	if err != nil {
+		return nil, mapAnalyticsError(err)
+	}
+	resp := &desc.GetEventTimeSeriesResponse{
+		Points: make([]*desc.TimeSeriesPoint, 0, len(points)),
+	}
+	for _, p := range points {
+		resp.Points = append(resp.Points, &desc.TimeSeriesPoint{
+			BucketStart: p.BucketStart.UTC().Format(time.RFC3339),
+			EventType:   string(p.EventType),
+			Count:       p.Count,
+		})
+	}
+	return resp, nil
+}
+
+// ComparePromotions returns completed promotions side by side
+func (s *Service) ComparePromotions(ctx context.Context, req *desc.ComparePromotionsRequest) (*desc.ComparePromotionsResponse, error) {
+	cohorts, err := s.analyticsService.ComparePromotions(ctx, req.PromotionIds)
+	if err != nil {
+		return nil, mapAnalyticsError(err)
+	}
+	resp := &desc.ComparePromotionsResponse{
+		Promotions: make([]*desc.PromotionCohort, 0, len(cohorts)),
+	}
+	for _, c := range cohorts {
+		resp.Promotions = append(resp.Promotions, &desc.PromotionCohort{
+			PromotionId:          c.PromotionID,
+			Name:                 c.Name,
+			Theme:                c.Theme,
+			IdentificationMode:   c.IdentificationMode.APIString(),
+			PricingModel:         c.PricingModel.APIString(),
+			DateFrom:             c.DateFrom,
+			DateTo:               c.DateTo,
+			TotalSlots:           c.TotalSlots,
+			FilledSlots:          c.FilledSlots,
+			FillRate:             c.FillRate,
+			AvgClearingPrice:     c.AvgClearingPrice,
+			Bets:                 c.Bets,
+			Bidders:              c.Bidders,
+			ApprovedApplications: c.ApprovedApplications,
+			RejectedApplications: c.RejectedApplications,
+			RejectionRate:        c.RejectionRate,
+			ViewSessions:         c.ViewSessions,
+			ResolvedSessions:     c.ResolvedSessions,
+			ClickSessions:        c.ClickSessions,
+			QuizCompletionRate:   c.QuizCompletionRate,
+			BuyerConversion:      c.BuyerConversion,
+		})
+	}
+	return resp, nil
+}
+
+func parsePeriod(from, to string) (analytics.Period, error) {
+	var period analytics.Period
+	var err error
+	if from != "" {
+		if period.From, err = time.Parse(time.RFC3339, from); err != nil {
+			return period, grpcstatus.Error(codes.InvalidArgument, "invalid date_from")
+		}
+	}
+	if to != "" {
+		if period.To, err = time.Parse(time.RFC3339, to); err != nil {
+			return period, grpcstatus.Error(codes.InvalidArgument, "invalid date_to")
+		}
+	}
+	return period, nil
+}
+
+func toFunnelStagesPB(st entity.FunnelStages) *desc.FunnelStages {
+	return &desc.FunnelStages{
+		Views:           st.Views,
+		QuizStarts:      st.QuizStarts,
+		Completions:     st.Completions,
+		SegmentResolved: st.SegmentResolved,
+		ProductClicks:   st.ProductClicks,
+	}
+}
+
+func mapAnalyticsError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return grpcstatus.Error(codes.NotFound, "promotion not found")
+	}
+	if errors.Is(err, analytics.ErrPromotionNotCompleted) {
+		return grpcstatus.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, analytics.ErrInvalidPeriod) || errors.Is(err, repository.ErrInvalidFilter) ||
+		errors.Is(err, analytics.ErrTooManyPromotions) {
+		return grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/service/ai"
+	desc "wildberries/pkg/admin"
+)
+
+// defaultDraftDuration — длительность акции-черновика, если даты не заданы
+const defaultDraftDuration = 7 * 24 * time.Hour
+
+// GeneratePromotionDraft generates a promotion from a theme and stores it as NOT_READY;
+// with async the generation runs as a promotion_draft job
+func (s *Service) GeneratePromotionDraft(ctx context.Context, req *desc.GeneratePromotionDraftRequest) (*desc.GeneratePromotionDraftResponse, error) {
+	slog.InfoContext(ctx, "generate promotion draft", slog.String("theme", req.Theme))
+
+	pricingModel := entity.PricingModelFixed
+	if req.PricingModel != "" {
+		pricingModel = entity.ParsePricingModel(req.PricingModel)
+		if pricingModel == entity.PricingModelUnspecified {
+			return nil, grpcstatus.Error(codes.InvalidArgument, "invalid pricing_model")
+		}
+	}
+	dateFrom, dateTo, err := draftPeriod(req.DateFrom, req.DateTo, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	promo := &entity.Promotion{
+		DateFrom:           dateFrom,
+		DateTo:             dateTo,
+		IdentificationMode: entity.IdentificationModeQuestions,
+		PricingModel:       pricingModel,
+		SlotCount:          int(req.SlotCount),
+		MinDiscount:        int(req.MinDiscount),
+		MaxDiscount:        int(req.MaxDiscount),
+	}
+	if req.Async {
+		job, err := s.aiService.SubmitJob(ctx, ai.JobKindPromotionDraft, ai.JobRequest{
+			Theme:     req.Theme,
+			Limit:     int(req.SegmentCount),
+			SlotCount: int(req.SlotCount),
+			Promotion: promo,
+		})
+		switch {
+		case errors.Is(err, ai.ErrThemeRequired):
+			return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, ai.ErrJobsDisabled):
+			return nil, grpcstatus.Error(codes.Unavailable, err.Error())
+		case err != nil:
+			return nil, err
+		}
+		return &desc.GeneratePromotionDraftResponse{JobId: job.ID}, nil
+	}
+
+	draft, err := s.aiService.GeneratePromotionDraft(ctx, req.Theme, int(req.SegmentCount), int(req.SlotCount))
+	switch {
+	case errors.Is(err, ai.ErrThemeRequired):
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ai.ErrInconsistentDraft), errors.Is(err, ai.ErrNoCategorySupply):
+		return nil, grpcstatus.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	promo.Name = draft.Name
+	promo.Description = draft.Description
+	promo.Theme = draft.Theme
+	id, segmentIDs, err := s.promotionService.CreatePromotionDraft(ctx, promo, draft)
+	switch {
+	case errors.Is(err, entity.ErrAmbiguousSegmentName):
+		return nil, grpcstatus.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &desc.GeneratePromotionDraftResponse{
+		Id:          id,
+		Status:      entity.PromotionStatusNotReady.String(),
+		Name:        draft.Name,
+		Description: draft.Description,
+		Theme:       draft.Theme,
+		Segments:    make([]*desc.SegmentWithOrder, 0, len(segmentIDs)),
+	}
+	for i, segID := range segmentIDs {
+		resp.Segments = append(resp.Segments, &desc.SegmentWithOrder{
+			Id:           segID,
+			Name:         draft.Segments[i].Name,
+			CategoryName: draft.Segments[i].CategoryName,
+			OrderIndex:   int32(i),
+		})
+	}
+	pollData, err := s.promotionService.GetPromotionPoll(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if pollData != nil {
+		resp.Poll = promotionPollToDesc(pollData)
+	}
+	return resp, nil
+}
+
+// draftPeriod validates RFC3339 dates; by default the promotion starts tomorrow and lasts a week.
+func draftPeriod(from, to string, now time.Time) (string, string, error) {
+	start := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	if from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return "", "", grpcstatus.Error(codes.InvalidArgument, "date_from must be RFC3339")
+		}
+		start = t
+	}
+	end := start.Add(defaultDraftDuration)
+	if to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return "", "", grpcstatus.Error(codes.InvalidArgument, "date_to must be RFC3339")
+		}
+		end = t
+	}
+	if !end.After(start) {
+		return "", "", grpcstatus.Error(codes.InvalidArgument, "date_to must be after date_from")
+	}
+	return start.Format(time.RFC3339), end.Format(time.RFC3339), nil
+}
@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+	"wildberries/internal/service/ai"
+	desc "wildberries/pkg/admin"
+)
+
+// ListPromptTemplates returns editable AI prompts
+func (s *Service) ListPromptTemplates(ctx context.Context, _ *desc.ListPromptTemplatesRequest) (*desc.ListPromptTemplatesResponse, error) {
+	templates, err := s.aiService.PromptTemplates(ctx)
+	if err != nil {
+		return nil, promptError(err)
+	}
+	resp := &desc.ListPromptTemplatesResponse{Templates: make([]*desc.PromptTemplate, 0, len(templates))}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, &desc.PromptTemplate{
+			Name:          t.Name,
+			Variables:     t.Variables,
+			ActiveVersion: int32(t.ActiveVersion),
+			LatestVersion: int32(t.LatestVersion),
+		})
+	}
+	return resp, nil
+}
+
+// ListPromptTemplateVersions returns the version history of a prompt
+func (s *Service) ListPromptTemplateVersions(ctx context.Context, req *desc.ListPromptTemplateVersionsRequest) (*desc.ListPromptTemplateVersionsResponse, error) {
+	versions, err := s.aiService.PromptTemplateVersions(ctx, req.Name)
+	if err != nil {
+		return nil, promptError(err)
+	}
+	resp := &desc.ListPromptTemplateVersionsResponse{Versions: make([]*desc.PromptTemplateVersion, 0, len(versions))}
+	for _, v := range versions {
+		resp.Versions = append(resp.Versions, promptVersionToDesc(v))
+	}
+	return resp, nil
+}
+
+// CreatePromptTemplateVersion stores a new version of a prompt
+func (s *Service) CreatePromptTemplateVersion(ctx context.Context, req *desc.CreatePromptTemplateVersionRequest) (*desc.CreatePromptTemplateVersionResponse, error) {
+	version, err := s.aiService.CreatePromptTemplateVersion(ctx, req.Name, req.Body, req.Comment, req.Activate)
+	if err != nil {
+		return nil, promptError(err)
+	}
+	return &desc.CreatePromptTemplateVersionResponse{Version: promptVersionToDesc(version)}, nil
+}
+
+// ActivatePromptTemplate switches generations to a prompt version
+func (s *Service) ActivatePromptTemplate(ctx context.Context, req *desc.ActivatePromptTemplateRequest) (*desc.ActivatePromptTemplateResponse, error) {
+	if err := s.aiService.ActivatePromptTemplate(ctx, req.Name, int(req.Version)); err != nil {
+		return nil, promptError(err)
+	}
+	return &desc.ActivatePromptTemplateResponse{Success: true}, nil
+}
+
+// ListPromotionPromptPins returns prompt versions pinned for a promotion
+func (s *Service) ListPromotionPromptPins(ctx context.Context, req *desc.ListPromotionPromptPinsRequest) (*desc.ListPromotionPromptPinsResponse, error) {
+	pins, err := s.aiService.PromotionPromptPins(ctx, req.PromotionId)
+	if err != nil {
+		return nil, promptError(err)
+	}
+	resp := &desc.ListPromotionPromptPinsResponse{Pins: make([]*desc.PromotionPromptPin, 0, len(pins))}
+	for _, p := range pins {
+		resp.Pins = append(resp.Pins, &desc.PromotionPromptPin{
+			Name:      p.Name,
+			Version:   int32(p.Version),
+			CreatedAt: p.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+// PinPromotionPrompt pins a prompt version for a promotion
+func (s *Service) PinPromotionPrompt(ctx context.Context, req *desc.PinPromotionPromptRequest) (*desc.PinPromotionPromptResponse, error) {
+	if err := s.aiService.PinPromotionPrompt(ctx, req.PromotionId, req.Name, int(req.Version)); err != nil {
+		return nil, promptError(err)
+	}
+	return &desc.PinPromotionPromptResponse{Success: true}, nil
+}
+
+// UnpinPromotionPrompt returns a promotion to the active prompt version
+func (s *Service) UnpinPromotionPrompt(ctx context.Context, req *desc.UnpinPromotionPromptRequest) (*desc.UnpinPromotionPromptResponse, error) {
+	if err := s.aiService.UnpinPromotionPrompt(ctx, req.PromotionId, req.Name); err != nil {
+		return nil, promptError(err)
+	}
+	return &desc.UnpinPromotionPromptResponse{Success: true}, nil
+}
+
+func promptError(err error) error {
+	switch {
+	case errors.Is(err, ai.ErrUnknownPrompt):
+		return grpcstatus.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrNotFound):
+		return grpcstatus.Error(codes.NotFound, "promotion or prompt version not found")
+	case errors.Is(err, ai.ErrInvalidPromptTemplate):
+		return grpcstatus.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ai.ErrPromptsDisabled):
+		return grpcstatus.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+func promptVersionToDesc(v *entity.PromptTemplateVersion) *desc.PromptTemplateVersion {
+	out := &desc.PromptTemplateVersion{
+		Name:    v.Name,
+		Version: int32(v.Version),
+		Body:    v.Body,
+		Comment: v.Comment,
+		Active:  v.Active,
+	}
+	if !v.CreatedAt.IsZero() {
+		out.CreatedAt = v.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+	desc "wildberries/pkg/admin"
+)
+
+// ListSegmentTexts returns the text history of a segment
+func (s *Service) ListSegmentTexts(ctx context.Context, req *desc.ListSegmentTextsRequest) (*desc.ListSegmentTextsResponse, error) {
+	texts, err := s.promotionService.ListSegmentTexts(ctx, req.PromotionId, req.SegmentId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, grpcstatus.Error(codes.NotFound, "segment not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &desc.ListSegmentTextsResponse{Versions: make([]*desc.SegmentTextVersion, 0, len(texts))}
+	for _, t := range texts {
+		resp.Versions = append(resp.Versions, segmentTextToDesc(t))
+	}
+	return resp, nil
+}
+
+// RevertSegmentText makes an earlier segment text current again
+func (s *Service) RevertSegmentText(ctx context.Context, req *desc.RevertSegmentTextRequest) (*desc.RevertSegmentTextResponse, error) {
+	text, err := s.promotionService.RevertSegmentText(ctx, req.PromotionId, req.SegmentId, int(req.Version))
+	if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, repository.ErrNotFound) {
+		return nil, grpcstatus.Error(codes.NotFound, "segment or text version not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &desc.RevertSegmentTextResponse{Current: segmentTextToDesc(text)}, nil
+}
+
+func segmentTextToDesc(t *entity.SegmentText) *desc.SegmentTextVersion {
+	return &desc.SegmentTextVersion{
+		Version:   int32(t.Version),
+		Text:      t.Text,
+		Source:    t.Source,
+		CreatedAt: t.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
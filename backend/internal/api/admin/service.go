@@ -4,8 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"log"
+	"log/slog"
 	"sort"
+	"wildberries/internal/service/buyer"
 	"wildberries/internal/service/seller"
 
 	"github.com/jackc/pgx/v5"
@@ -22,27 +23,40 @@ type sellerService interface {
 	GetSegmentSlotsMarket(ctx context.Context, actionID, segmentID int64) (*seller.SegmentSlotsMarket, error)
 }
 
+type buyerService interface {
+	SimulateIdentification(ctx context.Context, promotionID int64, optionIDs []int64) (*buyer.IdentificationSimulation, error)
+}
+
 // Service handles admin API requests
 type Service struct {
 	promotionService *promotion.Service
 	sellerService    sellerService
+	buyerService     buyerService
+	analyticsService analyticsService
+	aiService        adminAIService
 	desc.UnimplementedModerationServiceServer
 	desc.UnimplementedPollAdminServiceServer
 	desc.UnimplementedPromotionAdminServiceServer
 	desc.UnimplementedSegmentAdminServiceServer
+	desc.UnimplementedPromotionAnalyticsServiceServer
+	desc.UnimplementedAIAuditServiceServer
+	desc.UnimplementedPromptTemplateServiceServer
 }
 
 // New creates a new admin service
-func New(promotionService *promotion.Service, sellerService sellerService) *Service {
+func New(promotionService *promotion.Service, sellerService sellerService, buyerService buyerService, analyticsService analyticsService, aiService adminAIService) *Service {
 	return &Service{
 		promotionService: promotionService,
 		sellerService:    sellerService,
+		buyerService:     buyerService,
+		analyticsService: analyticsService,
+		aiService:        aiService,
 	}
 }
 
 // CreatePromotion creates a new promotion
 func (s *Service) CreatePromotion(ctx context.Context, req *desc.CreatePromotionRequest) (*desc.CreatePromotionResponse, error) {
-	log.Println("create promotion")
+	slog.InfoContext(ctx, "create promotion", slog.String("name", req.Name))
 
 	identificationMode := entity.ParseIdentificationMode(req.IdentificationMode)
 	if identificationMode == entity.IdentificationModeUnspecified {
@@ -66,6 +80,9 @@ func (s *Service) CreatePromotion(ctx context.Context, req *desc.CreatePromotion
 		MinDiscount:        int(req.MinDiscount),
 		MaxDiscount:        int(req.MaxDiscount),
 		StopFactors:        entity.StopFactors{Factors: req.StopFactors},
+		Priority:           int(req.Priority),
+		TargetRegions:      req.TargetRegions,
+		TargetPlatforms:    req.TargetPlatforms,
 	}
 	id, err := s.promotionService.CreatePromotion(ctx, promo)
 	if err != nil {
@@ -100,10 +117,19 @@ func (s *Service) GetPromotions(ctx context.Context, req *desc.GetPromotionReque
 			MinDiscount:        int32(promo.MinDiscount),
 			MaxDiscount:        int32(promo.MaxDiscount),
 			StopFactors:        promo.StopFactors.Factors,
+			Priority:           int32(promo.Priority),
+			TargetRegions:      promo.TargetRegions,
+			TargetPlatforms:    promo.TargetPlatforms,
 		}
 		// Segments, FixedPrices, Poll filled by service if needed
 		segments, err := s.promotionService.GetPromotionSegments(ctx, promo.ID)
 		if err == nil && len(segments) > 0 {
+			// The estimate is an extra; a failure leaves the promotion without it instead of failing the list.
+			populations, err := s.analyticsService.EstimateSegmentPopulation(ctx, promo.ID)
+			if err != nil {
+				slog.WarnContext(ctx, "estimate segment population",
+					slog.Int64("promotion_id", promo.ID), slog.String("error", err.Error()))
+			}
 			res.Segments = make([]*desc.SegmentWithOrder, len(segments))
 			for i, seg := range segments {
 				segmentSlotsMarket, err := s.sellerService.GetSegmentSlotsMarket(ctx, promo.ID, seg.ID)
@@ -136,6 +162,11 @@ func (s *Service) GetPromotions(ctx context.Context, req *desc.GetPromotionReque
 					CategoryName: seg.CategoryName,
 					OrderIndex:   seg.OrderIndex,
 				}
+				if p := populations[seg.ID]; p != nil {
+					res.Segments[i].Population = p.Population
+					res.Segments[i].PopulationShare = p.Share
+					res.Segments[i].PopulationSource = p.Source
+				}
 			}
 		}
 		res.BookedSlotsPrice = bookedSlotsPrice
@@ -145,40 +176,45 @@ func (s *Service) GetPromotions(ctx context.Context, req *desc.GetPromotionReque
 		}
 		pollData, err := s.promotionService.GetPromotionPoll(ctx, promo.ID)
 		if err == nil && pollData != nil {
-			res.Poll = &desc.PromotionPoll{}
-			optByQuestion := make(map[int64][]*desc.PollOptionAdmin)
-			for _, opt := range pollData.Options {
-				optByQuestion[opt.QuestionID] = append(optByQuestion[opt.QuestionID], &desc.PollOptionAdmin{
-					Id:    opt.ID,
-					Text:  opt.Text,
-					Value: opt.Value,
-				})
-			}
-			for _, q := range pollData.Questions {
-				res.Poll.Questions = append(res.Poll.Questions, &desc.PollQuestionAdmin{
-					Id:      q.ID,
-					Text:    q.Text,
-					Options: optByQuestion[q.ID],
-				})
-			}
-			for _, n := range pollData.AnswerTree {
-				parent := ""
-				if n.ParentNodeID != nil {
-					parent = *n.ParentNodeID
-				}
-				res.Poll.AnswerTree = append(res.Poll.AnswerTree, &desc.AnswerTreeNode{
-					NodeId:       n.NodeID,
-					ParentNodeId: parent,
-					Label:        n.Label,
-					Value:        n.Value,
-				})
-			}
+			res.Poll = promotionPollToDesc(pollData)
 		}
 		resp.Promotions = append(resp.Promotions, res)
 	}
 	return resp, nil
 }
 
+func promotionPollToDesc(pollData *promotion.PromotionPoll) *desc.PromotionPoll {
+	poll := &desc.PromotionPoll{}
+	optByQuestion := make(map[int64][]*desc.PollOptionAdmin)
+	for _, opt := range pollData.Options {
+		optByQuestion[opt.QuestionID] = append(optByQuestion[opt.QuestionID], &desc.PollOptionAdmin{
+			Id:    opt.ID,
+			Text:  opt.Text,
+			Value: opt.Value,
+		})
+	}
+	for _, q := range pollData.Questions {
+		poll.Questions = append(poll.Questions, &desc.PollQuestionAdmin{
+			Id:      q.ID,
+			Text:    q.Text,
+			Options: optByQuestion[q.ID],
+		})
+	}
+	for _, n := range pollData.AnswerTree {
+		parent := ""
+		if n.ParentNodeID != nil {
+			parent = *n.ParentNodeID
+		}
+		poll.AnswerTree = append(poll.AnswerTree, &desc.AnswerTreeNode{
+			NodeId:       n.NodeID,
+			ParentNodeId: parent,
+			Label:        n.Label,
+			Value:        n.Value,
+		})
+	}
+	return poll
+}
+
 // UpdatePromotion updates a promotion
 func (s *Service) UpdatePromotion(ctx context.Context, req *desc.UpdatePromotionRequest) (*desc.UpdatePromotionResponse, error) {
 	promo, err := s.promotionService.GetPromotion(ctx, req.Id)
@@ -218,6 +254,19 @@ func (s *Service) UpdatePromotion(ctx context.Context, req *desc.UpdatePromotion
 	if len(req.StopFactors) > 0 {
 		promo.StopFactors = entity.StopFactors{Factors: req.StopFactors}
 	}
+	if req.Priority != nil {
+		promo.Priority = int(*req.Priority)
+	}
+	if req.ClearTargeting {
+		promo.TargetRegions = nil
+		promo.TargetPlatforms = nil
+	}
+	if len(req.TargetRegions) > 0 {
+		promo.TargetRegions = req.TargetRegions
+	}
+	if len(req.TargetPlatforms) > 0 {
+		promo.TargetPlatforms = req.TargetPlatforms
+	}
 	err = s.promotionService.UpdatePromotion(ctx, promo)
 	if err != nil {
 		return nil, err
@@ -449,6 +498,54 @@ func (s *Service) SetAnswerTree(ctx context.Context, req *desc.SetAnswerTreeRequ
 	return &desc.SetAnswerTreeResponse{}, nil
 }
 
+// SimulateIdentification runs the quiz for a promotion without publishing it
+func (s *Service) SimulateIdentification(ctx context.Context, req *desc.SimulateIdentificationRequest) (*desc.SimulateIdentificationResponse, error) {
+	sim, err := s.buyerService.SimulateIdentification(ctx, req.PromotionId, req.OptionIds)
+	if err != nil {
+		if errors.Is(err, buyer.ErrInvalidSimulationChoice) {
+			return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, grpcstatus.Error(codes.NotFound, "promotion not found")
+		}
+		return nil, err
+	}
+
+	paths := make([]*desc.SimulationPath, 0, len(sim.Paths))
+	for _, p := range sim.Paths {
+		steps := make([]*desc.SimulationStep, 0, len(p.Steps))
+		for _, st := range p.Steps {
+			steps = append(steps, &desc.SimulationStep{
+				QuestionId:   st.QuestionID,
+				QuestionText: st.QuestionText,
+				OptionId:     st.OptionID,
+				OptionText:   st.OptionText,
+			})
+		}
+		paths = append(paths, &desc.SimulationPath{
+			Steps:             steps,
+			ResultSegmentId:   p.ResultSegmentID,
+			ResultSegmentName: p.ResultSegmentName,
+			NextQuestionId:    p.NextQuestionID,
+			Loop:              p.Loop,
+		})
+	}
+	coverage := make([]*desc.SegmentCoverage, 0, len(sim.Coverage))
+	for _, c := range sim.Coverage {
+		coverage = append(coverage, &desc.SegmentCoverage{
+			SegmentId: c.SegmentID,
+			Name:      c.Name,
+			Paths:     int32(c.Paths),
+		})
+	}
+	return &desc.SimulateIdentificationResponse{
+		Method:    sim.Method,
+		Paths:     paths,
+		Coverage:  coverage,
+		Truncated: sim.Truncated,
+	}, nil
+}
+
 // --- ModerationService ---
 
 // GetApplications returns moderation applications
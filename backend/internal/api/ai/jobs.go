@@ -0,0 +1,91 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"wildberries/internal/repository"
+	"wildberries/internal/service/ai"
+	desc "wildberries/pkg/ai"
+)
+
+// GetGenerationJob returns the state of an async generation and its result once done
+func (s *Service) GetGenerationJob(ctx context.Context, req *desc.GetGenerationJobRequest) (*desc.GetGenerationJobResponse, error) {
+	job, err := s.aiService.GetJob(ctx, req.Id)
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return nil, grpcstatus.Error(codes.NotFound, "generation job not found")
+	case errors.Is(err, ai.ErrJobsDisabled):
+		return nil, grpcstatus.Error(codes.Unavailable, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &desc.GetGenerationJobResponse{
+		Id:         job.ID,
+		Kind:       job.Kind,
+		Status:     job.Status,
+		Error:      job.Error,
+		Attempts:   int32(job.Attempts),
+		CreatedAt:  job.CreatedAt.UTC().Format(time.RFC3339),
+		StartedAt:  formatJobTime(job.StartedAt),
+		FinishedAt: formatJobTime(job.FinishedAt),
+	}
+	if job.Status != ai.JobStatusDone {
+		return resp, nil
+	}
+	switch job.Kind {
+	case ai.JobKindThemes:
+		resp.Themes = &desc.GenerateThemesResponse{Themes: themesToDesc(job.Themes)}
+	case ai.JobKindSegments:
+		resp.Segments = &desc.GenerateSegmentsResponse{Segments: segmentsToDesc(job.Segments)}
+	case ai.JobKindQuestions:
+		resp.Questions = &desc.GenerateQuestionsResponse{Questions: questionsToDesc(job.Questions)}
+	case ai.JobKindAnswerTree:
+		resp.AnswerTree = &desc.GenerateAnswerTreeResponse{Nodes: answerTreeToDesc(job.AnswerTree)}
+	case ai.JobKindText:
+		resp.Text = &desc.GetTextResponse{Text: job.Text, Segments: segmentTextsToDesc(job.SegmentTexts)}
+	case ai.JobKindPromotionDraft:
+		if saved := job.PromotionDraft; saved != nil {
+			resp.PromotionDraft = &desc.PromotionDraftResult{
+				PromotionId: saved.PromotionID,
+				Name:        saved.Draft.Name,
+				Description: saved.Draft.Description,
+				Theme:       saved.Draft.Theme,
+				Segments:    segmentsToDesc(saved.Draft.Segments),
+				SegmentIds:  saved.SegmentIDs,
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (s *Service) submitJob(ctx context.Context, kind string, req ai.JobRequest) (string, error) {
+	job, err := s.aiService.SubmitJob(ctx, kind, req)
+	if errors.Is(err, ai.ErrJobsDisabled) {
+		return "", grpcstatus.Error(codes.Unavailable, err.Error())
+	}
+	if err != nil {
+		return "", generationError(err)
+	}
+	return job.ID, nil
+}
+
+// generationError maps invalid request parameters to InvalidArgument.
+func generationError(err error) error {
+	if errors.Is(err, ai.ErrInvalidQuizShape) || errors.Is(err, ai.ErrInvalidThemeCount) {
+		return grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func formatJobTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -2,7 +2,13 @@ package ai
 
 import (
 	"context"
+	"errors"
 
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
 	"wildberries/internal/service/ai"
 	desc "wildberries/pkg/ai"
 )
@@ -22,100 +28,97 @@ func New(aiService *ai.Service) *Service {
 
 // GenerateThemes generates themes
 func (s *Service) GenerateThemes(ctx context.Context, req *desc.GenerateThemesRequest) (*desc.GenerateThemesResponse, error) {
-	// Call service
-	themes, err := s.aiService.GenerateThemes(ctx)
-	if err != nil {
-		return nil, err
+	if req.Async {
+		jobID, err := s.submitJob(ctx, ai.JobKindThemes, ai.JobRequest{Limit: int(req.Count)})
+		if err != nil {
+			return nil, err
+		}
+		return &desc.GenerateThemesResponse{JobId: jobID}, nil
 	}
 
-	// Convert entities to response
-	responseThemes := make([]*desc.ThemeItem, len(themes))
-	for i, theme := range themes {
-		responseThemes[i] = &desc.ThemeItem{
-			Value: theme.Value,
-			Label: theme.Label,
-		}
+	// Call service
+	themes, err := s.aiService.GenerateThemes(ctx, int(req.Count))
+	if err != nil {
+		return nil, generationError(err)
 	}
 
 	return &desc.GenerateThemesResponse{
-		Themes: responseThemes,
+		Themes: themesToDesc(themes),
 	}, nil
 }
 
 // GenerateSegments generates segments
 func (s *Service) GenerateSegments(ctx context.Context, req *desc.GenerateSegmentsRequest) (*desc.GenerateSegmentsResponse, error) {
+	if req.Async {
+		jobID, err := s.submitJob(ctx, ai.JobKindSegments, ai.JobRequest{Theme: req.Theme, Limit: int(req.Limit), SlotCount: int(req.SlotCount), Force: req.Force, PromotionID: req.PromotionId})
+		if err != nil {
+			return nil, err
+		}
+		return &desc.GenerateSegmentsResponse{JobId: jobID}, nil
+	}
+
 	// Call service
-	segments, err := s.aiService.GenerateSegments(ctx, req.Theme, int(req.Limit))
+	ctx = ai.WithPromotion(ctx, req.PromotionId)
+	segments, err := s.aiService.GenerateSegments(ctx, req.Theme, int(req.Limit), int(req.SlotCount), req.Force)
+	if errors.Is(err, ai.ErrNoCategorySupply) {
+		return nil, grpcstatus.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert entities to response
-	responseSegments := make([]*desc.SegmentSuggestion, len(segments))
-	for i, segment := range segments {
-		responseSegments[i] = &desc.SegmentSuggestion{
-			Name:         segment.Name,
-			CategoryName: segment.CategoryName,
-		}
-	}
-
 	return &desc.GenerateSegmentsResponse{
-		Segments: responseSegments,
+		Segments: segmentsToDesc(segments),
 	}, nil
 }
 
 // GenerateQuestions generates questions
 func (s *Service) GenerateQuestions(ctx context.Context, req *desc.GenerateQuestionsRequest) (*desc.GenerateQuestionsResponse, error) {
-	// Call service
-	questions, err := s.aiService.GenerateQuestions(ctx, req.Theme)
-	if err != nil {
-		return nil, err
+	shape := ai.QuizShape{
+		MinQuestions: int(req.MinQuestions),
+		MaxQuestions: int(req.MaxQuestions),
+		Options:      int(req.OptionsPerQuestion),
 	}
-
-	// Convert entities to response
-	responseQuestions := make([]*desc.QuestionSuggestion, len(questions))
-	for i, question := range questions {
-		// Convert options
-		responseOptions := make([]*desc.OptionSuggestion, len(question.Options))
-		for j, option := range question.Options {
-			responseOptions[j] = &desc.OptionSuggestion{
-				Text:  option.Text,
-				Value: option.Value,
-			}
+	if req.Async {
+		jobID, err := s.submitJob(ctx, ai.JobKindQuestions, ai.JobRequest{Theme: req.Theme, Force: req.Force, PromotionID: req.PromotionId, QuizShape: shape})
+		if err != nil {
+			return nil, err
 		}
+		return &desc.GenerateQuestionsResponse{JobId: jobID}, nil
+	}
 
-		responseQuestions[i] = &desc.QuestionSuggestion{
-			Text:    question.Text,
-			Options: responseOptions,
-		}
+	// Call service
+	ctx = ai.WithPromotion(ctx, req.PromotionId)
+	questions, err := s.aiService.GenerateQuestions(ctx, req.Theme, shape, req.Force)
+	if err != nil {
+		return nil, generationError(err)
 	}
 
 	return &desc.GenerateQuestionsResponse{
-		Questions: responseQuestions,
+		Questions: questionsToDesc(questions),
 	}, nil
 }
 
 // GenerateAnswerTree generates answer tree
 func (s *Service) GenerateAnswerTree(ctx context.Context, req *desc.GenerateAnswerTreeRequest) (*desc.GenerateAnswerTreeResponse, error) {
-	// Call service
-	nodes, err := s.aiService.GenerateAnswerTree(ctx, req.Theme)
-	if err != nil {
-		return nil, err
+	shape := ai.QuizShape{MaxQuestions: int(req.QuestionCount), Options: int(req.OptionsPerQuestion)}
+	if req.Async {
+		jobID, err := s.submitJob(ctx, ai.JobKindAnswerTree, ai.JobRequest{Theme: req.Theme, PromotionID: req.PromotionId, QuizShape: shape})
+		if err != nil {
+			return nil, err
+		}
+		return &desc.GenerateAnswerTreeResponse{JobId: jobID}, nil
 	}
 
-	// Convert entities to response
-	responseNodes := make([]*desc.AnswerTreeNode, len(nodes))
-	for i, node := range nodes {
-		responseNodes[i] = &desc.AnswerTreeNode{
-			NodeId:       node.NodeID,
-			ParentNodeId: node.ParentNodeID,
-			Label:        node.Label,
-			Value:        node.Value,
-		}
+	// Call service
+	ctx = ai.WithPromotion(ctx, req.PromotionId)
+	nodes, err := s.aiService.GenerateAnswerTree(ctx, req.Theme, shape)
+	if err != nil {
+		return nil, generationError(err)
 	}
 
 	return &desc.GenerateAnswerTreeResponse{
-		Nodes: responseNodes,
+		Nodes: answerTreeToDesc(nodes),
 	}, nil
 }
 
@@ -127,6 +130,32 @@ func (s *Service) GetText(ctx context.Context, req *desc.GetTextRequest) (*desc.
 		params[k] = v
 	}
 
+	if req.Async {
+		jobID, err := s.submitJob(ctx, ai.JobKindText, ai.JobRequest{Params: params, SegmentID: req.SegmentId, PromotionID: req.PromotionId, Save: req.Save})
+		if err != nil {
+			return nil, err
+		}
+		return &desc.GetTextResponse{JobId: jobID}, nil
+	}
+
+	// Segment texts: one segment or all segments of a promotion, optionally saved
+	if req.SegmentId > 0 || req.PromotionId > 0 || req.Save {
+		texts, err := s.aiService.GenerateSegmentTexts(ctx, params, req.PromotionId, req.SegmentId, req.Save)
+		switch {
+		case errors.Is(err, ai.ErrSegmentTextTarget):
+			return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, grpcstatus.Error(codes.NotFound, "segment or promotion not found")
+		case err != nil:
+			return nil, err
+		}
+		resp := &desc.GetTextResponse{Segments: segmentTextsToDesc(texts)}
+		if len(texts) == 1 {
+			resp.Text = texts[0].Text
+		}
+		return resp, nil
+	}
+
 	// Call service
 	text, err := s.aiService.GetText(ctx, params, req.SegmentId)
 	if err != nil {
@@ -137,3 +166,70 @@ func (s *Service) GetText(ctx context.Context, req *desc.GetTextRequest) (*desc.
 		Text: text,
 	}, nil
 }
+
+func themesToDesc(themes []*entity.ThemeItem) []*desc.ThemeItem {
+	responseThemes := make([]*desc.ThemeItem, len(themes))
+	for i, theme := range themes {
+		responseThemes[i] = &desc.ThemeItem{
+			Value: theme.Value,
+			Label: theme.Label,
+		}
+	}
+	return responseThemes
+}
+
+func segmentsToDesc(segments []*entity.SegmentSuggestion) []*desc.SegmentSuggestion {
+	responseSegments := make([]*desc.SegmentSuggestion, len(segments))
+	for i, segment := range segments {
+		responseSegments[i] = &desc.SegmentSuggestion{
+			Name:         segment.Name,
+			CategoryName: segment.CategoryName,
+		}
+	}
+	return responseSegments
+}
+
+func questionsToDesc(questions []*entity.QuestionSuggestion) []*desc.QuestionSuggestion {
+	responseQuestions := make([]*desc.QuestionSuggestion, len(questions))
+	for i, question := range questions {
+		// Convert options
+		responseOptions := make([]*desc.OptionSuggestion, len(question.Options))
+		for j, option := range question.Options {
+			responseOptions[j] = &desc.OptionSuggestion{
+				Text:  option.Text,
+				Value: option.Value,
+			}
+		}
+
+		responseQuestions[i] = &desc.QuestionSuggestion{
+			Text:    question.Text,
+			Options: responseOptions,
+		}
+	}
+	return responseQuestions
+}
+
+func segmentTextsToDesc(texts []*entity.SegmentText) []*desc.SegmentText {
+	responseTexts := make([]*desc.SegmentText, len(texts))
+	for i, text := range texts {
+		responseTexts[i] = &desc.SegmentText{
+			SegmentId: text.SegmentID,
+			Text:      text.Text,
+			Version:   int32(text.Version),
+		}
+	}
+	return responseTexts
+}
+
+func answerTreeToDesc(nodes []*entity.AnswerTreeNode) []*desc.AnswerTreeNode {
+	responseNodes := make([]*desc.AnswerTreeNode, len(nodes))
+	for i, node := range nodes {
+		responseNodes[i] = &desc.AnswerTreeNode{
+			NodeId:       node.NodeID,
+			ParentNodeId: node.ParentNodeID,
+			Label:        node.Label,
+			Value:        node.Value,
+		}
+	}
+	return responseNodes
+}
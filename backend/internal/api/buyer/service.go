@@ -3,11 +3,15 @@ package buyer
 import (
 	"context"
 	"errors"
+	"sort"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
 	"wildberries/internal/service/buyer"
 	desc "wildberries/pkg/buyer"
 	commonpb "wildberries/pkg/common"
@@ -18,6 +22,7 @@ type Service struct {
 	buyerService *buyer.Service
 	desc.UnimplementedBuyerPromotionServiceServer
 	desc.UnimplementedIdentificationServiceServer
+	desc.UnimplementedBuyerEventServiceServer
 }
 
 // New creates a new buyer service
@@ -29,15 +34,19 @@ func New(buyerService *buyer.Service) *Service {
 
 // GetCurrentPromotion gets the current promotion
 func (s *Service) GetCurrentPromotion(ctx context.Context, req *desc.GetCurrentPromotionRequest) (*desc.GetCurrentPromotionResponse, error) {
-	promotion, err := s.buyerService.GetCurrentPromotion(ctx)
+	target := buyer.PromotionTarget{Region: req.Region, Platform: req.Platform}
+	promotion, err := s.buyerService.GetCurrentPromotion(ctx, req.GetPromotionId(), target)
 	if err != nil {
+		if errors.Is(err, buyer.ErrPromotionNotActive) {
+			return nil, grpcstatus.Error(codes.NotFound, err.Error())
+		}
 		return nil, err
 	}
 	if promotion == nil {
 		return &desc.GetCurrentPromotionResponse{}, nil
 	}
 	segments, _ := s.buyerService.GetCurrentPromotionSegments(ctx, promotion.ID)
-	resp := &desc.GetCurrentPromotionResponse{
+	return &desc.GetCurrentPromotionResponse{
 		Id:          promotion.ID,
 		Name:        promotion.Name,
 		Description: promotion.Description,
@@ -45,21 +54,53 @@ func (s *Service) GetCurrentPromotion(ctx context.Context, req *desc.GetCurrentP
 		Status:      promotion.Status.String(),
 		DateFrom:    promotion.DateFrom,
 		DateTo:      promotion.DateTo,
+		Segments:    toSegmentsPB(segments),
+	}, nil
+}
+
+// ListActivePromotions lists all running promotions for the buyer
+func (s *Service) ListActivePromotions(ctx context.Context, req *desc.ListActivePromotionsRequest) (*desc.ListActivePromotionsResponse, error) {
+	target := buyer.PromotionTarget{Region: req.Region, Platform: req.Platform}
+	promotions, err := s.buyerService.ListActivePromotions(ctx, target)
+	if err != nil {
+		return nil, err
 	}
-	if len(segments) > 0 {
-		resp.Segments = make([]*commonpb.Segment, len(segments))
-		for i, seg := range segments {
-			resp.Segments[i] = &commonpb.Segment{
-				Id:           seg.ID,
-				Name:         seg.Name,
-				CategoryName: seg.CategoryName,
-				OrderIndex:   seg.OrderIndex,
-			}
-		}
+	resp := &desc.ListActivePromotionsResponse{
+		Promotions: make([]*desc.ActivePromotion, 0, len(promotions)),
+	}
+	for _, promotion := range promotions {
+		segments, _ := s.buyerService.GetCurrentPromotionSegments(ctx, promotion.ID)
+		resp.Promotions = append(resp.Promotions, &desc.ActivePromotion{
+			Id:          promotion.ID,
+			Name:        promotion.Name,
+			Description: promotion.Description,
+			Theme:       promotion.Theme,
+			Status:      promotion.Status.String(),
+			DateFrom:    promotion.DateFrom,
+			DateTo:      promotion.DateTo,
+			Priority:    int32(promotion.Priority),
+			Segments:    toSegmentsPB(segments),
+		})
 	}
 	return resp, nil
 }
 
+func toSegmentsPB(segments []*entity.Segment) []*commonpb.Segment {
+	if len(segments) == 0 {
+		return nil
+	}
+	out := make([]*commonpb.Segment, len(segments))
+	for i, seg := range segments {
+		out[i] = &commonpb.Segment{
+			Id:           seg.ID,
+			Name:         seg.Name,
+			CategoryName: seg.CategoryName,
+			OrderIndex:   seg.OrderIndex,
+		}
+	}
+	return out
+}
+
 // GetSegmentProducts gets products for a segment
 func (s *Service) GetSegmentProducts(ctx context.Context, req *desc.GetSegmentProductsRequest) (*desc.GetSegmentProductsResponse, error) {
 	// Convert request filters
@@ -77,6 +118,9 @@ func (s *Service) GetSegmentProducts(ctx context.Context, req *desc.GetSegmentPr
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, grpcstatus.Error(codes.NotFound, "segment not found for promotion")
 		}
+		if errors.Is(err, repository.ErrInvalidFilter) {
+			return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 
@@ -153,3 +197,53 @@ func (s *Service) Answer(ctx context.Context, req *desc.AnswerRequest) (*desc.An
 		ResultSegmentId: resultSegment,
 	}, nil
 }
+
+// TrackEvents ingests a batch of buyer events
+func (s *Service) TrackEvents(ctx context.Context, req *desc.TrackEventsRequest) (*desc.TrackEventsResponse, error) {
+	events := make([]*entity.BuyerEvent, 0, len(req.Events))
+	var rejected []*desc.RejectedEvent
+	for i, e := range req.Events {
+		var occurredAt time.Time
+		if e.OccurredAt != "" {
+			t, err := time.Parse(time.RFC3339, e.OccurredAt)
+			if err != nil {
+				rejected = append(rejected, &desc.RejectedEvent{Index: int32(i), Reason: "invalid occurred_at"})
+				events = append(events, nil)
+				continue
+			}
+			occurredAt = t
+		}
+		events = append(events, &entity.BuyerEvent{
+			EventID:     e.EventId,
+			Type:        entity.BuyerEventType(e.Type),
+			PromotionID: e.PromotionId,
+			SegmentID:   e.SegmentId,
+			QuestionID:  e.QuestionId,
+			OptionID:    e.OptionId,
+			ProductID:   e.ProductId,
+			SlotID:      e.SlotId,
+			SessionID:   e.SessionId,
+			Properties:  e.Properties,
+			OccurredAt:  occurredAt,
+		})
+	}
+
+	accepted, invalid, err := s.buyerService.TrackEvents(ctx, events)
+	if err != nil {
+		if errors.Is(err, buyer.ErrEventBatchTooLarge) {
+			return nil, grpcstatus.Errorf(codes.InvalidArgument, "at most %d events per request", buyer.MaxEventBatch)
+		}
+		return nil, err
+	}
+	for _, r := range invalid {
+		if events[r.Index] == nil {
+			continue // already rejected above
+		}
+		rejected = append(rejected, &desc.RejectedEvent{Index: int32(r.Index), Reason: r.Reason})
+	}
+	sort.Slice(rejected, func(i, j int) bool { return rejected[i].Index < rejected[j].Index })
+	return &desc.TrackEventsResponse{
+		Accepted: int32(accepted),
+		Rejected: rejected,
+	}, nil
+}
@@ -2,6 +2,11 @@ package seller
 
 import (
 	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"wildberries/internal/service/seller"
 	desc "wildberries/pkg/seller"
@@ -32,12 +37,14 @@ func (s *Service) GetActionSegments(ctx context.Context, req *desc.GetActionSegm
 	}
 	for _, item := range items {
 		resp.ActionSegments = append(resp.ActionSegments, &desc.ActionSegment{
-			Id:          item.ID,
-			Name:        item.Name,
-			Category:    item.Category,
-			Population:  item.Population,
-			BookedSlots: item.BookedSlots,
-			TotalSlots:  item.TotalSlots,
+			Id:               item.ID,
+			Name:             item.Name,
+			Category:         item.Category,
+			Population:       item.Population,
+			BookedSlots:      item.BookedSlots,
+			TotalSlots:       item.TotalSlots,
+			PopulationShare:  item.PopulationShare,
+			PopulationSource: item.PopulationSource,
 		})
 	}
 	return resp, nil
@@ -153,19 +160,109 @@ func (s *Service) RemoveBet(ctx context.Context, req *desc.RemoveBetRequest) (*d
 	}, nil
 }
 
+// RecommendBid estimates the bid needed to hold a segment position
+func (s *Service) RecommendBid(ctx context.Context, req *desc.RecommendBidRequest) (*desc.RecommendBidResponse, error) {
+	rec, err := s.sellerService.RecommendBid(ctx, req.PromotionId, req.SegmentId, int(req.Position))
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, grpcstatus.Error(codes.NotFound, "segment not found for promotion")
+		case errors.Is(err, seller.ErrInvalidPosition):
+			return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, seller.ErrNotAuction):
+			return nil, grpcstatus.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return &desc.RecommendBidResponse{
+		RecommendedBid:    rec.RecommendedBid,
+		MinAllowedBid:     rec.MinAllowedBid,
+		PositionBid:       rec.PositionBid,
+		BidStep:           rec.BidStep,
+		CompetingOffers:   int32(rec.CompetingOffers),
+		HistoricalMedian:  rec.HistoricalMedian,
+		HistoricalMax:     rec.HistoricalMax,
+		HistoricalSamples: rec.HistoricalSamples,
+		HistorySource:     rec.HistorySource,
+		TimeLeft:          rec.TimeLeft,
+		TimeLeftShare:     rec.TimeLeftShare,
+		AuctionFinished:   rec.AuctionFinished,
+		Position:          int32(rec.Position),
+	}, nil
+}
+
 // GetSellerStatistics returns seller statistics
 func (s *Service) GetSellerStatistics(ctx context.Context, req *desc.GetSellerStatisticsRequest) (*desc.GetSellerStatisticsResponse, error) {
-	stats, err := s.sellerService.GetSellerStatistics(ctx)
+	stats, err := s.sellerService.GetSellerStatistics(ctx, req.SellerId)
 	if err != nil {
 		return nil, err
 	}
 
-	return &desc.GetSellerStatisticsResponse{
-		ActivePromotions: stats.ActivePromotions,
-		FreeSlots:       stats.FreeSlots,
-		OccupiedSlots:   stats.OccupiedSlots,
-		TotalViews:      stats.TotalViews,
-	}, nil
+	resp := &desc.GetSellerStatisticsResponse{
+		ActivePromotions:   stats.ActivePromotions,
+		FreeSlots:          stats.FreeSlots,
+		OccupiedSlots:      stats.OccupiedSlots,
+		WonSlots:           stats.WonSlots,
+		TotalViews:         stats.TotalViews,
+		ModerationSlots:    stats.ModerationSlots,
+		CommittedAmount:    stats.CommittedAmount,
+		ActiveBets:         stats.ActiveBets,
+		ActiveBetsAmount:   stats.ActiveBetsAmount,
+		WinRate:            stats.WinRate,
+		ProductImpressions: stats.ProductImpressions,
+		ProductClicks:      stats.ProductClicks,
+	}
+	for _, p := range stats.Promotions {
+		resp.Promotions = append(resp.Promotions, &desc.SellerPromotionStatistics{
+			PromotionId:        p.PromotionID,
+			PromotionName:      p.PromotionName,
+			PromotionStatus:    p.PromotionStatus,
+			WonSlots:           p.WonSlots,
+			ModerationSlots:    p.ModerationSlots,
+			CommittedAmount:    p.CommittedAmount,
+			ActiveBets:         p.ActiveBets,
+			ActiveBetsAmount:   p.ActiveBetsAmount,
+			WinRate:            p.WinRate,
+			ProductImpressions: p.ProductImpressions,
+			ProductClicks:      p.ProductClicks,
+		})
+	}
+	return resp, nil
+}
+
+// GetSlotPerformance returns performance of seller products per occupied slot
+func (s *Service) GetSlotPerformance(ctx context.Context, req *desc.GetSlotPerformanceRequest) (*desc.GetSlotPerformanceResponse, error) {
+	if req.SellerId <= 0 {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "seller_id is required")
+	}
+	items, err := s.sellerService.GetSlotPerformance(ctx, req.SellerId, req.PromotionId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &desc.GetSlotPerformanceResponse{
+		Items: make([]*desc.SlotPerformance, 0, len(items)),
+	}
+	for _, item := range items {
+		resp.Items = append(resp.Items, &desc.SlotPerformance{
+			PromotionId:   item.PromotionID,
+			PromotionName: item.PromotionName,
+			SegmentId:     item.SegmentID,
+			SegmentName:   item.SegmentName,
+			SlotId:        item.SlotID,
+			Position:      int32(item.Position),
+			PricingType:   item.PricingType,
+			ProductId:     item.ProductID,
+			ProductName:   item.ProductName,
+			Price:         item.Price,
+			Impressions:   item.Impressions,
+			Clicks:        item.Clicks,
+			Ctr:           item.CTR,
+			CostPerClick:  item.CostPerClick,
+		})
+	}
+	return resp, nil
 }
 
 // IncrementPromotionView increments the view count for a promotion
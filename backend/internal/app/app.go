@@ -3,24 +3,36 @@ package app
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
 
 	admin_api "wildberries/internal/api/admin"
 	ai_api "wildberries/internal/api/ai"
 	buyer_api "wildberries/internal/api/buyer"
 	seller_api "wildberries/internal/api/seller"
+	"wildberries/internal/cache"
 	"wildberries/internal/config"
+	"wildberries/internal/logging"
+	"wildberries/internal/metrics"
 	"wildberries/internal/repository"
 	"wildberries/internal/service/ai"
+	"wildberries/internal/service/analytics"
 	"wildberries/internal/service/buyer"
 	"wildberries/internal/service/promotion"
 	"wildberries/internal/service/seller"
+	"wildberries/internal/tracing"
 	adminpb "wildberries/pkg/admin"
 	aipb "wildberries/pkg/ai"
 	buyerpb "wildberries/pkg/buyer"
@@ -33,6 +45,8 @@ type App struct {
 
 	promotionService *promotion.Service
 	sellerService    *seller.Service
+	aiService        *ai.Service
+	healthRepo       repository.HealthRepository
 
 	// API services
 	adminAPI  *admin_api.Service
@@ -42,11 +56,26 @@ type App struct {
 
 	// gRPC gateway mux
 	gwmux *runtime.ServeMux
+
+	// gRPC server and its health service; set by StartGRPCServer
+	mu         sync.Mutex
+	grpcServer *grpc.Server
+	health     *health.Server
+
+	// stops background jobs; background waits for them to return
+	stopBackground context.CancelFunc
+	background     sync.WaitGroup
+	shuttingDown   atomic.Bool
 }
 
 func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	// Create database connection pool
-	pool, err := pgxpool.New(ctx, cfg.DSN)
+	poolCfg, err := pgxpool.ParseConfig(cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	poolCfg.ConnConfig.Tracer = tracing.NewQueryTracer()
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +90,20 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 	auctionRepo := repository.NewAuctionPostgres(pool)
 	pollRepo := repository.NewPollPostgres(pool)
 	viewCountRepo := repository.NewPromotionViewCountPostgres(pool)
+	buyerEventRepo := repository.NewBuyerEventPostgres(pool)
+	sellerStatsRepo := repository.NewSellerStatisticsPostgres(pool)
+	promotionReportRepo := repository.NewPromotionReportPostgres(pool)
+
+	prometheus.MustRegister(
+		metrics.NewPoolCollector(pool),
+		metrics.NewDomainCollector(repository.NewMetricsPostgres(pool)),
+	)
+
+	// Buyer storefront cache
+	var storefrontCache cache.Cache = cache.Noop{}
+	if cfg.CacheSize > 0 {
+		storefrontCache = cache.NewLRU(cfg.CacheSize)
+	}
 
 	// Create services
 	promotionService := promotion.New(
@@ -72,34 +115,55 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 		auctionRepo,
 		betRepo,
 		pollRepo,
+		storefrontCache,
 	)
 
-	buyerService := buyer.New(productRepo, promotionRepo, slotRepo, segmentRepo, pollRepo)
-	sellerService := seller.New(productRepo, betRepo, auctionRepo, slotRepo, segmentRepo, promotionRepo, moderationRepo, viewCountRepo)
+	buyerService := buyer.New(productRepo, promotionRepo, slotRepo, segmentRepo, pollRepo, buyerEventRepo, storefrontCache, cfg.CacheTTL)
+	analyticsService := analytics.New(buyerEventRepo, promotionRepo, segmentRepo, promotionReportRepo, buyerService)
+	sellerService := seller.New(productRepo, betRepo, auctionRepo, slotRepo, segmentRepo, promotionRepo, moderationRepo, viewCountRepo, sellerStatsRepo, analyticsService)
 	aiService := ai.New(ai.Config{
-		Provider:         cfg.AIProvider,
-		GeminiAPIKey:     cfg.GeminiAPIKey,
-		GeminiModel:      cfg.GeminiModel,
-		GeminiAPIBaseURL: cfg.GeminiAPIBaseURL,
-		GroqAPIKey:       cfg.GroqAPIKey,
-		GroqModel:        cfg.GroqModel,
-		GroqAPIBaseURL:   cfg.GroqAPIBaseURL,
-	})
+		Provider:              cfg.AIProvider,
+		GeminiAPIKey:          cfg.GeminiAPIKey,
+		GeminiModel:           cfg.GeminiModel,
+		GeminiAPIBaseURL:      cfg.GeminiAPIBaseURL,
+		GroqAPIKey:            cfg.GroqAPIKey,
+		GroqModel:             cfg.GroqModel,
+		GroqAPIBaseURL:        cfg.GroqAPIBaseURL,
+		OpenAIAPIKey:          cfg.OpenAIAPIKey,
+		OpenAIModel:           cfg.OpenAIModel,
+		OpenAIAPIBaseURL:      cfg.OpenAIAPIBaseURL,
+		OpenAIHeaders:         cfg.OpenAIHeaders,
+		OpenAITimeout:         cfg.OpenAITimeout,
+		OpenAIDisableJSONMode: !cfg.OpenAIJSONMode,
+		RetryAttempts:         cfg.AIRetryAttempts,
+		BreakerThreshold:      cfg.AIBreakerLimit,
+		BreakerCooldown:       cfg.AIBreakerCooldown,
+		CacheTTL:              cfg.AICacheTTL,
+		TokenPrices:           aiTokenPrices(cfg.AITokenPrices),
+		JobTimeout:            cfg.AIJobTimeout,
+	}, repository.NewAIGenerationCachePostgres(pool), repository.NewAIGenerationLogPostgres(pool), repository.NewAIGenerationJobPostgres(pool), productRepo, segmentRepo, promotionRepo, repository.NewPromptTemplatePostgres(pool), promotionService)
 
 	// Create API services
 	buyerAPIService := buyer_api.New(buyerService)
 	sellerAPIService := seller_api.New(sellerService)
-	adminAPIService := admin_api.New(promotionService, sellerService)
+	adminAPIService := admin_api.New(promotionService, sellerService, buyerService, analyticsService, aiService)
 	aiAPIService := ai_api.New(aiService)
 
 	// Create gRPC gateway mux
-	gwmux := runtime.NewServeMux()
+	gwmux := runtime.NewServeMux(
+		// request id assigned at the HTTP edge goes to gRPC handlers as metadata
+		runtime.WithMetadata(logging.GatewayMetadata),
+		runtime.WithMiddlewares(metrics.GatewayRoute),
+	)
 
 	app := &App{
 		cfg:              cfg,
 		pool:             pool,
 		promotionService: promotionService,
 		sellerService:    sellerService,
+		aiService:        aiService,
+		healthRepo:       repository.NewHealthPostgres(pool),
+		health:           health.NewServer(),
 		adminAPI:         adminAPIService,
 		buyerAPI:         buyerAPIService,
 		sellerAPI:        sellerAPIService,
@@ -107,14 +171,80 @@ func New(ctx context.Context, cfg *config.Config) (*App, error) {
 		gwmux:            gwmux,
 	}
 
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	app.stopBackground = stopBackground
+	app.background.Add(1)
+	go func() {
+		defer app.background.Done()
+		runEventPartitionMaintenance(bgCtx, buyerService)
+	}()
+	app.background.Add(1)
+	go func() {
+		defer app.background.Done()
+		runAIMaintenance(bgCtx, aiService)
+	}()
+	app.background.Add(1)
+	go func() {
+		defer app.background.Done()
+		aiService.RunJobWorkers(bgCtx, cfg.AIJobWorkers)
+	}()
+
 	return app, nil
 }
 
+// runEventPartitionMaintenance keeps daily buyer_event partitions created ahead of time
+// and purges event ids that can no longer be replayed.
+func runEventPartitionMaintenance(ctx context.Context, buyerService *buyer.Service) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		if err := buyerService.EnsureEventPartitions(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "buyer_event partitions", slog.String("error", err.Error()))
+		}
+		if _, err := buyerService.PurgeEventDedup(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "buyer_event dedup purge", slog.String("error", err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func aiTokenPrices(prices map[string]config.TokenPrice) map[string]ai.TokenPrice {
+	out := make(map[string]ai.TokenPrice, len(prices))
+	for provider, p := range prices {
+		out[provider] = ai.TokenPrice{Input: p.Input, Output: p.Output}
+	}
+	return out
+}
+
+// runAIMaintenance removes expired AI generations from the cache and old finished jobs.
+func runAIMaintenance(ctx context.Context, aiService *ai.Service) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		if _, err := aiService.PurgeExpiredCache(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "ai generation cache purge", slog.String("error", err.Error()))
+		}
+		if _, err := aiService.PurgeFinishedJobs(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "ai generation jobs purge", slog.String("error", err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (a *App) SetupGatewayHandlers(ctx context.Context) error {
 	// Connect to gRPC server
 	grpcConn, err := grpc.DialContext(ctx,
 		fmt.Sprintf("localhost:%d", a.cfg.GRPCPort),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.FailOnNonTempDialError(true),
 	)
 	if err != nil {
@@ -138,6 +268,10 @@ func (a *App) SetupGatewayHandlers(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	err = adminpb.RegisterPromotionAnalyticsServiceHandler(ctx, a.gwmux, grpcConn)
+	if err != nil {
+		return err
+	}
 
 	err = buyerpb.RegisterBuyerPromotionServiceHandler(ctx, a.gwmux, grpcConn)
 	if err != nil {
@@ -147,6 +281,10 @@ func (a *App) SetupGatewayHandlers(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	err = buyerpb.RegisterBuyerEventServiceHandler(ctx, a.gwmux, grpcConn)
+	if err != nil {
+		return err
+	}
 
 	err = sellerpb.RegisterSellerProductServiceHandler(ctx, a.gwmux, grpcConn)
 	if err != nil {
@@ -161,6 +299,16 @@ func (a *App) SetupGatewayHandlers(ctx context.Context) error {
 		return err
 	}
 
+	err = adminpb.RegisterAIAuditServiceHandler(ctx, a.gwmux, grpcConn)
+	if err != nil {
+		return err
+	}
+
+	err = adminpb.RegisterPromptTemplateServiceHandler(ctx, a.gwmux, grpcConn)
+	if err != nil {
+		return err
+	}
+
 	err = aipb.RegisterAIServiceHandler(ctx, a.gwmux, grpcConn)
 	if err != nil {
 		return err
@@ -170,6 +318,16 @@ func (a *App) SetupGatewayHandlers(ctx context.Context) error {
 }
 
 func (a *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/healthz":
+		metrics.SetRoute(r.Context(), r.URL.Path)
+		a.handleHealthz(w, r)
+		return
+	case "/readyz":
+		metrics.SetRoute(r.Context(), r.URL.Path)
+		a.handleReadyz(w, r)
+		return
+	}
 	if a.serveCustomHTTP(w, r) {
 		return
 	}
@@ -177,6 +335,36 @@ func (a *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	a.gwmux.ServeHTTP(w, r)
 }
 
+// BeginShutdown marks the instance as not ready so load balancers stop routing to it.
+func (a *App) BeginShutdown() {
+	a.shuttingDown.Store(true)
+	a.health.Shutdown()
+}
+
+// Shutdown drains gRPC, stops background workers and closes the pool.
+// HTTP must be drained by the caller before, since gateway requests go through gRPC.
+// If ctx expires, in-flight gRPC calls are cut off.
 func (a *App) Shutdown(ctx context.Context) {
+	a.BeginShutdown()
+
+	a.mu.Lock()
+	grpcServer := a.grpcServer
+	a.mu.Unlock()
+	if grpcServer != nil {
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			slog.Warn("gRPC graceful stop timed out")
+			grpcServer.Stop()
+		}
+	}
+
+	a.stopBackground()
+	a.background.Wait()
 	a.pool.Close()
 }
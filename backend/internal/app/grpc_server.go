@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"net"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"wildberries/internal/logging"
+	"wildberries/internal/metrics"
 	"wildberries/pkg/admin"
 	"wildberries/pkg/ai"
 	"wildberries/pkg/buyer"
@@ -17,16 +21,26 @@ import (
 // StartGRPCServer starts the gRPC server with all services
 func (a *App) StartGRPCServer(ctx context.Context) error {
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(),
+			metrics.UnaryServerInterceptor(),
+		),
+	)
 
 	// Register services
 	admin.RegisterPromotionAdminServiceServer(grpcServer, a.adminAPI)
 	admin.RegisterSegmentAdminServiceServer(grpcServer, a.adminAPI)
 	admin.RegisterPollAdminServiceServer(grpcServer, a.adminAPI)
 	admin.RegisterModerationServiceServer(grpcServer, a.adminAPI)
+	admin.RegisterPromotionAnalyticsServiceServer(grpcServer, a.adminAPI)
+	admin.RegisterAIAuditServiceServer(grpcServer, a.adminAPI)
+	admin.RegisterPromptTemplateServiceServer(grpcServer, a.adminAPI)
 
 	buyer.RegisterBuyerPromotionServiceServer(grpcServer, a.buyerAPI)
 	buyer.RegisterIdentificationServiceServer(grpcServer, a.buyerAPI)
+	buyer.RegisterBuyerEventServiceServer(grpcServer, a.buyerAPI)
 
 	seller.RegisterSellerProductServiceServer(grpcServer, a.sellerAPI)
 	seller.RegisterSellerActionsServiceServer(grpcServer, a.sellerAPI)
@@ -34,6 +48,10 @@ func (a *App) StartGRPCServer(ctx context.Context) error {
 
 	ai.RegisterAIServiceServer(grpcServer, a.aiAPI)
 
+	// Health service: SERVING until BeginShutdown
+	healthpb.RegisterHealthServer(grpcServer, a.health)
+	a.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+
 	// Enable reflection for debugging
 	reflection.Register(grpcServer)
 
@@ -43,6 +61,15 @@ func (a *App) StartGRPCServer(ctx context.Context) error {
 		return fmt.Errorf("failed to listen: %v", err)
 	}
 
-	// Start serving
+	a.mu.Lock()
+	if a.shuttingDown.Load() {
+		a.mu.Unlock()
+		_ = lis.Close()
+		return nil
+	}
+	a.grpcServer = grpcServer
+	a.mu.Unlock()
+
+	// Start serving; returns nil after GracefulStop
 	return grpcServer.Serve(lis)
 }
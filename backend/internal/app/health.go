@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"wildberries/migrations"
+)
+
+// readinessTimeout bounds all readiness checks together.
+const readinessTimeout = 3 * time.Second
+
+type healthCheck struct {
+	Name  string `json:"name"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// handleHealthz reports that the process is alive.
+func (a *App) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleReadyz reports whether the instance can take traffic: DB reachable,
+// schema migrated to the version the binary expects and AI provider configured.
+func (a *App) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	checks := a.readinessChecks(r.Context())
+	status, code := "ok", http.StatusOK
+	for _, c := range checks {
+		if !c.OK {
+			status, code = "unavailable", http.StatusServiceUnavailable
+			break
+		}
+	}
+	writeJSON(w, code, struct {
+		Status string        `json:"status"`
+		Checks []healthCheck `json:"checks"`
+	}{Status: status, Checks: checks})
+}
+
+func (a *App) readinessChecks(ctx context.Context) []healthCheck {
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+
+	checks := make([]healthCheck, 0, 4)
+	add := func(name string, err error) {
+		c := healthCheck{Name: name, OK: err == nil}
+		if err != nil {
+			c.Error = err.Error()
+		}
+		checks = append(checks, c)
+	}
+
+	if a.shuttingDown.Load() {
+		add("shutdown", fmt.Errorf("shutting down"))
+	}
+	dbErr := a.healthRepo.Ping(ctx)
+	add("database", dbErr)
+	if dbErr == nil {
+		add("migrations", a.checkMigrations(ctx))
+	}
+	add("ai_provider", a.aiService.Ready())
+	return checks
+}
+
+func (a *App) checkMigrations(ctx context.Context) error {
+	applied, err := a.healthRepo.MigrationVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if expected := migrations.LatestVersion(); applied < expected {
+		return fmt.Errorf("database is at migration %d, expected %d", applied, expected)
+	}
+	return nil
+}
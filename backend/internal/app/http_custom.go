@@ -1,14 +1,18 @@
 package app
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 	"wildberries/internal/entity"
+	"wildberries/internal/logging"
+	"wildberries/internal/metrics"
 
 	"github.com/jackc/pgx/v5"
 )
@@ -25,6 +29,7 @@ func (a *App) serveCustomHTTP(w http.ResponseWriter, r *http.Request) bool {
 		parts := splitPath(path)
 		// /admin/promotions/{id}
 		if len(parts) == 3 && parts[0] == "admin" && parts[1] == "promotions" {
+			metrics.SetRoute(r.Context(), "/admin/promotions/{id}")
 			if r.Method == http.MethodGet {
 				a.handleAdminGetPromotion(w, r, parts[2])
 				return true
@@ -36,12 +41,14 @@ func (a *App) serveCustomHTTP(w http.ResponseWriter, r *http.Request) bool {
 		}
 		// /admin/promotions/{id}/segments/{segmentId}
 		if len(parts) == 5 && parts[0] == "admin" && parts[1] == "promotions" && parts[3] == "segments" && r.Method == http.MethodPatch {
+			metrics.SetRoute(r.Context(), "/admin/promotions/{id}/segments/{segmentId}")
 			a.handleAdminUpdateSegment(w, r, parts[2], parts[4])
 			return true
 		}
 	}
 
 	if strings.HasPrefix(path, "/admin/promotions/") && strings.HasSuffix(path, "/auction-params") {
+		metrics.SetRoute(r.Context(), "/admin/promotions/{id}/auction-params")
 		switch r.Method {
 		case http.MethodGet:
 			a.handleAdminGetAuctionParams(w, r)
@@ -53,15 +60,23 @@ func (a *App) serveCustomHTTP(w http.ResponseWriter, r *http.Request) bool {
 		return true
 	}
 
+	if path == "/seller/slots/performance.csv" && r.Method == http.MethodGet {
+		metrics.SetRoute(r.Context(), path)
+		a.handleSellerSlotPerformanceCSV(w, r)
+		return true
+	}
+
 	if strings.HasPrefix(path, "/seller/actions/") {
 		parts := splitPath(path)
 		// /seller/actions/{id}/segments
 		if len(parts) == 4 && parts[0] == "seller" && parts[1] == "actions" && parts[3] == "segments" && r.Method == http.MethodGet {
+			metrics.SetRoute(r.Context(), "/seller/actions/{id}/segments")
 			a.handleSellerSegmentsAlias(w, r, parts[2])
 			return true
 		}
 		// /seller/actions/{id}/segments/{segmentId}/slots
 		if len(parts) == 6 && parts[0] == "seller" && parts[1] == "actions" && parts[3] == "segments" && parts[5] == "slots" && r.Method == http.MethodGet {
+			metrics.SetRoute(r.Context(), "/seller/actions/{id}/segments/{segmentId}/slots")
 			a.handleSellerSegmentSlots(w, r, parts[2], parts[4])
 			return true
 		}
@@ -551,24 +566,28 @@ func (a *App) handleSellerSegmentsAlias(w http.ResponseWriter, r *http.Request,
 		return
 	}
 	type segment struct {
-		ID          int64  `json:"id"`
-		Name        string `json:"name"`
-		Category    string `json:"category"`
-		Population  int64  `json:"population"`
-		BookedSlots int64  `json:"bookedSlots"`
-		TotalSlots  int64  `json:"totalSlots"`
+		ID               int64   `json:"id"`
+		Name             string  `json:"name"`
+		Category         string  `json:"category"`
+		Population       int64   `json:"population"`
+		PopulationShare  float64 `json:"populationShare"`
+		PopulationSource string  `json:"populationSource"`
+		BookedSlots      int64   `json:"bookedSlots"`
+		TotalSlots       int64   `json:"totalSlots"`
 	}
 	resp := struct {
 		ActionSegments []segment `json:"actionSegments"`
 	}{ActionSegments: make([]segment, 0, len(items))}
 	for _, item := range items {
 		resp.ActionSegments = append(resp.ActionSegments, segment{
-			ID:          item.ID,
-			Name:        item.Name,
-			Category:    item.Category,
-			Population:  item.Population,
-			BookedSlots: item.BookedSlots,
-			TotalSlots:  item.TotalSlots,
+			ID:               item.ID,
+			Name:             item.Name,
+			Category:         item.Category,
+			Population:       item.Population,
+			PopulationShare:  item.PopulationShare,
+			PopulationSource: item.PopulationSource,
+			BookedSlots:      item.BookedSlots,
+			TotalSlots:       item.TotalSlots,
 		})
 	}
 	writeJSON(w, http.StatusOK, resp)
@@ -640,6 +659,62 @@ func (a *App) handleSellerSegmentSlots(w http.ResponseWriter, r *http.Request, a
 	writeJSON(w, http.StatusOK, resp)
 }
 
+func (a *App) handleSellerSlotPerformanceCSV(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	sellerID, err := strconv.ParseInt(query.Get("seller_id"), 10, 64)
+	if err != nil || sellerID <= 0 {
+		writeJSONError(w, http.StatusBadRequest, "seller_id is required")
+		return
+	}
+	var promotionID int64
+	if raw := query.Get("promotion_id"); raw != "" {
+		promotionID, err = strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid promotion_id")
+			return
+		}
+	}
+	items, err := a.sellerService.GetSlotPerformance(r.Context(), sellerID, promotionID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="slot-performance-%d.csv"`, sellerID))
+	w.WriteHeader(http.StatusOK)
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"promotion_id", "promotion_name", "segment_id", "segment_name", "slot_id", "position",
+		"pricing_type", "product_id", "product_name", "price", "impressions", "clicks", "ctr", "cost_per_click"})
+	for _, item := range items {
+		_ = cw.Write([]string{
+			strconv.FormatInt(item.PromotionID, 10),
+			csvText(item.PromotionName),
+			strconv.FormatInt(item.SegmentID, 10),
+			csvText(item.SegmentName),
+			strconv.FormatInt(item.SlotID, 10),
+			strconv.Itoa(item.Position),
+			item.PricingType,
+			strconv.FormatInt(item.ProductID, 10),
+			csvText(item.ProductName),
+			strconv.FormatInt(item.Price, 10),
+			strconv.FormatInt(item.Impressions, 10),
+			strconv.FormatInt(item.Clicks, 10),
+			strconv.FormatFloat(item.CTR, 'f', 4, 64),
+			strconv.FormatFloat(item.CostPerClick, 'f', 2, 64),
+		})
+	}
+	cw.Flush()
+}
+
+// csvText neutralizes user-typed text that a spreadsheet would run as a formula.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
 func parseInt64PathParam(w http.ResponseWriter, raw string) (int64, bool) {
 	v, err := strconv.ParseInt(raw, 10, 64)
 	if err != nil {
@@ -677,10 +752,11 @@ func writeJSON(w http.ResponseWriter, status int, payload any) {
 
 func writeJSONError(w http.ResponseWriter, status int, message string) {
 	type errResp struct {
-		Code    int    `json:"code,omitempty"`
-		Message string `json:"message"`
+		Code      int    `json:"code,omitempty"`
+		Message   string `json:"message"`
+		RequestID string `json:"requestId,omitempty"`
 	}
-	writeJSON(w, status, errResp{Code: status, Message: message})
+	writeJSON(w, status, errResp{Code: status, Message: message, RequestID: w.Header().Get(logging.RequestIDHeader)})
 }
 
 func mapPromotionStatusToDashboardStatus(status entity.PromotionStatus) string {
@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Cache — хранилище read-through кэша витрины покупателя.
+// Значения хранятся сериализованными, чтобы за интерфейсом можно было держать Redis.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+	DeletePrefix(ctx context.Context, prefix string)
+}
+
+// Noop never stores anything; used when caching is disabled.
+type Noop struct{}
+
+func (Noop) Get(context.Context, string) ([]byte, bool)         { return nil, false }
+func (Noop) Set(context.Context, string, []byte, time.Duration) {}
+func (Noop) DeletePrefix(context.Context, string)               {}
+
+var _ Cache = Noop{}
+
+// GetOrLoad returns the cached value for key or calls load and stores its result.
+// Cache errors (bad payload) fall through to load.
+func GetOrLoad[T any](ctx context.Context, c Cache, key string, ttl time.Duration, load func() (T, error)) (T, error) {
+	if raw, ok := c.Get(ctx, key); ok {
+		var v T
+		if err := json.Unmarshal(raw, &v); err == nil {
+			return v, nil
+		}
+	}
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+	if raw, err := json.Marshal(v); err == nil {
+		c.Set(ctx, key, raw, ttl)
+	}
+	return v, nil
+}
+
+const activePromotionsPrefix = "buyer:active:"
+
+// ActivePromotionsKey — список запущенных акций для региона и платформы.
+func ActivePromotionsKey(region, platform string) string {
+	return activePromotionsPrefix + region + ":" + platform
+}
+
+// PromotionPrefix covers every buyer key of one promotion (segments, product lists).
+func PromotionPrefix(promotionID int64) string {
+	return "buyer:promo:" + strconv.FormatInt(promotionID, 10) + ":"
+}
+
+func SegmentsKey(promotionID int64) string {
+	return PromotionPrefix(promotionID) + "segments"
+}
+
+func SegmentProductsKey(promotionID, segmentID int64, query string) string {
+	return PromotionPrefix(promotionID) + "segment:" + strconv.FormatInt(segmentID, 10) + ":products:" + query
+}
+
+// InvalidatePromotion drops everything cached for the promotion and all active promotion lists.
+func InvalidatePromotion(ctx context.Context, c Cache, promotionID int64) {
+	c.DeletePrefix(ctx, PromotionPrefix(promotionID))
+	c.DeletePrefix(ctx, activePromotionsPrefix)
+}
@@ -0,0 +1,129 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	type step struct {
+		op    string // set | get | wait | prefix
+		key   string
+		ttl   time.Duration
+		found bool
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{name: "get after set", steps: []step{
+			{op: "set", key: "a"}, {op: "get", key: "a", found: true}, {op: "get", key: "b"},
+		}},
+		{name: "entry expires after ttl", steps: []step{
+			{op: "set", key: "a", ttl: time.Minute}, {op: "set", key: "b"},
+			{op: "wait"}, {op: "get", key: "a"}, {op: "get", key: "b", found: true},
+		}},
+		{name: "set renews ttl", steps: []step{
+			{op: "set", key: "a", ttl: time.Minute}, {op: "set", key: "a", ttl: 3 * time.Minute},
+			{op: "wait"}, {op: "get", key: "a", found: true},
+		}},
+		{name: "evicts least recently set", steps: []step{
+			{op: "set", key: "a"}, {op: "set", key: "b"}, {op: "set", key: "c"}, {op: "set", key: "d"},
+			{op: "get", key: "a"}, {op: "get", key: "b", found: true}, {op: "get", key: "d", found: true},
+		}},
+		{name: "get protects from eviction", steps: []step{
+			{op: "set", key: "a"}, {op: "set", key: "b"}, {op: "set", key: "c"},
+			{op: "get", key: "a", found: true}, {op: "set", key: "d"},
+			{op: "get", key: "a", found: true}, {op: "get", key: "b"},
+		}},
+		{name: "delete prefix", steps: []step{
+			{op: "set", key: "p:1:a"}, {op: "set", key: "p:1:b"}, {op: "set", key: "p:10:a"},
+			{op: "prefix", key: "p:1:"},
+			{op: "get", key: "p:1:a"}, {op: "get", key: "p:1:b"}, {op: "get", key: "p:10:a", found: true},
+		}},
+	}
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			c := NewLRU(3)
+			c.now = func() time.Time { return now }
+			for i, s := range tt.steps {
+				switch s.op {
+				case "set":
+					c.Set(ctx, s.key, []byte(s.key), s.ttl)
+				case "get":
+					v, found := c.Get(ctx, s.key)
+					if found != s.found || (found && string(v) != s.key) {
+						t.Fatalf("step %d: Get(%q) = %q, %v; want found %v", i, s.key, v, found, s.found)
+					}
+				case "wait":
+					now = now.Add(2 * time.Minute)
+				case "prefix":
+					c.DeletePrefix(ctx, s.key)
+				}
+			}
+			if c.ll.Len() != len(c.items) || c.ll.Len() > 3 {
+				t.Errorf("list has %d entries, index %d", c.ll.Len(), len(c.items))
+			}
+		})
+	}
+}
+
+func TestGetOrLoad(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(10)
+	calls := 0
+	load := func() ([]string, error) {
+		calls++
+		return []string{"x"}, nil
+	}
+	for i := 0; i < 2; i++ {
+		v, err := GetOrLoad(ctx, c, "k", time.Minute, load)
+		if err != nil || len(v) != 1 || v[0] != "x" {
+			t.Fatalf("GetOrLoad = %v, %v", v, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("load called %d times, want 1", calls)
+	}
+
+	// a payload that does not decode is reloaded and overwritten
+	c.Set(ctx, "bad", []byte("{"), time.Minute)
+	if v, err := GetOrLoad(ctx, c, "bad", time.Minute, load); err != nil || len(v) != 1 || calls != 2 {
+		t.Errorf("bad payload: %v, %v, calls %d", v, err, calls)
+	}
+
+	// load errors are returned and not cached
+	failed := errors.New("db down")
+	if _, err := GetOrLoad(ctx, c, "err", time.Minute, func() (int, error) { return 0, failed }); !errors.Is(err, failed) {
+		t.Errorf("err = %v", err)
+	}
+	if _, found := c.Get(ctx, "err"); found {
+		t.Error("failed load must not be cached")
+	}
+}
+
+func TestInvalidatePromotion(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(10)
+	keys := map[string]bool{ // key -> kept after invalidating promotion 1
+		SegmentsKey(1):                      false,
+		SegmentProductsKey(1, 5, "page=1"):  false,
+		ActivePromotionsKey("", ""):         false,
+		ActivePromotionsKey("msk", "ios"):   false,
+		SegmentsKey(2):                      true,
+		SegmentProductsKey(12, 5, "page=1"): true,
+	}
+	for key := range keys {
+		c.Set(ctx, key, []byte("1"), 0)
+	}
+	InvalidatePromotion(ctx, c, 1)
+	for key, kept := range keys {
+		if _, found := c.Get(ctx, key); found != kept {
+			t.Errorf("%s: found %v, want %v", key, found, kept)
+		}
+	}
+}
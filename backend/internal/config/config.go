@@ -3,19 +3,44 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
-	HTTPPort         int
-	GRPCPort         int
-	DSN              string
-	AIProvider       string
-	GeminiAPIKey     string
-	GeminiModel      string
-	GeminiAPIBaseURL string
-	GroqAPIKey       string
-	GroqModel        string
-	GroqAPIBaseURL   string
+	HTTPPort          int
+	GRPCPort          int
+	MetricsPort       int // внутренний порт /metrics, не публикуется наружу
+	DSN               string
+	AIProvider        string
+	GeminiAPIKey      string
+	GeminiModel       string
+	GeminiAPIBaseURL  string
+	GroqAPIKey        string
+	GroqModel         string
+	GroqAPIBaseURL    string
+	OpenAIAPIKey      string
+	OpenAIModel       string
+	OpenAIAPIBaseURL  string
+	OpenAIHeaders     map[string]string // OPENAI_HEADERS="Name: value; Name2: value2"
+	OpenAITimeout     time.Duration
+	OpenAIJSONMode    bool
+	AITokenPrices     map[string]TokenPrice // AI_TOKEN_PRICES="gemini=0.30/2.50,groq=0.05/0.08"
+	AICacheTTL        time.Duration         // 0 — без кэша генераций
+	AIRetryAttempts   int
+	AIBreakerLimit    int // подряд неудачных вызовов, после которых провайдер отключается
+	AIBreakerCooldown time.Duration
+	AIJobWorkers      int // воркеров асинхронных генераций в этом процессе, 0 — только ставить в очередь
+	AIJobTimeout      time.Duration
+	CacheSize         int // записей в LRU витрины покупателя, 0 — без кэша
+	CacheTTL          time.Duration
+	LogLevel          string // debug | info | warn | error
+	LogFormat         string // json | text
+	TracingExporter   string // none | stdout | otlp
+	TracingService    string
+	TracingRatio      float64
+	ShutdownTimeout   time.Duration
+	DrainDelay        time.Duration // пауза между снятием готовности и остановкой HTTP, пока балансировщик убирает инстанс
 }
 
 func Load() *Config {
@@ -31,6 +56,12 @@ func Load() *Config {
 			grpcPort = v
 		}
 	}
+	metricsPort := 9090
+	if p := os.Getenv("METRICS_PORT"); p != "" {
+		if v, err := strconv.Atoi(p); err == nil {
+			metricsPort = v
+		}
+	}
 	dsn := os.Getenv("DATABASE_DSN")
 	if dsn == "" { // TODO
 		dsn = "postgres://postgres:postgres@db:5432/seller_promotions?sslmode=disable"
@@ -55,16 +86,150 @@ func Load() *Config {
 	if groqAPIBaseURL == "" {
 		groqAPIBaseURL = "https://api.groq.com/openai/v1"
 	}
+	openAIAPIBaseURL := os.Getenv("OPENAI_API_BASE_URL")
+	if openAIAPIBaseURL == "" {
+		openAIAPIBaseURL = "http://localhost:11434/v1"
+	}
+	openAITimeout := 120 * time.Second
+	if v, err := time.ParseDuration(os.Getenv("OPENAI_TIMEOUT")); err == nil && v > 0 {
+		openAITimeout = v
+	}
+	openAIJSONMode := true
+	if v, err := strconv.ParseBool(os.Getenv("OPENAI_JSON_MODE")); err == nil {
+		openAIJSONMode = v
+	}
+	aiCacheTTL := 24 * time.Hour
+	if v, err := time.ParseDuration(os.Getenv("AI_CACHE_TTL")); err == nil && v >= 0 {
+		aiCacheTTL = v
+	}
+	aiRetryAttempts := 3
+	if v, err := strconv.Atoi(os.Getenv("AI_RETRY_ATTEMPTS")); err == nil && v > 0 {
+		aiRetryAttempts = v
+	}
+	aiBreakerLimit := 5
+	if v, err := strconv.Atoi(os.Getenv("AI_BREAKER_THRESHOLD")); err == nil && v > 0 {
+		aiBreakerLimit = v
+	}
+	aiBreakerCooldown := 30 * time.Second
+	if v, err := time.ParseDuration(os.Getenv("AI_BREAKER_COOLDOWN")); err == nil && v > 0 {
+		aiBreakerCooldown = v
+	}
+	aiJobWorkers := 2
+	if v, err := strconv.Atoi(os.Getenv("AI_JOB_WORKERS")); err == nil && v >= 0 {
+		aiJobWorkers = v
+	}
+	aiJobTimeout := 5 * time.Minute
+	if v, err := time.ParseDuration(os.Getenv("AI_JOB_TIMEOUT")); err == nil && v > 0 {
+		aiJobTimeout = v
+	}
+	cacheSize := 10000
+	if v, err := strconv.Atoi(os.Getenv("CACHE_SIZE")); err == nil && v >= 0 {
+		cacheSize = v
+	}
+	cacheTTL := 30 * time.Second
+	if v, err := time.ParseDuration(os.Getenv("CACHE_TTL")); err == nil {
+		cacheTTL = v
+	}
+	logFormat := os.Getenv("LOG_FORMAT")
+	if logFormat == "" {
+		logFormat = "json"
+	}
+	tracingExporter := os.Getenv("TRACING_EXPORTER")
+	if tracingExporter == "" {
+		tracingExporter = "none"
+	}
+	tracingService := os.Getenv("OTEL_SERVICE_NAME")
+	if tracingService == "" {
+		tracingService = "wildberries-backend"
+	}
+	tracingRatio := 1.0
+	if v, err := strconv.ParseFloat(os.Getenv("TRACING_SAMPLE_RATIO"), 64); err == nil && v >= 0 && v <= 1 {
+		tracingRatio = v
+	}
+	shutdownTimeout := 20 * time.Second
+	if v, err := time.ParseDuration(os.Getenv("SHUTDOWN_TIMEOUT")); err == nil && v > 0 {
+		shutdownTimeout = v
+	}
+	drainDelay := 5 * time.Second
+	if v, err := time.ParseDuration(os.Getenv("SHUTDOWN_DRAIN_DELAY")); err == nil && v >= 0 {
+		drainDelay = v
+	}
 	return &Config{
-		HTTPPort:         httpPort,
-		GRPCPort:         grpcPort,
-		DSN:              dsn,
-		AIProvider:       aiProvider,
-		GeminiAPIKey:     os.Getenv("GEMINI_API_KEY"),
-		GeminiModel:      geminiModel,
-		GeminiAPIBaseURL: geminiAPIBaseURL,
-		GroqAPIKey:       os.Getenv("GROQ_API_KEY"),
-		GroqModel:        groqModel,
-		GroqAPIBaseURL:   groqAPIBaseURL,
+		HTTPPort:          httpPort,
+		GRPCPort:          grpcPort,
+		MetricsPort:       metricsPort,
+		DSN:               dsn,
+		AIProvider:        aiProvider,
+		GeminiAPIKey:      os.Getenv("GEMINI_API_KEY"),
+		GeminiModel:       geminiModel,
+		GeminiAPIBaseURL:  geminiAPIBaseURL,
+		GroqAPIKey:        os.Getenv("GROQ_API_KEY"),
+		GroqModel:         groqModel,
+		GroqAPIBaseURL:    groqAPIBaseURL,
+		OpenAIAPIKey:      os.Getenv("OPENAI_API_KEY"),
+		OpenAIModel:       os.Getenv("OPENAI_MODEL"),
+		OpenAIAPIBaseURL:  openAIAPIBaseURL,
+		OpenAIHeaders:     parseHeaders(os.Getenv("OPENAI_HEADERS")),
+		OpenAITimeout:     openAITimeout,
+		OpenAIJSONMode:    openAIJSONMode,
+		AITokenPrices:     parseTokenPrices(os.Getenv("AI_TOKEN_PRICES")),
+		AICacheTTL:        aiCacheTTL,
+		AIRetryAttempts:   aiRetryAttempts,
+		AIBreakerLimit:    aiBreakerLimit,
+		AIBreakerCooldown: aiBreakerCooldown,
+		AIJobWorkers:      aiJobWorkers,
+		AIJobTimeout:      aiJobTimeout,
+		CacheSize:         cacheSize,
+		CacheTTL:          cacheTTL,
+		LogLevel:          os.Getenv("LOG_LEVEL"),
+		LogFormat:         logFormat,
+		TracingExporter:   tracingExporter,
+		TracingService:    tracingService,
+		TracingRatio:      tracingRatio,
+		ShutdownTimeout:   shutdownTimeout,
+		DrainDelay:        drainDelay,
+	}
+}
+
+// parseHeaders parses "Name: value; Name2: value2"; malformed pairs are skipped.
+func parseHeaders(raw string) map[string]string {
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(raw, ";") {
+		name, value, ok := strings.Cut(pair, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			continue
+		}
+		headers[name] = strings.TrimSpace(value)
+	}
+	return headers
+}
+
+// TokenPrice — цена провайдера в USD за миллион входных и выходных токенов
+type TokenPrice struct {
+	Input  float64
+	Output float64
+}
+
+// parseTokenPrices parses "provider=input/output,..."; malformed entries are skipped.
+func parseTokenPrices(raw string) map[string]TokenPrice {
+	prices := make(map[string]TokenPrice)
+	for _, entry := range strings.Split(raw, ",") {
+		provider, price, ok := strings.Cut(entry, "=")
+		provider = strings.ToLower(strings.TrimSpace(provider))
+		if !ok || provider == "" {
+			continue
+		}
+		in, out, ok := strings.Cut(price, "/")
+		if !ok {
+			continue
+		}
+		input, errIn := strconv.ParseFloat(strings.TrimSpace(in), 64)
+		output, errOut := strconv.ParseFloat(strings.TrimSpace(out), 64)
+		if errIn != nil || errOut != nil || input < 0 || output < 0 {
+			continue
+		}
+		prices[provider] = TokenPrice{Input: input, Output: output}
 	}
+	return prices
 }
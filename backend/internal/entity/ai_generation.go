@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// AIGenerationLog is one AI provider call from the audit log
+type AIGenerationLog struct {
+	ID               int64
+	Operation        string // themes, segments, questions, answer_tree, text
+	Provider         string
+	Model            string
+	Status           string // ok, invalid, failed, cached
+	Latency          time.Duration
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+	CostUSD          float64
+	Prompt           string
+	Response         string
+	Error            string
+	CreatedAt        time.Time
+}
+
+// AIUsageDay is the AI spend of one provider and model during one UTC day
+type AIUsageDay struct {
+	Day              time.Time
+	Provider         string
+	Model            string
+	Calls            int64
+	FailedCalls      int64
+	CachedCalls      int64
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+	CostUSD          float64
+	AvgLatency       time.Duration
+}
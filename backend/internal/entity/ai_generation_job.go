@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// AIGenerationJob is an asynchronous AI generation. When Status is done,
+// the result field matching Kind is filled.
+type AIGenerationJob struct {
+	ID         string
+	Kind       string // themes, segments, questions, answer_tree, text, promotion_draft
+	Status     string // queued, running, done, failed
+	Error      string
+	Attempts   int
+	CreatedAt  time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	Themes     []*ThemeItem
+	Segments   []*SegmentSuggestion
+	Questions  []*QuestionSuggestion
+	AnswerTree []*AnswerTreeNode
+	Text       string
+	// тексты сегментов, если задача генерировала их по segment_id или promotion_id
+	SegmentTexts []*SegmentText
+	// акция, созданная задачей promotion_draft
+	PromotionDraft *SavedPromotionDraft
+}
@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+// BuyerEventType is the kind of buyer engagement event
+type BuyerEventType string
+
+const (
+	BuyerEventPromotionView     BuyerEventType = "promotion_view"
+	BuyerEventQuizStart         BuyerEventType = "quiz_start"
+	BuyerEventQuestionAnswered  BuyerEventType = "question_answered"
+	BuyerEventSegmentResolved   BuyerEventType = "segment_resolved"
+	BuyerEventProductImpression BuyerEventType = "product_impression"
+	BuyerEventProductClick      BuyerEventType = "product_click"
+)
+
+// ParseBuyerEventType returns the event type and whether it is known
+func ParseBuyerEventType(s string) (BuyerEventType, bool) {
+	switch t := BuyerEventType(s); t {
+	case BuyerEventPromotionView, BuyerEventQuizStart, BuyerEventQuestionAnswered,
+		BuyerEventSegmentResolved, BuyerEventProductImpression, BuyerEventProductClick:
+		return t, true
+	}
+	return "", false
+}
+
+// BuyerEvent represents one tracked buyer action
+type BuyerEvent struct {
+	EventID     string
+	Type        BuyerEventType
+	PromotionID int64
+	SegmentID   int64
+	QuestionID  int64
+	OptionID    int64
+	ProductID   int64
+	SlotID      int64
+	SessionID   string
+	Properties  map[string]string
+	OccurredAt  time.Time
+}
@@ -0,0 +1,8 @@
+package entity
+
+// CategoryStat is a catalog category with its product supply
+type CategoryStat struct {
+	Name     string
+	Products int64
+	Sellers  int64
+}
@@ -0,0 +1,83 @@
+package entity
+
+import "time"
+
+// FunnelStages holds unique buyer sessions reaching each funnel stage
+type FunnelStages struct {
+	Views           int64
+	QuizStarts      int64
+	Completions     int64 // started the quiz and got a segment
+	SegmentResolved int64
+	ProductClicks   int64
+}
+
+// SegmentFunnel is the funnel of sessions that ended up in a segment
+type SegmentFunnel struct {
+	SegmentID   int64
+	SegmentName string
+	Stages      FunnelStages
+}
+
+// PromotionFunnel is the promotion-wide funnel plus its per-segment breakdown
+type PromotionFunnel struct {
+	PromotionID int64
+	From        time.Time
+	To          time.Time
+	Total       FunnelStages
+	Segments    []*SegmentFunnel
+}
+
+// SegmentShare is how many quiz outcomes landed in a segment
+type SegmentShare struct {
+	SegmentID   int64
+	SegmentName string
+	Sessions    int64
+	Share       float64 // 0..1
+}
+
+// TimeSeriesPoint is the event count of one type in one time bucket
+type TimeSeriesPoint struct {
+	BucketStart time.Time
+	EventType   BuyerEventType
+	Count       int64
+}
+
+// Population estimate sources
+const (
+	PopulationSourceObserved   = "observed"   // resolved quiz outcomes of this promotion
+	PopulationSourceHistorical = "historical" // same-theme promotions, split by simulated quiz paths
+	PopulationSourceSimulation = "simulation" // only the share of simulated quiz paths is known
+)
+
+// SegmentPopulation is the estimated number of buyers landing in a segment
+type SegmentPopulation struct {
+	SegmentID  int64
+	Population int64
+	Share      float64 // 0..1 of all buyers of the promotion
+	Source     string
+}
+
+// PromotionCohort is one completed promotion in a side-by-side comparison
+type PromotionCohort struct {
+	PromotionID          int64
+	Name                 string
+	Theme                string
+	IdentificationMode   IdentificationMode
+	PricingModel         PricingModel
+	DateFrom             string
+	DateTo               string
+	TotalSlots           int64
+	FilledSlots          int64
+	FillRate             float64 // filled / total slots
+	AvgClearingPrice     float64 // average paid price of filled slots
+	Bets                 int64
+	Bidders              int64
+	ApprovedApplications int64
+	RejectedApplications int64
+	RejectionRate        float64 // rejected / resolved applications
+	ViewSessions         int64
+	ResolvedSessions     int64
+	ClickSessions        int64
+	QuizCompletionRate   float64 // sessions with a segment / sessions with a view
+	BuyerConversion      float64 // sessions with a product click / sessions with a view
+}
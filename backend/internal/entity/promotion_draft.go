@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrAmbiguousSegmentName — по имени сегмента нельзя однозначно сослаться на него из дерева ответов
+var ErrAmbiguousSegmentName = errors.New("ambiguous segment name")
+
+// PromotionDraft is everything AI generates for a new promotion from a theme.
+// Answer tree segment targets are "segment:segment-<n>", 1-based indexes into Segments.
+type PromotionDraft struct {
+	Theme       string
+	Name        string
+	Description string
+	Segments    []*SegmentSuggestion
+	Questions   []*QuestionSuggestion
+	AnswerTree  []*AnswerTreeNode
+}
+
+// SavedPromotionDraft is a draft stored by an async generation job.
+type SavedPromotionDraft struct {
+	PromotionID int64
+	SegmentIDs  []int64 // в порядке Draft.Segments
+	Draft       *PromotionDraft
+}
+
+// CheckSegmentNames rejects segment names that differ only in case or surrounding spaces and
+// numeric names: the stored answer tree targets segments by name, and a number there is read
+// as a segment id or position.
+func CheckSegmentNames(segments []*SegmentSuggestion) error {
+	seen := make(map[string]struct{}, len(segments))
+	for _, seg := range segments {
+		name := strings.ToLower(strings.TrimSpace(seg.Name))
+		if _, err := strconv.ParseInt(name, 10, 64); err == nil {
+			return fmt.Errorf("%w: %q would be read as a segment id", ErrAmbiguousSegmentName, seg.Name)
+		}
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("%w: %q is not unique", ErrAmbiguousSegmentName, seg.Name)
+		}
+		seen[name] = struct{}{}
+	}
+	return nil
+}
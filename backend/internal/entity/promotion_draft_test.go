@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckSegmentNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+		ok    bool
+	}{
+		{name: "unique", names: []string{"Овен", "Телец"}, ok: true},
+		{name: "name with digits", names: []string{"Овен 2", "Телец"}, ok: true},
+		{name: "same name", names: []string{"Овен", "Овен"}},
+		{name: "differs in case and spaces", names: []string{"Овен", " овен "}},
+		{name: "numeric", names: []string{"Овен", "2"}},
+		{name: "negative number", names: []string{"-3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segments := make([]*SegmentSuggestion, 0, len(tt.names))
+			for _, name := range tt.names {
+				segments = append(segments, &SegmentSuggestion{Name: name})
+			}
+			err := CheckSegmentNames(segments)
+			if tt.ok != (err == nil) {
+				t.Fatalf("err = %v, want ok %v", err, tt.ok)
+			}
+			if err != nil && !errors.Is(err, ErrAmbiguousSegmentName) {
+				t.Errorf("err = %v, want ErrAmbiguousSegmentName", err)
+			}
+		})
+	}
+}
@@ -18,4 +18,7 @@ type Promotion struct {
 	BidStep            *int64
 	StopFactors        StopFactors
 	FixedPrices        map[int32]int64
+	Priority           int      // higher is shown first when several promotions run at once
+	TargetRegions      []string // empty = all regions
+	TargetPlatforms    []string // empty = all platforms
 }
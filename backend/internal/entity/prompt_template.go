@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// PromptTemplate is an editable AI prompt. Version 0 is the built-in prompt.
+type PromptTemplate struct {
+	Name          string
+	Variables     []string
+	ActiveVersion int
+	LatestVersion int
+}
+
+// PromptTemplateVersion is one saved body of a prompt
+type PromptTemplateVersion struct {
+	Name      string
+	Version   int
+	Body      string
+	Comment   string
+	Active    bool
+	CreatedAt time.Time // zero for the built-in prompt
+}
+
+// PromptPin is a prompt version fixed for one promotion
+type PromptPin struct {
+	PromotionID int64
+	Name        string
+	Version     int
+	CreatedAt   time.Time
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// Источник версии текста сегмента
+const (
+	SegmentTextSourceInitial = "initial"
+	SegmentTextSourceAI      = "ai"
+	SegmentTextSourceRevert  = "revert"
+)
+
+// SegmentText is a version of the buyer-facing copy of a segment.
+// Version is 0 for a generated text that was not saved.
+type SegmentText struct {
+	SegmentID int64     `json:"segment_id"`
+	Version   int       `json:"version"`
+	Text      string    `json:"text"`
+	Source    string    `json:"source"`
+	CreatedAt time.Time `json:"created_at"`
+}
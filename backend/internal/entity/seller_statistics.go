@@ -2,7 +2,51 @@ package entity
 
 type SellerStatistics struct {
 	ActivePromotions int64
-	FreeSlots       int64
-	OccupiedSlots   int64
-	TotalViews      int64
+	FreeSlots        int64
+	OccupiedSlots    int64 // over all sellers, FreeSlots + OccupiedSlots are the market slots in use
+	TotalViews       int64
+
+	// Seller-scoped, zero when no seller is given
+	WonSlots           int64
+	ModerationSlots    int64
+	CommittedAmount    int64 // price of won slots and slots in moderation
+	ActiveBets         int64
+	ActiveBetsAmount   int64 // seller's best bid per slot in running auctions
+	WinRate            float64
+	ProductImpressions int64
+	ProductClicks      int64
+	Promotions         []*SellerPromotionStatistics
+}
+
+// SellerPromotionStatistics is the per-promotion breakdown of seller statistics
+type SellerPromotionStatistics struct {
+	PromotionID        int64
+	PromotionName      string
+	PromotionStatus    string
+	WonSlots           int64
+	ModerationSlots    int64
+	CommittedAmount    int64
+	ActiveBets         int64
+	ActiveBetsAmount   int64
+	WinRate            float64
+	ProductImpressions int64
+	ProductClicks      int64
+}
+
+// SlotPerformance is how a seller's product performed in an occupied slot
+type SlotPerformance struct {
+	PromotionID   int64
+	PromotionName string
+	SegmentID     int64
+	SegmentName   string
+	SlotID        int64
+	Position      int
+	PricingType   string
+	ProductID     int64
+	ProductName   string
+	Price         int64 // paid slot price
+	Impressions   int64
+	Clicks        int64
+	CTR           float64 // clicks / impressions
+	CostPerClick  float64 // price / clicks, zero without clicks
 }
@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor takes the request id from incoming metadata (or generates one),
+// puts it into the handler context, returns it in the response header and in error details,
+// and logs every call.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(RequestIDMetadataKey); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		id = SanitizeRequestID(id)
+		ctx = WithRequestID(ctx, id)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, id))
+
+		startedAt := time.Now()
+		resp, err := handler(ctx, req)
+		code := status.Code(err)
+
+		attrs := []any{
+			slog.String("method", info.FullMethod),
+			slog.String("code", code.String()),
+			slog.Duration("duration", time.Since(startedAt)),
+		}
+		switch {
+		case err == nil:
+			slog.InfoContext(ctx, "grpc request", attrs...)
+		case code == codes.Unknown || code == codes.Internal:
+			slog.ErrorContext(ctx, "grpc request", append(attrs, slog.String("error", err.Error()))...)
+		default:
+			slog.WarnContext(ctx, "grpc request", append(attrs, slog.String("error", err.Error()))...)
+		}
+		if err != nil {
+			if withID, detailErr := status.Convert(err).WithDetails(&errdetails.RequestInfo{RequestId: id}); detailErr == nil {
+				err = withID.Err()
+			}
+		}
+		return resp, err
+	}
+}
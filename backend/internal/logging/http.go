@@ -0,0 +1,71 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// HTTPMiddleware assigns the request id at the HTTP edge (taken from X-Request-ID or generated),
+// returns it in the response header and logs the request.
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startedAt := time.Now()
+		requestID := SanitizeRequestID(r.Header.Get(RequestIDHeader))
+		r.Header.Set(RequestIDHeader, requestID)
+		w.Header().Set(RequestIDHeader, requestID)
+		ctx := WithRequestID(r.Context(), requestID)
+
+		logger := newResponseLogger(w)
+		next.ServeHTTP(logger, r.WithContext(ctx))
+
+		level := slog.LevelInfo
+		if logger.statusCode >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		slog.Log(ctx, level, "http request",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.RequestURI()),
+			slog.Int("status", logger.statusCode),
+			slog.Int("bytes", logger.bytes),
+			slog.Duration("duration", time.Since(startedAt)),
+			slog.String("remote", r.RemoteAddr),
+		)
+	})
+}
+
+// GatewayMetadata passes the request id assigned at the HTTP edge to gRPC handlers as metadata;
+// it is meant for runtime.WithMetadata of the gateway mux.
+func GatewayMetadata(ctx context.Context, _ *http.Request) metadata.MD {
+	if id := RequestID(ctx); id != "" {
+		return metadata.Pairs(RequestIDMetadataKey, id)
+	}
+	return nil
+}
+
+type responseLogger struct {
+	http.ResponseWriter
+	statusCode int
+	bytes      int
+}
+
+func newResponseLogger(w http.ResponseWriter) *responseLogger {
+	return &responseLogger{
+		ResponseWriter: w,
+		statusCode:     http.StatusOK,
+	}
+}
+
+func (l *responseLogger) WriteHeader(code int) {
+	l.statusCode = code
+	l.ResponseWriter.WriteHeader(code)
+}
+
+func (l *responseLogger) Write(data []byte) (int, error) {
+	n, err := l.ResponseWriter.Write(data)
+	l.bytes += n
+	return n, err
+}
@@ -0,0 +1,208 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"wildberries/internal/logging"
+	buyerpb "wildberries/pkg/buyer"
+)
+
+var generatedID = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+func TestSanitizeRequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		keep string // expected id, empty when a new one must be generated
+	}{
+		{name: "valid", id: "req-42_abc.DEF", keep: "req-42_abc.DEF"},
+		{name: "surrounding spaces trimmed", id: "  req-42 \t", keep: "req-42"},
+		{name: "max length", id: strings.Repeat("a", 128), keep: strings.Repeat("a", 128)},
+		{name: "empty", id: ""},
+		{name: "blank", id: "   "},
+		{name: "oversized", id: strings.Repeat("a", 129)},
+		{name: "inner space", id: "req 42"},
+		{name: "newline", id: "req\n42"},
+		{name: "control char", id: "req\x0042"},
+		{name: "non-ascii", id: "запрос"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := logging.SanitizeRequestID(tt.id)
+			if tt.keep != "" {
+				if got != tt.keep {
+					t.Fatalf("SanitizeRequestID(%q) = %q, want %q", tt.id, got, tt.keep)
+				}
+				return
+			}
+			if !generatedID.MatchString(got) {
+				t.Fatalf("SanitizeRequestID(%q) = %q, want a generated id", tt.id, got)
+			}
+		})
+	}
+}
+
+func TestRequestIDContext(t *testing.T) {
+	if id := logging.RequestID(context.Background()); id != "" {
+		t.Fatalf("RequestID of empty context = %q", id)
+	}
+	ctx := logging.WithRequestID(context.Background(), "req-1")
+	if id := logging.RequestID(ctx); id != "req-1" {
+		t.Fatalf("RequestID = %q, want req-1", id)
+	}
+}
+
+type identificationServer struct {
+	buyerpb.UnimplementedIdentificationServiceServer
+
+	mu  sync.Mutex
+	ids []string
+}
+
+func (s *identificationServer) StartIdentification(ctx context.Context, _ *buyerpb.StartIdentificationRequest) (*buyerpb.StartIdentificationResponse, error) {
+	s.mu.Lock()
+	s.ids = append(s.ids, logging.RequestID(ctx))
+	s.mu.Unlock()
+	slog.InfoContext(ctx, "handled")
+	return &buyerpb.StartIdentificationResponse{}, nil
+}
+
+func (s *identificationServer) lastID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.ids) == 0 {
+		return ""
+	}
+	return s.ids[len(s.ids)-1]
+}
+
+// TestRequestIDReachesGRPCHandler wires the request id the way production does: HTTP middleware,
+// gateway metadata, gRPC interceptor. The handler and its logs must see the id returned to the client.
+func TestRequestIDReachesGRPCHandler(t *testing.T) {
+	var logs syncBuffer
+	prevLogger := slog.Default()
+	slog.SetDefault(logging.NewLogger(&logs, "info", "json"))
+	t.Cleanup(func() { slog.SetDefault(prevLogger) })
+
+	srv := &identificationServer{}
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(logging.UnaryServerInterceptor()))
+	buyerpb.RegisterIdentificationServiceServer(grpcServer, srv)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	gwmux := runtime.NewServeMux(runtime.WithMetadata(logging.GatewayMetadata))
+	if err := buyerpb.RegisterIdentificationServiceHandler(context.Background(), gwmux, conn); err != nil {
+		t.Fatal(err)
+	}
+	edge := httptest.NewServer(logging.HTTPMiddleware(gwmux))
+	defer edge.Close()
+
+	tests := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{name: "valid id kept", header: "client-req-1", keep: true},
+		{name: "missing id generated", header: ""},
+		{name: "bad id replaced", header: "bad id"},
+		{name: "oversized id replaced", header: strings.Repeat("x", 200)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs.Reset()
+			req, err := http.NewRequest(http.MethodPost, edge.URL+"/identification/start", strings.NewReader(`{"promotionId":1}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.header != "" {
+				req.Header.Set(logging.RequestIDHeader, tt.header)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d", resp.StatusCode)
+			}
+
+			id := resp.Header.Get(logging.RequestIDHeader)
+			if tt.keep && id != tt.header {
+				t.Fatalf("response id = %q, want %q", id, tt.header)
+			}
+			if !tt.keep && !generatedID.MatchString(id) {
+				t.Fatalf("response id = %q, want a generated id", id)
+			}
+			if got := srv.lastID(); got != id {
+				t.Fatalf("handler saw id %q, response carries %q", got, id)
+			}
+			for _, msg := range []string{"handled", "grpc request"} {
+				if got := logs.requestID(t, msg); got != id {
+					t.Fatalf("%q logged with request_id %q, want %q", msg, got, id)
+				}
+			}
+		})
+	}
+}
+
+// syncBuffer collects log lines written from the HTTP and gRPC goroutines.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf.Reset()
+}
+
+// requestID returns the request_id of the first record with the message.
+func (b *syncBuffer) requestID(t *testing.T, msg string) string {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		var record struct {
+			Msg       string `json:"msg"`
+			RequestID string `json:"request_id"`
+		}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("log line %q: %v", line, err)
+		}
+		if record.Msg == msg {
+			return record.RequestID
+		}
+	}
+	t.Fatalf("no %q record in logs:\n%s", msg, b.buf.String())
+	return ""
+}
@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"wildberries/internal/repository"
+)
+
+// domainQueryTimeout bounds the database query behind the domain gauges.
+const domainQueryTimeout = 2 * time.Second
+
+// domainCacheTTL is how long domain gauges are reused, so frequent or parallel
+// scrapes (several Prometheus replicas) do not each hit the database.
+const domainCacheTTL = 30 * time.Second
+
+var (
+	poolAcquired      = prometheus.NewDesc(namespace+"_pgxpool_acquired_conns", "Connections currently in use.", nil, nil)
+	poolIdle          = prometheus.NewDesc(namespace+"_pgxpool_idle_conns", "Idle connections.", nil, nil)
+	poolTotal         = prometheus.NewDesc(namespace+"_pgxpool_total_conns", "Total connections in the pool.", nil, nil)
+	poolMax           = prometheus.NewDesc(namespace+"_pgxpool_max_conns", "Maximum pool size.", nil, nil)
+	poolAcquires      = prometheus.NewDesc(namespace+"_pgxpool_acquires_total", "Successful connection acquires.", nil, nil)
+	poolEmptyWaits    = prometheus.NewDesc(namespace+"_pgxpool_empty_acquires_total", "Acquires that waited for a connection.", nil, nil)
+	poolAcquireTime   = prometheus.NewDesc(namespace+"_pgxpool_acquire_duration_seconds_total", "Total time spent acquiring connections.", nil, nil)
+	poolCanceled      = prometheus.NewDesc(namespace+"_pgxpool_canceled_acquires_total", "Acquires canceled by context.", nil, nil)
+	runningPromos     = prometheus.NewDesc(namespace+"_running_promotions", "Promotions currently running.", nil, nil)
+	openAuctions      = prometheus.NewDesc(namespace+"_open_auctions", "Auctions accepting bids right now.", nil, nil)
+	pendingModeration = prometheus.NewDesc(namespace+"_pending_moderation", "Applications waiting for moderation.", nil, nil)
+)
+
+// PoolCollector exports pgxpool statistics.
+type PoolCollector struct {
+	pool *pgxpool.Pool
+}
+
+func NewPoolCollector(pool *pgxpool.Pool) *PoolCollector {
+	return &PoolCollector{pool: pool}
+}
+
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{poolAcquired, poolIdle, poolTotal, poolMax, poolAcquires, poolEmptyWaits, poolAcquireTime, poolCanceled} {
+		ch <- d
+	}
+}
+
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	st := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(poolAcquired, prometheus.GaugeValue, float64(st.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(poolIdle, prometheus.GaugeValue, float64(st.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(poolTotal, prometheus.GaugeValue, float64(st.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(poolMax, prometheus.GaugeValue, float64(st.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(poolAcquires, prometheus.CounterValue, float64(st.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolEmptyWaits, prometheus.CounterValue, float64(st.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolAcquireTime, prometheus.CounterValue, st.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(poolCanceled, prometheus.CounterValue, float64(st.CanceledAcquireCount()))
+}
+
+// DomainCollector exports business gauges read from the database at most once per domainCacheTTL.
+type DomainCollector struct {
+	repo repository.MetricsRepository
+
+	mu        sync.Mutex
+	row       *repository.DomainGaugesRow
+	fetchedAt time.Time
+}
+
+func NewDomainCollector(repo repository.MetricsRepository) *DomainCollector {
+	return &DomainCollector{repo: repo}
+}
+
+func (c *DomainCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- runningPromos
+	ch <- openAuctions
+	ch <- pendingModeration
+}
+
+func (c *DomainCollector) Collect(ch chan<- prometheus.Metric) {
+	row := c.gauges()
+	if row == nil {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(runningPromos, prometheus.GaugeValue, float64(row.RunningPromotions))
+	ch <- prometheus.MustNewConstMetric(openAuctions, prometheus.GaugeValue, float64(row.OpenAuctions))
+	ch <- prometheus.MustNewConstMetric(pendingModeration, prometheus.GaugeValue, float64(row.PendingModeration))
+}
+
+// gauges returns the cached row, refreshing it when stale. A failed refresh is cached too:
+// without the database only these gauges are missing and it is not retried on every scrape.
+func (c *DomainCollector) gauges() *repository.DomainGaugesRow {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.fetchedAt.IsZero() && time.Since(c.fetchedAt) < domainCacheTTL {
+		return c.row
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), domainQueryTimeout)
+	defer cancel()
+	row, err := c.repo.DomainGauges(ctx)
+	if err != nil {
+		slog.Warn("domain metrics", slog.String("error", err.Error()))
+		row = nil
+	}
+	c.row, c.fetchedAt = row, time.Now()
+	return c.row
+}
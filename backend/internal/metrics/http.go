@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// unmatchedRoute labels requests no handler claimed, so scanners cannot grow the label set.
+const unmatchedRoute = "other"
+
+type routeKey struct{}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.statusCode = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// HTTPMiddleware counts HTTP requests and observes their latency per route.
+// The route is the pattern reported by the handler through SetRoute; the request span
+// is renamed after it too, since the pattern is only known once the request is routed.
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startedAt := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		var pattern string
+		next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), routeKey{}, &pattern)))
+
+		route := Route(pattern)
+		httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(rec.statusCode)).Inc()
+		httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(startedAt).Seconds())
+		trace.SpanFromContext(r.Context()).SetName(r.Method + " " + route)
+	})
+}
+
+// SetRoute reports the pattern that matched the request, e.g. "/admin/promotions/{id}".
+func SetRoute(ctx context.Context, pattern string) {
+	if p, ok := ctx.Value(routeKey{}).(*string); ok {
+		*p = pattern
+	}
+}
+
+// GatewayRoute is a grpc-gateway middleware reporting the matched path template.
+func GatewayRoute(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+			SetRoute(r.Context(), pattern.String())
+		}
+		next(w, r, pathParams)
+	}
+}
+
+// Route turns a matched pattern into a label: gateway variables "{id=*}" become "{id}",
+// and requests without a pattern are collapsed into "other".
+func Route(pattern string) string {
+	if pattern == "" {
+		return unmatchedRoute
+	}
+	return strings.ReplaceAll(pattern, "=*}", "}")
+}
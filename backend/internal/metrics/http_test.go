@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRoute(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{pattern: "", want: "other"},
+		{pattern: "/healthz", want: "/healthz"},
+		{pattern: "/admin/promotions/{id=*}", want: "/admin/promotions/{id}"},
+		{pattern: "/admin/promotions/{promotion_id=*}/segments/{segment_id=*}", want: "/admin/promotions/{promotion_id}/segments/{segment_id}"},
+		{pattern: "/seller/actions/{id}/segments", want: "/seller/actions/{id}/segments"},
+	}
+	for _, tt := range tests {
+		if got := Route(tt.pattern); got != tt.want {
+			t.Errorf("Route(%q) = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestHTTPMiddlewareRoute(t *testing.T) {
+	handler := HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/known/42" {
+			SetRoute(r.Context(), "/known/{id}")
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	for _, path := range []string{"/known/42", "/wp-admin/1", "/wp-admin/2"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	if got := testCount(t, "GET", "/known/{id}", "200"); got != 1 {
+		t.Errorf("known route count = %v, want 1", got)
+	}
+	if got := testCount(t, "GET", "other", "404"); got != 2 {
+		t.Errorf("unmatched route count = %v, want 2", got)
+	}
+}
+
+func testCount(t *testing.T, labels ...string) float64 {
+	t.Helper()
+	counter, err := httpRequests.GetMetricWithLabelValues(labels...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testutil.ToFloat64(counter)
+}
@@ -0,0 +1,72 @@
+// Package metrics exposes Prometheus metrics of the backend on /metrics.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "wildberries"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "code"})
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by method and route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "gRPC requests by full method and status code.",
+	}, []string{"method", "code"})
+	grpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "gRPC request latency by full method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	aiCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ai",
+		Name:      "provider_calls_total",
+		Help:      "AI provider calls by provider and result (ok | error).",
+	}, []string{"provider", "result"})
+	aiDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ai",
+		Name:      "provider_call_duration_seconds",
+		Help:      "AI provider call latency by provider.",
+		Buckets:   []float64{0.25, 0.5, 1, 2, 5, 10, 20, 40, 70},
+	}, []string{"provider"})
+)
+
+// Handler serves the default Prometheus registry.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveAICall records one AI provider call.
+func ObserveAICall(provider string, duration time.Duration, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	aiCalls.WithLabelValues(provider, result).Inc()
+	aiDuration.WithLabelValues(provider).Observe(duration.Seconds())
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AIGenerationCachePostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewAIGenerationCachePostgres(pool *pgxpool.Pool) *AIGenerationCachePostgres {
+	return &AIGenerationCachePostgres{pool: pool}
+}
+
+func (r *AIGenerationCachePostgres) Get(ctx context.Context, provider, model, promptHash string) (*AIGenerationCacheRow, error) {
+	row := &AIGenerationCacheRow{}
+	err := r.pool.QueryRow(ctx, `
+		SELECT provider, model, prompt_hash, kind, raw_json, created_at::text
+		FROM ai_generation_cache
+		WHERE provider = $1 AND model = $2 AND prompt_hash = $3 AND expires_at > now()`,
+		provider, model, promptHash,
+	).Scan(&row.Provider, &row.Model, &row.PromptHash, &row.Kind, &row.RawJSON, &row.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// Put stores or replaces the entry; a forced regeneration overwrites the cached answer.
+func (r *AIGenerationCachePostgres) Put(ctx context.Context, row *AIGenerationCacheRow, ttl time.Duration) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO ai_generation_cache (provider, model, prompt_hash, kind, raw_json, expires_at)
+		VALUES ($1, $2, $3, $4, $5, now() + $6 * interval '1 millisecond')
+		ON CONFLICT (provider, model, prompt_hash) DO UPDATE SET
+			kind = EXCLUDED.kind,
+			raw_json = EXCLUDED.raw_json,
+			created_at = now(),
+			expires_at = EXCLUDED.expires_at`,
+		row.Provider, row.Model, row.PromptHash, row.Kind, row.RawJSON, ttl.Milliseconds(),
+	)
+	return err
+}
+
+func (r *AIGenerationCachePostgres) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM ai_generation_cache WHERE expires_at <= now()`)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+var _ AIGenerationCacheRepository = (*AIGenerationCachePostgres)(nil)
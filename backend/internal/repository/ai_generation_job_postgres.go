@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AIGenerationJobPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewAIGenerationJobPostgres(pool *pgxpool.Pool) *AIGenerationJobPostgres {
+	return &AIGenerationJobPostgres{pool: pool}
+}
+
+const aiGenerationJobColumns = `id, kind, status, request, result, error, attempts, created_at, started_at, finished_at`
+
+func scanAIGenerationJob(row pgx.Row) (*AIGenerationJobRow, error) {
+	job := &AIGenerationJobRow{}
+	err := row.Scan(&job.ID, &job.Kind, &job.Status, &job.Request, &job.Result, &job.Error,
+		&job.Attempts, &job.CreatedAt, &job.StartedAt, &job.FinishedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (r *AIGenerationJobPostgres) Create(ctx context.Context, row *AIGenerationJobRow) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO ai_generation_job (id, kind, request)
+		VALUES ($1, $2, $3)`,
+		row.ID, row.Kind, row.Request,
+	)
+	return err
+}
+
+func (r *AIGenerationJobPostgres) Get(ctx context.Context, id string) (*AIGenerationJobRow, error) {
+	return scanAIGenerationJob(r.pool.QueryRow(ctx, `
+		SELECT `+aiGenerationJobColumns+`
+		FROM ai_generation_job
+		WHERE id = $1`, id))
+}
+
+// Claim takes the oldest pending job; SKIP LOCKED lets several workers and instances share the queue.
+func (r *AIGenerationJobPostgres) Claim(ctx context.Context, lease time.Duration) (*AIGenerationJobRow, error) {
+	return scanAIGenerationJob(r.pool.QueryRow(ctx, `
+		UPDATE ai_generation_job SET
+			status = 'running',
+			attempts = attempts + 1,
+			started_at = now(),
+			locked_until = now() + $1 * interval '1 millisecond'
+		WHERE id = (
+			SELECT id FROM ai_generation_job
+			WHERE status = 'queued' OR (status = 'running' AND locked_until < now())
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+aiGenerationJobColumns, lease.Milliseconds()))
+}
+
+func (r *AIGenerationJobPostgres) Complete(ctx context.Context, id string, result []byte) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE ai_generation_job
+		SET status = 'done', result = $2, error = '', locked_until = NULL, finished_at = now()
+		WHERE id = $1 AND status = 'running'`,
+		id, result,
+	)
+	return err
+}
+
+func (r *AIGenerationJobPostgres) Fail(ctx context.Context, id, errText string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE ai_generation_job
+		SET status = 'failed', error = $2, locked_until = NULL, finished_at = now()
+		WHERE id = $1 AND status = 'running'`,
+		id, errText,
+	)
+	return err
+}
+
+// Requeue gives back the attempt counted by Claim: an interrupted run is not a failed one.
+func (r *AIGenerationJobPostgres) Requeue(ctx context.Context, id string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE ai_generation_job
+		SET status = 'queued', attempts = attempts - 1, locked_until = NULL
+		WHERE id = $1 AND status = 'running'`,
+		id,
+	)
+	return err
+}
+
+func (r *AIGenerationJobPostgres) DeleteFinished(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM ai_generation_job WHERE finished_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+var _ AIGenerationJobRepository = (*AIGenerationJobPostgres)(nil)
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AIGenerationLogPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewAIGenerationLogPostgres(pool *pgxpool.Pool) *AIGenerationLogPostgres {
+	return &AIGenerationLogPostgres{pool: pool}
+}
+
+func (r *AIGenerationLogPostgres) Insert(ctx context.Context, row *AIGenerationLogRow) error {
+	return r.pool.QueryRow(ctx, `
+		INSERT INTO ai_generation_log (operation, provider, model, status, latency_ms,
+			prompt_tokens, completion_tokens, total_tokens, cost_usd, prompt, response, error)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id, created_at`,
+		row.Operation, row.Provider, row.Model, row.Status, row.LatencyMs,
+		row.PromptTokens, row.CompletionTokens, row.TotalTokens, row.CostUSD,
+		row.Prompt, row.Response, row.Error,
+	).Scan(&row.ID, &row.CreatedAt)
+}
+
+// List returns log entries newest first and the total number of matching entries.
+func (r *AIGenerationLogPostgres) List(ctx context.Context, filter AIGenerationLogFilter) ([]*AIGenerationLogRow, int64, error) {
+	conds := []string{"TRUE"}
+	args := []any{}
+	addCond := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.Operation != "" {
+		addCond("operation = $%d", filter.Operation)
+	}
+	if filter.Provider != "" {
+		addCond("provider = $%d", filter.Provider)
+	}
+	if filter.Status != "" {
+		addCond("status = $%d", filter.Status)
+	}
+	if !filter.From.IsZero() {
+		addCond("created_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		addCond("created_at < $%d", filter.To)
+	}
+	where := strings.Join(conds, " AND ")
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, `SELECT count(*) FROM ai_generation_log WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	rows, err := r.pool.Query(ctx, fmt.Sprintf(`
+		SELECT id, operation, provider, model, status, latency_ms, prompt_tokens, completion_tokens,
+			total_tokens, cost_usd::float8, prompt, response, error, created_at
+		FROM ai_generation_log
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var out []*AIGenerationLogRow
+	for rows.Next() {
+		row := &AIGenerationLogRow{}
+		if err := rows.Scan(&row.ID, &row.Operation, &row.Provider, &row.Model, &row.Status, &row.LatencyMs,
+			&row.PromptTokens, &row.CompletionTokens, &row.TotalTokens, &row.CostUSD,
+			&row.Prompt, &row.Response, &row.Error, &row.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		out = append(out, row)
+	}
+	return out, total, rows.Err()
+}
+
+// DailyUsage aggregates calls per UTC day, provider and model in [from, to).
+func (r *AIGenerationLogPostgres) DailyUsage(ctx context.Context, from, to time.Time) ([]*AIUsageDayRow, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT date_trunc('day', created_at AT TIME ZONE 'UTC') AS day, provider, model,
+			count(*),
+			count(*) FILTER (WHERE status IN ('failed', 'invalid')),
+			count(*) FILTER (WHERE status = 'cached'),
+			COALESCE(sum(prompt_tokens), 0),
+			COALESCE(sum(completion_tokens), 0),
+			COALESCE(sum(total_tokens), 0),
+			COALESCE(sum(cost_usd), 0)::float8,
+			COALESCE(avg(latency_ms) FILTER (WHERE status <> 'cached'), 0)::float8
+		FROM ai_generation_log
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY 1, 2, 3
+		ORDER BY 1, 2, 3`, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*AIUsageDayRow
+	for rows.Next() {
+		row := &AIUsageDayRow{}
+		if err := rows.Scan(&row.Day, &row.Provider, &row.Model, &row.Calls, &row.FailedCalls, &row.CachedCalls,
+			&row.PromptTokens, &row.CompletionTokens, &row.TotalTokens, &row.CostUSD, &row.AvgLatencyMs); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+var _ AIGenerationLogRepository = (*AIGenerationLogPostgres)(nil)
@@ -52,4 +52,28 @@ func (r *AuctionPostgres) Update(ctx context.Context, promotionID int64, minPric
 	return err
 }
 
+// ClearingPrices aggregates winning bids for a slot position across finished auctions.
+// A non-empty category narrows the sample to segments with the same category_name.
+func (r *AuctionPostgres) ClearingPrices(ctx context.Context, position int, category string, excludePromotionID int64) (*ClearingPriceRow, error) {
+	var row ClearingPriceRow
+	err := r.pool.QueryRow(ctx, `SELECT count(*),
+			COALESCE(round(percentile_cont(0.5) WITHIN GROUP (ORDER BY s.price)), 0)::bigint,
+			COALESCE(max(s.price), 0)
+		FROM public.slot s
+		JOIN public.auction a ON a.promotion_id = s.promotion_id AND a.deleted_at IS NULL
+		JOIN public.segment sg ON sg.id = s.segment_id
+		WHERE s.pricing_type = 'auction'
+			AND s.status IN ('moderation', 'occupied')
+			AND s.price IS NOT NULL
+			AND s.position = $1
+			AND a.date_to < now()
+			AND s.promotion_id <> $3
+			AND ($2 = '' OR lower(sg.category_name) = lower($2))`,
+		position, category, excludePromotionID).Scan(&row.Samples, &row.Median, &row.Max)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
 var _ AuctionRepository = (*AuctionPostgres)(nil)
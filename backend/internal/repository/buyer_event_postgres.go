@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// buyerEventSession is the session of a buyer event in every report: events without
+// session_id count as separate sessions.
+const buyerEventSession = `COALESCE(NULLIF(session_id, ''), event_id)`
+
+type BuyerEventPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewBuyerEventPostgres(pool *pgxpool.Pool) *BuyerEventPostgres {
+	return &BuyerEventPostgres{pool: pool}
+}
+
+// InsertBatch writes events in one statement; events whose event_id was already accepted
+// (buyer_event_dedup) are skipped. Returns the number of rows actually inserted.
+func (r *BuyerEventPostgres) InsertBatch(ctx context.Context, rows []*BuyerEventRow) (int, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	var (
+		eventIDs    = make([]string, len(rows))
+		eventTypes  = make([]string, len(rows))
+		promotions  = make([]int64, len(rows))
+		segments    = make([]*int64, len(rows))
+		questions   = make([]*int64, len(rows))
+		options     = make([]*int64, len(rows))
+		products    = make([]*int64, len(rows))
+		slots       = make([]*int64, len(rows))
+		sessions    = make([]string, len(rows))
+		properties  = make([]string, len(rows))
+		occurredAts = make([]time.Time, len(rows))
+	)
+	for i, row := range rows {
+		eventIDs[i] = row.EventID
+		eventTypes[i] = row.EventType
+		promotions[i] = row.PromotionID
+		segments[i] = row.SegmentID
+		questions[i] = row.QuestionID
+		options[i] = row.OptionID
+		products[i] = row.ProductID
+		slots[i] = row.SlotID
+		sessions[i] = row.SessionID
+		properties[i] = string(row.Properties)
+		occurredAts[i] = row.OccurredAt
+	}
+	tag, err := r.pool.Exec(ctx, `WITH e AS (
+			SELECT DISTINCT ON (u.event_id) u.*
+			FROM unnest($1::text[], $2::text[], $3::bigint[], $4::bigint[], $5::bigint[],
+				$6::bigint[], $7::bigint[], $8::bigint[], $9::text[], $10::text[], $11::timestamptz[])
+				AS u(event_id, event_type, promotion_id, segment_id, question_id, option_id, product_id, slot_id, session_id, properties, occurred_at)
+		), fresh AS (
+			INSERT INTO public.buyer_event_dedup (event_id, occurred_at)
+			SELECT event_id, occurred_at FROM e
+			ON CONFLICT (event_id) DO NOTHING
+			RETURNING event_id
+		)
+		INSERT INTO public.buyer_event (event_id, event_type, promotion_id, segment_id, question_id,
+			option_id, product_id, slot_id, session_id, properties, occurred_at)
+		SELECT e.event_id, e.event_type, e.promotion_id, e.segment_id, e.question_id,
+			e.option_id, e.product_id,
+			COALESCE(e.slot_id, (SELECT s.id FROM public.slot s
+				WHERE e.product_id IS NOT NULL AND s.segment_id = e.segment_id AND s.product_id = e.product_id
+					AND s.status = 'occupied'
+				ORDER BY s.position LIMIT 1)),
+			e.session_id, e.properties::jsonb, e.occurred_at
+		FROM e JOIN fresh ON fresh.event_id = e.event_id`,
+		eventIDs, eventTypes, promotions, segments, questions, options, products, slots, sessions, properties, occurredAts)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (r *BuyerEventPostgres) PurgeDedup(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM public.buyer_event_dedup WHERE occurred_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// EnsurePartitions creates missing daily partitions (UTC) for `days` days starting at from.
+// Instances run it concurrently, so creation is serialized by an advisory lock. Events of the
+// day that already landed in buyer_event_default are moved into the new partition: Postgres
+// refuses to add a partition while the default one holds rows of its range.
+func (r *BuyerEventPostgres) EnsurePartitions(ctx context.Context, from time.Time, days int) error {
+	day := from.UTC().Truncate(24 * time.Hour)
+	for i := 0; i < days; i++ {
+		if err := r.ensurePartition(ctx, day.AddDate(0, 0, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *BuyerEventPostgres) ensurePartition(ctx context.Context, start time.Time) error {
+	end := start.AddDate(0, 0, 1)
+	name := "buyer_event_" + start.Format("20060102")
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('buyer_event_partitions'))`); err != nil {
+		return err
+	}
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT to_regclass('public.' || $1) IS NOT NULL`, name).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	// Writes to the default partition wait until the day's rows are moved and the partition is attached.
+	if _, err := tx.Exec(ctx, `LOCK TABLE public.buyer_event_default IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`CREATE TABLE public.%s (LIKE public.buyer_event INCLUDING DEFAULTS INCLUDING CONSTRAINTS)`, name)); err != nil {
+		return err
+	}
+	moved, err := tx.Exec(ctx, fmt.Sprintf(`WITH moved AS (
+			DELETE FROM public.buyer_event_default WHERE occurred_at >= $1 AND occurred_at < $2 RETURNING *
+		)
+		INSERT INTO public.%s SELECT * FROM moved`, name), start, end)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`ALTER TABLE public.buyer_event ATTACH PARTITION public.%s FOR VALUES FROM ('%s') TO ('%s')`,
+		name, start.Format(time.RFC3339), end.Format(time.RFC3339))); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	if n := moved.RowsAffected(); n > 0 {
+		slog.InfoContext(ctx, "buyer_event rows moved from default partition", slog.String("partition", name), slog.Int64("rows", n))
+	}
+	return nil
+}
+
+// Funnel counts unique sessions per stage, grouped by the segment each session resolved to,
+// plus a total row.
+func (r *BuyerEventPostgres) Funnel(ctx context.Context, promotionID int64, from, to time.Time) ([]*BuyerEventFunnelRow, error) {
+	rows, err := r.pool.Query(ctx, `WITH ev AS (
+			SELECT `+buyerEventSession+` AS sid, event_type, segment_id, occurred_at
+			FROM public.buyer_event
+			WHERE promotion_id = $1 AND occurred_at >= $2 AND occurred_at < $3
+		), seg AS (
+			SELECT sid, (array_agg(segment_id ORDER BY occurred_at DESC))[1] AS segment_id
+			FROM ev WHERE event_type = 'segment_resolved' AND segment_id IS NOT NULL
+			GROUP BY sid
+		), per_session AS (
+			SELECT ev.sid, seg.segment_id,
+				bool_or(ev.event_type = 'promotion_view') AS viewed,
+				bool_or(ev.event_type = 'quiz_start') AS started,
+				bool_or(ev.event_type = 'segment_resolved') AS resolved,
+				bool_or(ev.event_type = 'product_click') AS clicked
+			FROM ev LEFT JOIN seg ON seg.sid = ev.sid
+			GROUP BY ev.sid, seg.segment_id
+		)
+		SELECT segment_id, GROUPING(segment_id) = 1,
+			count(*) FILTER (WHERE viewed),
+			count(*) FILTER (WHERE started),
+			count(*) FILTER (WHERE started AND resolved),
+			count(*) FILTER (WHERE resolved),
+			count(*) FILTER (WHERE clicked)
+		FROM per_session
+		GROUP BY ROLLUP (segment_id)`, promotionID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*BuyerEventFunnelRow
+	for rows.Next() {
+		var row BuyerEventFunnelRow
+		err = rows.Scan(&row.SegmentID, &row.IsTotal, &row.Views, &row.QuizStarts, &row.Completions, &row.SegmentResolved, &row.ProductClicks)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &row)
+	}
+	return out, rows.Err()
+}
+
+// TimeSeries counts events per bucket ("hour" or "day") and type; empty eventTypes means all types.
+func (r *BuyerEventPostgres) TimeSeries(ctx context.Context, promotionID int64, from, to time.Time, bucket string, eventTypes []string) ([]*BuyerEventBucketRow, error) {
+	if bucket != "hour" && bucket != "day" {
+		return nil, fmt.Errorf("unknown bucket %q: %w", bucket, ErrInvalidFilter)
+	}
+	rows, err := r.pool.Query(ctx, `SELECT date_trunc($4, occurred_at, 'UTC') AS bucket, event_type, count(*)
+		FROM public.buyer_event
+		WHERE promotion_id = $1 AND occurred_at >= $2 AND occurred_at < $3
+			AND (cardinality($5::text[]) = 0 OR event_type = ANY($5))
+		GROUP BY bucket, event_type
+		ORDER BY bucket, event_type`, promotionID, from, to, bucket, nonNilStrings(eventTypes))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*BuyerEventBucketRow
+	for rows.Next() {
+		var row BuyerEventBucketRow
+		if err = rows.Scan(&row.BucketStart, &row.EventType, &row.Count); err != nil {
+			return nil, err
+		}
+		out = append(out, &row)
+	}
+	return out, rows.Err()
+}
+
+// SegmentOutcomes returns unique sessions resolved to each segment of the promotion.
+func (r *BuyerEventPostgres) SegmentOutcomes(ctx context.Context, promotionID int64) (map[int64]int64, error) {
+	rows, err := r.pool.Query(ctx, `SELECT segment_id, count(DISTINCT `+buyerEventSession+`)
+		FROM public.buyer_event
+		WHERE promotion_id = $1 AND event_type = 'segment_resolved' AND segment_id IS NOT NULL
+		GROUP BY segment_id`, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make(map[int64]int64)
+	for rows.Next() {
+		var segmentID, sessions int64
+		if err = rows.Scan(&segmentID, &sessions); err != nil {
+			return nil, err
+		}
+		out[segmentID] = sessions
+	}
+	return out, rows.Err()
+}
+
+// ThemeOutcomeAverage returns the average number of resolved sessions per promotion with
+// the same theme (case-insensitive), over promotions that have any outcomes.
+func (r *BuyerEventPostgres) ThemeOutcomeAverage(ctx context.Context, theme string, excludePromotionID int64) (float64, error) {
+	var avg float64
+	err := r.pool.QueryRow(ctx, `SELECT COALESCE(AVG(sessions), 0)::float8 FROM (
+			SELECT p.id, count(DISTINCT `+buyerEventSession+`) AS sessions
+			FROM public.promotion p
+			JOIN public.buyer_event e ON e.promotion_id = p.id AND e.event_type = 'segment_resolved'
+			WHERE lower(p.theme) = lower($1) AND p.id <> $2 AND p.deleted_at IS NULL
+			GROUP BY p.id
+		) x`, theme, excludePromotionID).Scan(&avg)
+	return avg, err
+}
+
+var _ BuyerEventRepository = (*BuyerEventPostgres)(nil)
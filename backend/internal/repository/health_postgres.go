@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type HealthPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewHealthPostgres(pool *pgxpool.Pool) *HealthPostgres {
+	return &HealthPostgres{pool: pool}
+}
+
+func (r *HealthPostgres) Ping(ctx context.Context) error {
+	return r.pool.Ping(ctx)
+}
+
+// MigrationVersion returns the latest applied goose migration; a version rolled back with
+// `goose down` is not counted.
+func (r *HealthPostgres) MigrationVersion(ctx context.Context) (int64, error) {
+	var version int64
+	err := r.pool.QueryRow(ctx, `SELECT COALESCE(max(version_id), 0) FROM (
+			SELECT DISTINCT ON (version_id) version_id, is_applied
+			FROM public.goose_db_version
+			ORDER BY version_id, id DESC
+		) v WHERE is_applied`).Scan(&version)
+	return version, err
+}
+
+var _ HealthRepository = (*HealthPostgres)(nil)
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MetricsPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewMetricsPostgres(pool *pgxpool.Pool) *MetricsPostgres {
+	return &MetricsPostgres{pool: pool}
+}
+
+func (r *MetricsPostgres) DomainGauges(ctx context.Context) (*DomainGaugesRow, error) {
+	var row DomainGaugesRow
+	err := r.pool.QueryRow(ctx, `SELECT
+			(SELECT count(*) FROM public.promotion
+				WHERE status = 'RUNNING' AND date_from <= now() AND date_to >= now() AND deleted_at IS NULL),
+			(SELECT count(*) FROM public.auction a
+				JOIN public.promotion p ON p.id = a.promotion_id AND p.deleted_at IS NULL
+				WHERE a.deleted_at IS NULL AND a.date_from <= now() AND a.date_to > now()),
+			(SELECT count(*) FROM public.moderation WHERE status = 'pending')`).
+		Scan(&row.RunningPromotions, &row.OpenAuctions, &row.PendingModeration)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+var _ MetricsRepository = (*MetricsPostgres)(nil)
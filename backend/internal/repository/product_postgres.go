@@ -100,4 +100,98 @@ func (r *ProductPostgres) ListBySeller(ctx context.Context, sellerID int64, cate
 	return out, total, rows.Err()
 }
 
+// segmentProductSorts — допустимые значения sort для ListBySegmentSlots
+var segmentProductSorts = map[string]string{
+	"":              "position ASC",
+	"position_asc":  "position ASC",
+	"position_desc": "position DESC",
+	"price_asc":     "price ASC",
+	"price_desc":    "price DESC",
+	"discount_asc":  "discount ASC",
+	"discount_desc": "discount DESC",
+}
+
+// ListBySegmentSlots returns products placed in occupied slots of a segment with filtering,
+// sorting and pagination done in SQL. WB-filled slots (no seller) without their own
+// discount get defaultDiscount.
+func (r *ProductPostgres) ListBySegmentSlots(ctx context.Context, segmentID int64, defaultDiscount int, filters ProductFilters) ([]*SegmentProductRow, int, error) {
+	orderBy, ok := segmentProductSorts[filters.Sort]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown sort %q: %w", filters.Sort, ErrInvalidFilter)
+	}
+	if filters.PerPage <= 0 {
+		filters.PerPage = 20
+	}
+	offset := (filters.Page - 1) * filters.PerPage
+	if offset < 0 {
+		offset = 0
+	}
+
+	base := `WITH items AS (
+			SELECT p.id, p.nm_id, p.name, p.image, p.category_id, p.category_name, p.price AS base_price, s.position,
+				CASE WHEN s.seller_id IS NULL AND s.discount = 0 THEN $2 ELSE s.discount END AS discount
+			FROM public.slot s
+			JOIN public.product p ON p.id = s.product_id AND p.deleted_at IS NULL
+			WHERE s.segment_id = $1 AND s.status = 'occupied'
+		), priced AS (
+			SELECT *, base_price * (100 - discount) / 100 AS price FROM items
+		)`
+	where := ` WHERE true`
+	args := []interface{}{segmentID, defaultDiscount}
+	if filters.Category != "" {
+		args = append(args, filters.Category)
+		where += fmt.Sprintf(` AND (category_name = $%d OR category_id::text = $%d)`, len(args), len(args))
+	}
+	if filters.OnlyDiscounts {
+		where += ` AND discount > 0`
+	}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, base+` SELECT count(*) FROM priced`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filters.PerPage, offset)
+	q := base + ` SELECT id, nm_id, name, image, category_name, base_price, price, discount, position FROM priced` + where +
+		fmt.Sprintf(` ORDER BY %s, position, id LIMIT $%d OFFSET $%d`, orderBy, len(args)-1, len(args))
+	rows, err := r.pool.Query(ctx, q, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var out []*SegmentProductRow
+	for rows.Next() {
+		var row SegmentProductRow
+		err = rows.Scan(&row.ProductID, &row.NmID, &row.Name, &row.Image, &row.CategoryName, &row.BasePrice, &row.Price, &row.Discount, &row.Position)
+		if err != nil {
+			return nil, 0, err
+		}
+		out = append(out, &row)
+	}
+	return out, total, rows.Err()
+}
+
+// CategoryStats lists distinct product categories, largest first.
+func (r *ProductPostgres) CategoryStats(ctx context.Context) ([]*CategoryStatRow, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT category_name, count(*), count(DISTINCT seller_id)
+		FROM public.product
+		WHERE deleted_at IS NULL AND btrim(category_name) <> ''
+		GROUP BY category_name
+		ORDER BY count(*) DESC, category_name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*CategoryStatRow
+	for rows.Next() {
+		row := &CategoryStatRow{}
+		if err := rows.Scan(&row.CategoryName, &row.Products, &row.Sellers); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
 var _ ProductRepository = (*ProductPostgres)(nil)
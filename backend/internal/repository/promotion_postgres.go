@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -15,41 +17,52 @@ func NewPromotionPostgres(pool *pgxpool.Pool) *PromotionPostgres {
 	return &PromotionPostgres{pool: pool}
 }
 
-func (r *PromotionPostgres) GetByID(ctx context.Context, id int64) (*PromotionRow, error) {
-	var row PromotionRow
-	err := r.pool.QueryRow(ctx, `SELECT id, name, description, theme, date_from::text, date_to::text, status,
-		identification_mode, pricing_model, slot_count, max_discount, min_discount, min_price, bid_step, stop_factors, fixed_prices,
-		created_at::text, updated_at::text, deleted_at::text FROM public.promotion WHERE id = $1 AND deleted_at IS NULL`,
-		id).Scan(&row.ID, &row.Name, &row.Description, &row.Theme, &row.DateFrom, &row.DateTo, &row.Status,
-		&row.IdentificationMode, &row.PricingModel, &row.SlotCount, &row.MaxDiscount, &row.MinDiscount, &row.MinPrice, &row.BidStep,
-		&row.StopFactors, &row.FixedPrices, &row.CreatedAt, &row.UpdatedAt, &row.DeletedAt)
+const promotionColumns = `id, name, description, theme, date_from::text, date_to::text, status,
+	identification_mode, pricing_model, slot_count, max_discount, min_discount, min_price, bid_step, stop_factors, fixed_prices,
+	created_at::text, updated_at::text, deleted_at::text, priority, target_regions, target_platforms`
+
+func scanPromotion(row pgx.Row) (*PromotionRow, error) {
+	var p PromotionRow
+	err := row.Scan(&p.ID, &p.Name, &p.Description, &p.Theme, &p.DateFrom, &p.DateTo, &p.Status,
+		&p.IdentificationMode, &p.PricingModel, &p.SlotCount, &p.MaxDiscount, &p.MinDiscount, &p.MinPrice, &p.BidStep,
+		&p.StopFactors, &p.FixedPrices, &p.CreatedAt, &p.UpdatedAt, &p.DeletedAt, &p.Priority, &p.TargetRegions, &p.TargetPlatforms)
 	if err != nil {
 		return nil, err
 	}
-	return &row, nil
+	return &p, nil
+}
+
+func (r *PromotionPostgres) GetByID(ctx context.Context, id int64) (*PromotionRow, error) {
+	return scanPromotion(r.pool.QueryRow(ctx, `SELECT `+promotionColumns+` FROM public.promotion
+		WHERE id = $1 AND deleted_at IS NULL`, id))
 }
 
+// GetActive returns the highest-priority running promotion.
 func (r *PromotionPostgres) GetActive(ctx context.Context) (*PromotionRow, error) {
-	var row PromotionRow
-	err := r.pool.QueryRow(ctx, `SELECT id, name, description, theme, date_from::text, date_to::text, status,
-		identification_mode, pricing_model, slot_count, max_discount, min_discount, min_price, bid_step, stop_factors, fixed_prices,
-		created_at::text, updated_at::text, deleted_at::text FROM public.promotion
-		WHERE status = 'RUNNING' AND date_from <= now() AND date_to >= now() AND deleted_at IS NULL LIMIT 1`).
-		Scan(&row.ID, &row.Name, &row.Description, &row.Theme, &row.DateFrom, &row.DateTo, &row.Status,
-			&row.IdentificationMode, &row.PricingModel, &row.SlotCount, &row.MaxDiscount, &row.MinDiscount, &row.MinPrice, &row.BidStep,
-			&row.StopFactors, &row.FixedPrices, &row.CreatedAt, &row.UpdatedAt, &row.DeletedAt)
-	if err != nil {
-		return nil, err
-	}
-	return &row, nil
+	return scanPromotion(r.pool.QueryRow(ctx, `SELECT `+promotionColumns+` FROM public.promotion
+		WHERE status = 'RUNNING' AND date_from <= now() AND date_to >= now() AND deleted_at IS NULL
+		ORDER BY priority DESC, date_from DESC, id DESC LIMIT 1`))
+}
+
+// ListActive returns all running promotions ordered by admin priority.
+// A promotion with no targets matches everyone; an unknown (empty) buyer region or platform
+// matches only promotions not targeted by it.
+func (r *PromotionPostgres) ListActive(ctx context.Context, region, platform string) ([]*PromotionRow, error) {
+	return r.list(ctx, `SELECT `+promotionColumns+` FROM public.promotion
+		WHERE status = 'RUNNING' AND date_from <= now() AND date_to >= now() AND deleted_at IS NULL
+			AND (cardinality(target_regions) = 0 OR ($1 <> '' AND $1 = ANY(target_regions)))
+			AND (cardinality(target_platforms) = 0 OR ($2 <> '' AND $2 = ANY(target_platforms)))
+		ORDER BY priority DESC, date_from DESC, id DESC`, region, platform)
 }
 
 func (r *PromotionPostgres) ListAll(ctx context.Context) ([]*PromotionRow, error) {
-	rows, err := r.pool.Query(ctx, `SELECT id, name, description, theme, date_from::text, date_to::text, status,
-		identification_mode, pricing_model, slot_count, max_discount, min_discount, min_price, bid_step, stop_factors, fixed_prices,
-		created_at::text, updated_at::text, deleted_at::text FROM public.promotion
+	return r.list(ctx, `SELECT `+promotionColumns+` FROM public.promotion
 		WHERE deleted_at IS NULL
 		ORDER BY created_at DESC, id DESC`)
+}
+
+func (r *PromotionPostgres) list(ctx context.Context, query string, args ...any) ([]*PromotionRow, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -57,14 +70,11 @@ func (r *PromotionPostgres) ListAll(ctx context.Context) ([]*PromotionRow, error
 
 	var out []*PromotionRow
 	for rows.Next() {
-		var row PromotionRow
-		err = rows.Scan(&row.ID, &row.Name, &row.Description, &row.Theme, &row.DateFrom, &row.DateTo, &row.Status,
-			&row.IdentificationMode, &row.PricingModel, &row.SlotCount, &row.MaxDiscount, &row.MinDiscount, &row.MinPrice, &row.BidStep,
-			&row.StopFactors, &row.FixedPrices, &row.CreatedAt, &row.UpdatedAt, &row.DeletedAt)
+		row, err := scanPromotion(rows)
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, &row)
+		out = append(out, row)
 	}
 	return out, rows.Err()
 }
@@ -72,21 +82,87 @@ func (r *PromotionPostgres) ListAll(ctx context.Context) ([]*PromotionRow, error
 func (r *PromotionPostgres) Create(ctx context.Context, row *PromotionRow) (int64, error) {
 	var id int64
 	err := r.pool.QueryRow(ctx, `INSERT INTO public.promotion (name, description, theme, date_from, date_to, status,
-		identification_mode, pricing_model, slot_count, min_discount, max_discount, min_price, bid_step, stop_factors, fixed_prices)
-		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15) RETURNING id`,
+		identification_mode, pricing_model, slot_count, min_discount, max_discount, min_price, bid_step, stop_factors, fixed_prices,
+		priority, target_regions, target_platforms)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18) RETURNING id`,
 		row.Name, row.Description, row.Theme, row.DateFrom, row.DateTo, row.Status,
 		row.IdentificationMode, row.PricingModel, row.SlotCount, row.MinDiscount, row.MaxDiscount, row.MinPrice, row.BidStep,
-		row.StopFactors, row.FixedPrices).Scan(&id)
+		row.StopFactors, row.FixedPrices, row.Priority, nonNilStrings(row.TargetRegions), nonNilStrings(row.TargetPlatforms)).Scan(&id)
 	return id, err
 }
 
+// CreateDraft inserts the promotion, its segments, poll questions and answer tree in one
+// transaction; returns the promotion id and segment ids in input order.
+func (r *PromotionPostgres) CreateDraft(ctx context.Context, draft *PromotionDraftInput) (int64, []int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	row := draft.Promotion
+	var promotionID int64
+	err = tx.QueryRow(ctx, `INSERT INTO public.promotion (name, description, theme, date_from, date_to, status,
+		identification_mode, pricing_model, slot_count, min_discount, max_discount, min_price, bid_step, stop_factors, fixed_prices,
+		priority, target_regions, target_platforms)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18) RETURNING id`,
+		row.Name, row.Description, row.Theme, row.DateFrom, row.DateTo, row.Status,
+		row.IdentificationMode, row.PricingModel, row.SlotCount, row.MinDiscount, row.MaxDiscount, row.MinPrice, row.BidStep,
+		row.StopFactors, row.FixedPrices, row.Priority, nonNilStrings(row.TargetRegions), nonNilStrings(row.TargetPlatforms)).Scan(&promotionID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("insert promotion: %w", err)
+	}
+
+	segmentIDs := make([]int64, 0, len(draft.Segments))
+	for _, seg := range draft.Segments {
+		var id int64
+		if err := tx.QueryRow(ctx, `INSERT INTO public.segment (promotion_id, name, category_id, category_name, color, order_index, text)
+			VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING id`,
+			promotionID, seg.Name, seg.CategoryID, seg.CategoryName, seg.Color, seg.OrderIndex, seg.Text).Scan(&id); err != nil {
+			return 0, nil, fmt.Errorf("insert segment %q: %w", seg.Name, err)
+		}
+		segmentIDs = append(segmentIDs, id)
+	}
+
+	for qi, q := range draft.Questions {
+		var qID int64
+		if err := tx.QueryRow(ctx, `INSERT INTO public.poll_question (promotion_id, text, order_index)
+			VALUES ($1,$2,$3) RETURNING id`, promotionID, q.Text, qi).Scan(&qID); err != nil {
+			return 0, nil, fmt.Errorf("insert poll_question: %w", err)
+		}
+		for oi, opt := range q.Options {
+			if _, err := tx.Exec(ctx, `INSERT INTO public.poll_option (question_id, text, value, order_index)
+				VALUES ($1,$2,$3,$4)`, qID, opt.Text, opt.Value, oi); err != nil {
+				return 0, nil, fmt.Errorf("insert poll_option: %w", err)
+			}
+		}
+	}
+
+	for _, n := range draft.AnswerTree {
+		var parent any
+		if n.ParentNodeID != "" {
+			parent = n.ParentNodeID
+		}
+		if _, err := tx.Exec(ctx, `INSERT INTO public.poll_answer_tree (promotion_id, node_id, parent_node_id, label, value)
+			VALUES ($1,$2::uuid,$3::uuid,$4,$5)`,
+			promotionID, n.NodeID, parent, n.Label, n.Value); err != nil {
+			return 0, nil, fmt.Errorf("insert poll_answer_tree node %s: %w", n.NodeID, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, nil, err
+	}
+	return promotionID, segmentIDs, nil
+}
+
 func (r *PromotionPostgres) Update(ctx context.Context, row *PromotionRow) error {
 	_, err := r.pool.Exec(ctx, `UPDATE public.promotion SET name=$2, description=$3, theme=$4, date_from=$5, date_to=$6,
 		status=$7, identification_mode=$8, pricing_model=$9, slot_count=$10, min_discount=$11, max_discount=$12, min_price=$13, bid_step=$14,
-		stop_factors=$15, fixed_prices=$16, updated_at=now() WHERE id=$1`,
+		stop_factors=$15, fixed_prices=$16, priority=$17, target_regions=$18, target_platforms=$19, updated_at=now() WHERE id=$1`,
 		row.ID, row.Name, row.Description, row.Theme, row.DateFrom, row.DateTo, row.Status,
 		row.IdentificationMode, row.PricingModel, row.SlotCount, row.MinDiscount, row.MaxDiscount, row.MinPrice, row.BidStep,
-		row.StopFactors, row.FixedPrices)
+		row.StopFactors, row.FixedPrices, row.Priority, nonNilStrings(row.TargetRegions), nonNilStrings(row.TargetPlatforms))
 	return err
 }
 
@@ -112,6 +188,14 @@ func (r *PromotionPostgres) SetAuctionParams(ctx context.Context, id int64, minP
 
 var _ PromotionRepository = (*PromotionPostgres)(nil)
 
+// nonNilStrings keeps NOT NULL text[] columns from receiving NULL for an empty slice.
+func nonNilStrings(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
+
 func mustJSON(v interface{}) []byte {
 	b, _ := json.Marshal(v)
 	return b
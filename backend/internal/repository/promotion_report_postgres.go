@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PromotionReportPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPromotionReportPostgres(pool *pgxpool.Pool) *PromotionReportPostgres {
+	return &PromotionReportPostgres{pool: pool}
+}
+
+// CompareCompleted aggregates slot, bet, moderation and buyer event metrics of COMPLETED promotions.
+// Empty ids take the latest `limit` completed promotions.
+func (r *PromotionReportPostgres) CompareCompleted(ctx context.Context, ids []int64, limit int) ([]*PromotionCohortRow, error) {
+	if ids == nil {
+		ids = []int64{}
+	}
+	rows, err := r.pool.Query(ctx, `WITH promo AS (
+			SELECT id, name, theme, identification_mode, pricing_model, date_from::text AS date_from, date_to::text AS date_to
+			FROM public.promotion
+			WHERE status = 'COMPLETED' AND deleted_at IS NULL
+				AND (cardinality($1::bigint[]) = 0 OR id = ANY($1))
+			ORDER BY date_to DESC, id DESC
+			LIMIT $2
+		), slots AS (
+			SELECT promotion_id,
+				count(*) AS total,
+				count(*) FILTER (WHERE status = 'occupied') AS filled,
+				COALESCE(avg(price) FILTER (WHERE status = 'occupied' AND price IS NOT NULL), 0)::float8 AS avg_price
+			FROM public.slot
+			WHERE promotion_id IN (SELECT id FROM promo)
+			GROUP BY promotion_id
+		), bets AS (
+			SELECT s.promotion_id, count(*) AS bets, count(DISTINCT b.seller_id) AS bidders
+			FROM public.bet b
+			JOIN public.slot s ON s.id = b.slot_id
+			WHERE s.promotion_id IN (SELECT id FROM promo) AND b.deleted_at IS NULL
+			GROUP BY s.promotion_id
+		), moderation AS (
+			SELECT promotion_id,
+				count(*) FILTER (WHERE status = 'approved') AS approved,
+				count(*) FILTER (WHERE status = 'rejected') AS rejected
+			FROM public.moderation
+			WHERE promotion_id IN (SELECT id FROM promo)
+			GROUP BY promotion_id
+		), sessions AS (
+			SELECT promotion_id, `+buyerEventSession+` AS sid,
+				bool_or(event_type = 'promotion_view') AS viewed,
+				bool_or(event_type = 'segment_resolved') AS resolved,
+				bool_or(event_type = 'product_click') AS clicked
+			FROM public.buyer_event
+			WHERE promotion_id IN (SELECT id FROM promo)
+			GROUP BY promotion_id, sid
+		), conversion AS (
+			SELECT promotion_id,
+				count(*) FILTER (WHERE viewed) AS views,
+				count(*) FILTER (WHERE resolved) AS resolved,
+				count(*) FILTER (WHERE clicked) AS clicked
+			FROM sessions
+			GROUP BY promotion_id
+		)
+		SELECT p.id, p.name, p.theme, p.identification_mode, p.pricing_model, p.date_from, p.date_to,
+			COALESCE(s.total, 0), COALESCE(s.filled, 0), COALESCE(s.avg_price, 0),
+			COALESCE(b.bets, 0), COALESCE(b.bidders, 0),
+			COALESCE(m.approved, 0), COALESCE(m.rejected, 0),
+			COALESCE(c.views, 0), COALESCE(c.resolved, 0), COALESCE(c.clicked, 0)
+		FROM promo p
+		LEFT JOIN slots s ON s.promotion_id = p.id
+		LEFT JOIN bets b ON b.promotion_id = p.id
+		LEFT JOIN moderation m ON m.promotion_id = p.id
+		LEFT JOIN conversion c ON c.promotion_id = p.id
+		ORDER BY p.date_to DESC, p.id DESC`, ids, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*PromotionCohortRow
+	for rows.Next() {
+		var row PromotionCohortRow
+		err = rows.Scan(&row.PromotionID, &row.Name, &row.Theme, &row.IdentificationMode, &row.PricingModel, &row.DateFrom, &row.DateTo,
+			&row.TotalSlots, &row.FilledSlots, &row.AvgClearingPrice,
+			&row.Bets, &row.Bidders,
+			&row.ApprovedApplications, &row.RejectedApplications,
+			&row.ViewSessions, &row.ResolvedSessions, &row.ClickSessions)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &row)
+	}
+	return out, rows.Err()
+}
+
+var _ PromotionReportRepository = (*PromotionReportPostgres)(nil)
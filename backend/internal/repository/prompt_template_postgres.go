@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PromptTemplatePostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPromptTemplatePostgres(pool *pgxpool.Pool) *PromptTemplatePostgres {
+	return &PromptTemplatePostgres{pool: pool}
+}
+
+// Create numbers versions per name under an advisory lock, so concurrent saves do not collide.
+func (r *PromptTemplatePostgres) Create(ctx context.Context, row *PromptTemplateRow, activate bool) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('prompt_template:' || $1))`, row.Name); err != nil {
+		return 0, err
+	}
+	var version int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO prompt_template (name, version, body, comment)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3
+		FROM prompt_template WHERE name = $1
+		RETURNING version`, row.Name, row.Body, row.Comment).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+	if activate {
+		if err := activatePromptTemplate(ctx, tx, row.Name, version); err != nil {
+			return 0, err
+		}
+	}
+	return version, tx.Commit(ctx)
+}
+
+func (r *PromptTemplatePostgres) Get(ctx context.Context, name string, version int) (*PromptTemplateRow, error) {
+	row := &PromptTemplateRow{}
+	err := r.pool.QueryRow(ctx, `
+		SELECT name, version, body, comment, created_at
+		FROM prompt_template WHERE name = $1 AND version = $2`, name, version).
+		Scan(&row.Name, &row.Version, &row.Body, &row.Comment, &row.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (r *PromptTemplatePostgres) Versions(ctx context.Context, name string) ([]*PromptTemplateRow, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT name, version, body, comment, created_at
+		FROM prompt_template WHERE name = $1 ORDER BY version DESC`, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*PromptTemplateRow
+	for rows.Next() {
+		row := &PromptTemplateRow{}
+		if err := rows.Scan(&row.Name, &row.Version, &row.Body, &row.Comment, &row.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+func (r *PromptTemplatePostgres) Summaries(ctx context.Context) ([]*PromptTemplateSummaryRow, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT t.name, COALESCE(a.version, 0), MAX(t.version)
+		FROM prompt_template t
+		LEFT JOIN prompt_template_active a ON a.name = t.name
+		GROUP BY t.name, a.version
+		ORDER BY t.name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*PromptTemplateSummaryRow
+	for rows.Next() {
+		row := &PromptTemplateSummaryRow{}
+		if err := rows.Scan(&row.Name, &row.ActiveVersion, &row.LatestVersion); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+func (r *PromptTemplatePostgres) Activate(ctx context.Context, name string, version int) error {
+	return activatePromptTemplate(ctx, r.pool, name, version)
+}
+
+// promptExecer — пул или транзакция
+type promptExecer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func activatePromptTemplate(ctx context.Context, db promptExecer, name string, version int) error {
+	if version == 0 {
+		_, err := db.Exec(ctx, `DELETE FROM prompt_template_active WHERE name = $1`, name)
+		return err
+	}
+	_, err := db.Exec(ctx, `
+		INSERT INTO prompt_template_active (name, version) VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET version = EXCLUDED.version, updated_at = now()`, name, version)
+	return err
+}
+
+func (r *PromptTemplatePostgres) Resolve(ctx context.Context, name string, promotionID int64) (int, error) {
+	var version int
+	err := r.pool.QueryRow(ctx, `
+		SELECT COALESCE(
+			(SELECT version FROM promotion_prompt_pin WHERE promotion_id = $2 AND name = $1),
+			(SELECT version FROM prompt_template_active WHERE name = $1),
+			0)`, name, promotionID).Scan(&version)
+	return version, err
+}
+
+func (r *PromptTemplatePostgres) Pins(ctx context.Context, promotionID int64) ([]*PromptPinRow, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT promotion_id, name, version, created_at
+		FROM promotion_prompt_pin WHERE promotion_id = $1 ORDER BY name`, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*PromptPinRow
+	for rows.Next() {
+		row := &PromptPinRow{}
+		if err := rows.Scan(&row.PromotionID, &row.Name, &row.Version, &row.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+func (r *PromptTemplatePostgres) Pin(ctx context.Context, promotionID int64, name string, version int) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO promotion_prompt_pin (promotion_id, name, version) VALUES ($1, $2, $3)
+		ON CONFLICT (promotion_id, name) DO UPDATE SET version = EXCLUDED.version, created_at = now()`,
+		promotionID, name, version)
+	return err
+}
+
+func (r *PromptTemplatePostgres) Unpin(ctx context.Context, promotionID int64, name string) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM promotion_prompt_pin WHERE promotion_id = $1 AND name = $2`, promotionID, name)
+	return err
+}
+
+var _ PromptTemplateRepository = (*PromptTemplatePostgres)(nil)
@@ -3,11 +3,14 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 var (
 	ErrNotFound = errors.New("repository: not found")
 	ErrConflict = errors.New("repository: conflict")
+	// ErrInvalidFilter — недопустимое значение фильтра или сортировки
+	ErrInvalidFilter = errors.New("repository: invalid filter")
 )
 
 // PromotionRow — строка promotion из БД
@@ -31,6 +34,9 @@ type PromotionRow struct {
 	CreatedAt          string
 	UpdatedAt          string
 	DeletedAt          *string
+	Priority           int
+	TargetRegions      []string
+	TargetPlatforms    []string
 }
 
 // SegmentRow — строка segment
@@ -103,6 +109,7 @@ type ModerationRow struct {
 type PromotionRepository interface {
 	GetByID(ctx context.Context, id int64) (*PromotionRow, error)
 	GetActive(ctx context.Context) (*PromotionRow, error)
+	ListActive(ctx context.Context, region, platform string) ([]*PromotionRow, error)
 	ListAll(ctx context.Context) ([]*PromotionRow, error)
 	Create(ctx context.Context, row *PromotionRow) (int64, error)
 	Update(ctx context.Context, row *PromotionRow) error
@@ -110,6 +117,15 @@ type PromotionRepository interface {
 	SetFixedPrices(ctx context.Context, id int64, prices []byte) error
 	SetStatus(ctx context.Context, id int64, status string) error
 	SetAuctionParams(ctx context.Context, id int64, minPrice, bidStep int64) error
+	CreateDraft(ctx context.Context, draft *PromotionDraftInput) (int64, []int64, error)
+}
+
+// PromotionDraftInput — акция с сегментами, вопросами и деревом ответов для записи одной транзакцией
+type PromotionDraftInput struct {
+	Promotion  *PromotionRow
+	Segments   []*SegmentRow
+	Questions  []PollQuestionInput
+	AnswerTree []PollAnswerTreeInput
 }
 
 // SegmentRepository — операции с segment
@@ -121,7 +137,27 @@ type SegmentRepository interface {
 	Update(ctx context.Context, row *SegmentRow) error
 	Delete(ctx context.Context, id int64) error
 	ShuffleCategories(ctx context.Context, promotionID int64) error
-	UpdateText(ctx context.Context, segmentID int64, text string) error
+	// UpdateText сохраняет текст новой версией и делает его текущим; возвращает номер версии
+	UpdateText(ctx context.Context, segmentID int64, text, source string) (int, error)
+	// UpdateTexts сохраняет тексты нескольких сегментов в одной транзакции; версии — в порядке texts
+	UpdateTexts(ctx context.Context, texts []SegmentTextInput, source string) ([]int, error)
+	TextVersions(ctx context.Context, segmentID int64) ([]*SegmentTextVersionRow, error)
+	GetTextVersion(ctx context.Context, segmentID int64, version int) (*SegmentTextVersionRow, error)
+}
+
+// SegmentTextInput — новый текст сегмента
+type SegmentTextInput struct {
+	SegmentID int64
+	Text      string
+}
+
+// SegmentTextVersionRow — строка segment_text_version
+type SegmentTextVersionRow struct {
+	SegmentID int64
+	Version   int
+	Text      string
+	Source    string
+	CreatedAt time.Time
 }
 
 // SlotRepository — операции с slot
@@ -140,12 +176,36 @@ type ProductRepository interface {
 	GetByID(ctx context.Context, id int64) (*ProductRow, error)
 	GetByIDs(ctx context.Context, ids []int64, filters ProductFilters) ([]*ProductRow, error)
 	ListBySeller(ctx context.Context, sellerID int64, categoryID string, page, perPage int, segmentId *int64) ([]*ProductRow, int, error)
+	ListBySegmentSlots(ctx context.Context, segmentID int64, defaultDiscount int, filters ProductFilters) ([]*SegmentProductRow, int, error)
+	CategoryStats(ctx context.Context) ([]*CategoryStatRow, error)
+}
+
+// CategoryStatRow — категория каталога и сколько в ней товаров и продавцов
+type CategoryStatRow struct {
+	CategoryName string
+	Products     int64
+	Sellers      int64
 }
 
 type ProductFilters struct {
 	Category      string
 	OnlyDiscounts bool
 	Sort          string
+	Page          int
+	PerPage       int
+}
+
+// SegmentProductRow — товар в занятом слоте сегмента; Price уже с учётом скидки слота
+type SegmentProductRow struct {
+	ProductID    int64
+	NmID         int64
+	Name         string
+	Image        *string
+	CategoryName string
+	BasePrice    int64
+	Price        int64
+	Discount     int
+	Position     int
 }
 
 // ModerationRepository — операции с moderation
@@ -209,6 +269,14 @@ type AuctionRepository interface {
 	Create(ctx context.Context, promotionID int64, dateFrom, dateTo string, minPrice, bidStep int64) (int64, error)
 	Update(ctx context.Context, promotionID int64, minPrice, bidStep int64) error
 	UpsertByPromotion(ctx context.Context, promotionID int64, dateFrom, dateTo string, minPrice, bidStep int64) (int64, error)
+	ClearingPrices(ctx context.Context, position int, category string, excludePromotionID int64) (*ClearingPriceRow, error)
+}
+
+// ClearingPriceRow — цены, по которым закрывалась позиция в завершённых аукционах
+type ClearingPriceRow struct {
+	Samples int64
+	Median  int64
+	Max     int64
 }
 
 type BetRepository interface {
@@ -245,3 +313,275 @@ type PromotionViewCountRepository interface {
 	Increment(ctx context.Context, promotionID int64) error
 	GetTotalViews(ctx context.Context) (int64, error)
 }
+
+// BuyerEventRow — строка buyer_event
+type BuyerEventRow struct {
+	ID          int64
+	EventID     string
+	EventType   string
+	PromotionID int64
+	SegmentID   *int64
+	QuestionID  *int64
+	OptionID    *int64
+	ProductID   *int64
+	SlotID      *int64 // для событий товара без slot_id определяется по занятому слоту сегмента
+	SessionID   string
+	Properties  []byte // jsonb
+	OccurredAt  time.Time
+	ReceivedAt  time.Time
+}
+
+// BuyerEventRepository — операции с buyer_event
+type BuyerEventRepository interface {
+	InsertBatch(ctx context.Context, rows []*BuyerEventRow) (int, error)
+	EnsurePartitions(ctx context.Context, from time.Time, days int) error
+	// PurgeDedup удаляет event_id событий, произошедших раньше before
+	PurgeDedup(ctx context.Context, before time.Time) (int64, error)
+	Funnel(ctx context.Context, promotionID int64, from, to time.Time) ([]*BuyerEventFunnelRow, error)
+	TimeSeries(ctx context.Context, promotionID int64, from, to time.Time, bucket string, eventTypes []string) ([]*BuyerEventBucketRow, error)
+	SegmentOutcomes(ctx context.Context, promotionID int64) (map[int64]int64, error)
+	ThemeOutcomeAverage(ctx context.Context, theme string, excludePromotionID int64) (float64, error)
+}
+
+// BuyerEventFunnelRow — воронка по сессиям; SegmentID nil у сессий без сегмента,
+// IsTotal — итоговая строка по всей акции
+type BuyerEventFunnelRow struct {
+	SegmentID       *int64
+	IsTotal         bool
+	Views           int64
+	QuizStarts      int64
+	Completions     int64
+	SegmentResolved int64
+	ProductClicks   int64
+}
+
+// BuyerEventBucketRow — число событий типа в интервале
+type BuyerEventBucketRow struct {
+	BucketStart time.Time
+	EventType   string
+	Count       int64
+}
+
+// MarketTotalsRow — общие счётчики витрины для дашборда селлера
+type MarketTotalsRow struct {
+	ActivePromotions int64
+	FreeSlots        int64
+	OccupiedSlots    int64
+	TotalViews       int64
+}
+
+// SellerPromotionStatsRow — статистика селлера по одной акции
+type SellerPromotionStatsRow struct {
+	PromotionID       int64
+	PromotionName     string
+	PromotionStatus   string
+	WonSlots          int64
+	ModerationSlots   int64
+	CommittedAmount   int64
+	ActiveBets        int64
+	ActiveBetsAmount  int64
+	ParticipatedSlots int64
+	Impressions       int64
+	Clicks            int64
+}
+
+// SellerStatisticsRepository — агрегаты для статистики селлера
+type SellerStatisticsRepository interface {
+	MarketTotals(ctx context.Context) (*MarketTotalsRow, error)
+	BySeller(ctx context.Context, sellerID int64) ([]*SellerPromotionStatsRow, error)
+	SlotPerformance(ctx context.Context, sellerID, promotionID int64) ([]*SlotPerformanceRow, error)
+}
+
+// SlotPerformanceRow — показы и клики товара селлера в занятом слоте; Price — оплаченная цена слота
+type SlotPerformanceRow struct {
+	PromotionID   int64
+	PromotionName string
+	SegmentID     int64
+	SegmentName   string
+	SlotID        int64
+	Position      int
+	PricingType   string
+	ProductID     int64
+	ProductName   string
+	Price         int64
+	Impressions   int64
+	Clicks        int64
+}
+
+// PromotionReportRepository — сводные отчёты по завершённым акциям
+type PromotionReportRepository interface {
+	CompareCompleted(ctx context.Context, ids []int64, limit int) ([]*PromotionCohortRow, error)
+}
+
+// PromotionCohortRow — метрики одной завершённой акции для сравнения
+type PromotionCohortRow struct {
+	PromotionID          int64
+	Name                 string
+	Theme                string
+	IdentificationMode   string
+	PricingModel         string
+	DateFrom             string
+	DateTo               string
+	TotalSlots           int64
+	FilledSlots          int64
+	AvgClearingPrice     float64
+	Bets                 int64
+	Bidders              int64
+	ApprovedApplications int64
+	RejectedApplications int64
+	ViewSessions         int64
+	ResolvedSessions     int64
+	ClickSessions        int64
+}
+
+// MetricsRepository — счётчики для метрик Prometheus
+type MetricsRepository interface {
+	DomainGauges(ctx context.Context) (*DomainGaugesRow, error)
+}
+
+// DomainGaugesRow — текущее состояние витрины
+type DomainGaugesRow struct {
+	RunningPromotions int64
+	OpenAuctions      int64
+	PendingModeration int64
+}
+
+// HealthRepository — проверки готовности БД
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	MigrationVersion(ctx context.Context) (int64, error)
+}
+
+// AIGenerationCacheRow — закэшированный ответ AI провайдера; Result — распарсенный результат (jsonb)
+type AIGenerationCacheRow struct {
+	Provider   string
+	Model      string
+	PromptHash string
+	Kind       string
+	RawJSON    string
+	CreatedAt  string
+}
+
+// AIGenerationCacheRepository — кэш генераций AI; просроченные записи не возвращаются
+type AIGenerationCacheRepository interface {
+	Get(ctx context.Context, provider, model, promptHash string) (*AIGenerationCacheRow, error)
+	Put(ctx context.Context, row *AIGenerationCacheRow, ttl time.Duration) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// AIGenerationLogRow — один вызов AI провайдера в журнале
+type AIGenerationLogRow struct {
+	ID               int64
+	Operation        string
+	Provider         string
+	Model            string
+	Status           string
+	LatencyMs        int64
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+	CostUSD          float64
+	Prompt           string
+	Response         string
+	Error            string
+	CreatedAt        time.Time
+}
+
+// AIGenerationLogFilter — фильтры журнала; пустые поля не фильтруют
+type AIGenerationLogFilter struct {
+	Operation string
+	Provider  string
+	Status    string
+	From      time.Time
+	To        time.Time
+	Limit     int
+	Offset    int
+}
+
+// AIUsageDayRow — расход AI за день по провайдеру и модели
+type AIUsageDayRow struct {
+	Day              time.Time
+	Provider         string
+	Model            string
+	Calls            int64
+	FailedCalls      int64 // failed и invalid
+	CachedCalls      int64
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+	CostUSD          float64
+	AvgLatencyMs     float64 // без ответов из кэша
+}
+
+type AIGenerationLogRepository interface {
+	Insert(ctx context.Context, row *AIGenerationLogRow) error
+	List(ctx context.Context, filter AIGenerationLogFilter) ([]*AIGenerationLogRow, int64, error)
+	DailyUsage(ctx context.Context, from, to time.Time) ([]*AIUsageDayRow, error)
+}
+
+// AIGenerationJobRow — асинхронная генерация AI; Request и Result — json по Kind
+type AIGenerationJobRow struct {
+	ID         string
+	Kind       string
+	Status     string
+	Request    []byte
+	Result     []byte
+	Error      string
+	Attempts   int
+	CreatedAt  time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+// AIGenerationJobRepository — очередь асинхронных генераций AI
+type AIGenerationJobRepository interface {
+	Create(ctx context.Context, row *AIGenerationJobRow) error
+	Get(ctx context.Context, id string) (*AIGenerationJobRow, error)
+	// Claim забирает старейшую задачу из очереди или с истёкшей арендой; ErrNotFound — очередь пуста
+	Claim(ctx context.Context, lease time.Duration) (*AIGenerationJobRow, error)
+	Complete(ctx context.Context, id string, result []byte) error
+	Fail(ctx context.Context, id, errText string) error
+	// Requeue возвращает задачу в очередь, например при остановке воркера, не засчитывая попытку
+	Requeue(ctx context.Context, id string) error
+	DeleteFinished(ctx context.Context, before time.Time) (int64, error)
+}
+
+// PromptTemplateRow — версия промпта AI
+type PromptTemplateRow struct {
+	Name      string
+	Version   int
+	Body      string
+	Comment   string
+	CreatedAt time.Time
+}
+
+// PromptTemplateSummaryRow — активная и последняя версии промпта; 0 — встроенный промпт
+type PromptTemplateSummaryRow struct {
+	Name          string
+	ActiveVersion int
+	LatestVersion int
+}
+
+// PromptPinRow — версия промпта, закреплённая за акцией
+type PromptPinRow struct {
+	PromotionID int64
+	Name        string
+	Version     int
+	CreatedAt   time.Time
+}
+
+// PromptTemplateRepository — версии промптов AI; версия 0 (встроенный промпт) в БД не хранится
+type PromptTemplateRepository interface {
+	// Create сохраняет следующую версию промпта; с activate она сразу становится активной
+	Create(ctx context.Context, row *PromptTemplateRow, activate bool) (int, error)
+	Get(ctx context.Context, name string, version int) (*PromptTemplateRow, error)
+	Versions(ctx context.Context, name string) ([]*PromptTemplateRow, error)
+	Summaries(ctx context.Context) ([]*PromptTemplateSummaryRow, error)
+	// Activate делает версию активной; 0 возвращает встроенный промпт
+	Activate(ctx context.Context, name string, version int) error
+	// Resolve — версия для генерации: закреплённая за акцией, иначе активная, иначе 0
+	Resolve(ctx context.Context, name string, promotionID int64) (int, error)
+	Pins(ctx context.Context, promotionID int64) ([]*PromptPinRow, error)
+	Pin(ctx context.Context, promotionID int64, name string, version int) error
+	Unpin(ctx context.Context, promotionID int64, name string) error
+}
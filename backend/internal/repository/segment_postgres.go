@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -80,9 +82,81 @@ func (r *SegmentPostgres) ShuffleCategories(ctx context.Context, promotionID int
 	return err
 }
 
-func (r *SegmentPostgres) UpdateText(ctx context.Context, segmentID int64, text string) error {
-	_, err := r.pool.Exec(ctx, `UPDATE public.segment SET text=$2, updated_at=now() WHERE id=$1`, segmentID, text)
-	return err
+// UpdateText appends a version and makes it current; the segment row lock serializes version numbers.
+func (r *SegmentPostgres) UpdateText(ctx context.Context, segmentID int64, text, source string) (int, error) {
+	versions, err := r.UpdateTexts(ctx, []SegmentTextInput{{SegmentID: segmentID, Text: text}}, source)
+	if err != nil {
+		return 0, err
+	}
+	return versions[0], nil
+}
+
+// UpdateTexts saves the texts of several segments all or nothing.
+func (r *SegmentPostgres) UpdateTexts(ctx context.Context, texts []SegmentTextInput, source string) ([]int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	versions := make([]int, 0, len(texts))
+	for _, t := range texts {
+		version, err := updateSegmentText(ctx, tx, t.SegmentID, t.Text, source)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return versions, tx.Commit(ctx)
+}
+
+func updateSegmentText(ctx context.Context, tx pgx.Tx, segmentID int64, text, source string) (int, error) {
+	tag, err := tx.Exec(ctx, `UPDATE public.segment SET text=$2, updated_at=now() WHERE id=$1`, segmentID, text)
+	if err != nil {
+		return 0, err
+	}
+	if tag.RowsAffected() == 0 {
+		return 0, ErrNotFound
+	}
+	var version int
+	err = tx.QueryRow(ctx, `
+		INSERT INTO public.segment_text_version (segment_id, version, text, source)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3
+		FROM public.segment_text_version WHERE segment_id = $1
+		RETURNING version`, segmentID, text, source).Scan(&version)
+	return version, err
+}
+
+func (r *SegmentPostgres) TextVersions(ctx context.Context, segmentID int64) ([]*SegmentTextVersionRow, error) {
+	rows, err := r.pool.Query(ctx, `SELECT segment_id, version, text, source, created_at
+		FROM public.segment_text_version WHERE segment_id = $1 ORDER BY version DESC`, segmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*SegmentTextVersionRow
+	for rows.Next() {
+		row := &SegmentTextVersionRow{}
+		if err := rows.Scan(&row.SegmentID, &row.Version, &row.Text, &row.Source, &row.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+func (r *SegmentPostgres) GetTextVersion(ctx context.Context, segmentID int64, version int) (*SegmentTextVersionRow, error) {
+	row := &SegmentTextVersionRow{}
+	err := r.pool.QueryRow(ctx, `SELECT segment_id, version, text, source, created_at
+		FROM public.segment_text_version WHERE segment_id = $1 AND version = $2`, segmentID, version).
+		Scan(&row.SegmentID, &row.Version, &row.Text, &row.Source, &row.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
 }
 
 var _ SegmentRepository = (*SegmentPostgres)(nil)
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SellerStatisticsPostgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewSellerStatisticsPostgres(pool *pgxpool.Pool) *SellerStatisticsPostgres {
+	return &SellerStatisticsPostgres{pool: pool}
+}
+
+// MarketTotals returns counters that do not depend on the seller:
+// active promotions, free/occupied slots over all promotions and total promotion views.
+func (r *SellerStatisticsPostgres) MarketTotals(ctx context.Context) (*MarketTotalsRow, error) {
+	var row MarketTotalsRow
+	err := r.pool.QueryRow(ctx, `SELECT
+			(SELECT count(*) FROM public.promotion
+				WHERE status IN ('RUNNING', 'READY_TO_START') AND deleted_at IS NULL),
+			count(*) FILTER (WHERE s.status = 'available'),
+			count(*) FILTER (WHERE s.status = 'occupied'),
+			(SELECT COALESCE(SUM(view_count), 0) FROM public.promotion_view_count)
+		FROM public.slot s
+		JOIN public.promotion p ON p.id = s.promotion_id AND p.deleted_at IS NULL`).
+		Scan(&row.ActivePromotions, &row.FreeSlots, &row.OccupiedSlots, &row.TotalViews)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+// BySeller returns one row per promotion the seller took part in (slots, bets or applications)
+// with impressions and clicks of their products there.
+func (r *SellerStatisticsPostgres) BySeller(ctx context.Context, sellerID int64) ([]*SellerPromotionStatsRow, error) {
+	rows, err := r.pool.Query(ctx, `WITH my_slots AS (
+			SELECT promotion_id,
+				count(*) FILTER (WHERE status = 'occupied') AS won,
+				count(*) FILTER (WHERE status = 'moderation') AS in_moderation,
+				COALESCE(SUM(price) FILTER (WHERE status IN ('occupied', 'moderation')), 0) AS committed
+			FROM public.slot WHERE seller_id = $1
+			GROUP BY promotion_id
+		), my_bets AS (
+			SELECT s.promotion_id, b.slot_id, MAX(b.bet) AS max_bet, bool_or(a.date_to > now()) AS active
+			FROM public.bet b
+			JOIN public.slot s ON s.id = b.slot_id
+			JOIN public.auction a ON a.id = b.auction_id
+			WHERE b.seller_id = $1 AND b.deleted_at IS NULL
+			GROUP BY s.promotion_id, b.slot_id
+		), bets AS (
+			SELECT promotion_id,
+				count(*) FILTER (WHERE active) AS active_bets,
+				COALESCE(SUM(max_bet) FILTER (WHERE active), 0) AS active_bets_amount
+			FROM my_bets GROUP BY promotion_id
+		), participation AS (
+			SELECT promotion_id, count(DISTINCT slot_id) AS participated FROM (
+				SELECT promotion_id, slot_id FROM my_bets
+				UNION
+				SELECT promotion_id, slot_id FROM public.moderation WHERE seller_id = $1
+			) x GROUP BY promotion_id
+		), impressions AS (
+			-- only promotions found above, so idx_buyer_event_promotion is used instead of a full scan
+			SELECT e.promotion_id,
+				count(*) FILTER (WHERE e.event_type = 'product_impression') AS impressions,
+				count(*) FILTER (WHERE e.event_type = 'product_click') AS clicks
+			FROM public.buyer_event e
+			JOIN public.product p ON p.id = e.product_id
+			WHERE e.promotion_id IN (SELECT promotion_id FROM my_slots UNION SELECT promotion_id FROM participation)
+				AND e.event_type IN ('product_impression', 'product_click') AND p.seller_id = $1
+			GROUP BY e.promotion_id
+		)
+		SELECT p.id, p.name, p.status,
+			COALESCE(ms.won, 0), COALESCE(ms.in_moderation, 0), COALESCE(ms.committed, 0),
+			COALESCE(b.active_bets, 0), COALESCE(b.active_bets_amount, 0),
+			COALESCE(pa.participated, 0), COALESCE(im.impressions, 0), COALESCE(im.clicks, 0)
+		FROM public.promotion p
+		LEFT JOIN my_slots ms ON ms.promotion_id = p.id
+		LEFT JOIN bets b ON b.promotion_id = p.id
+		LEFT JOIN participation pa ON pa.promotion_id = p.id
+		LEFT JOIN impressions im ON im.promotion_id = p.id
+		WHERE p.deleted_at IS NULL
+			AND (ms.promotion_id IS NOT NULL OR pa.promotion_id IS NOT NULL)
+		ORDER BY p.id DESC`, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*SellerPromotionStatsRow
+	for rows.Next() {
+		var row SellerPromotionStatsRow
+		err = rows.Scan(&row.PromotionID, &row.PromotionName, &row.PromotionStatus,
+			&row.WonSlots, &row.ModerationSlots, &row.CommittedAmount,
+			&row.ActiveBets, &row.ActiveBetsAmount,
+			&row.ParticipatedSlots, &row.Impressions, &row.Clicks)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &row)
+	}
+	return out, rows.Err()
+}
+
+// SlotPerformance returns impressions and clicks for every slot the seller occupies.
+// promotionID = 0 covers all promotions.
+func (r *SellerStatisticsPostgres) SlotPerformance(ctx context.Context, sellerID, promotionID int64) ([]*SlotPerformanceRow, error) {
+	rows, err := r.pool.Query(ctx, `SELECT s.promotion_id, p.name, s.segment_id, sg.name, s.id, s.position, s.pricing_type,
+			pr.id, pr.name, COALESCE(s.price, 0),
+			COALESCE(ev.impressions, 0), COALESCE(ev.clicks, 0)
+		FROM public.slot s
+		JOIN public.promotion p ON p.id = s.promotion_id AND p.deleted_at IS NULL
+		JOIN public.segment sg ON sg.id = s.segment_id
+		JOIN public.product pr ON pr.id = s.product_id
+		LEFT JOIN LATERAL (
+			SELECT count(*) FILTER (WHERE e.event_type = 'product_impression') AS impressions,
+				count(*) FILTER (WHERE e.event_type = 'product_click') AS clicks
+			FROM public.buyer_event e
+			WHERE e.slot_id = s.id AND e.product_id = s.product_id
+				AND e.event_type IN ('product_impression', 'product_click')
+		) ev ON true
+		WHERE s.seller_id = $1 AND s.status = 'occupied'
+			AND ($2::bigint = 0 OR s.promotion_id = $2)
+		ORDER BY s.promotion_id DESC, sg.order_index, s.position`, sellerID, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*SlotPerformanceRow
+	for rows.Next() {
+		var row SlotPerformanceRow
+		err = rows.Scan(&row.PromotionID, &row.PromotionName, &row.SegmentID, &row.SegmentName, &row.SlotID, &row.Position,
+			&row.PricingType, &row.ProductID, &row.ProductName, &row.Price, &row.Impressions, &row.Clicks)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &row)
+	}
+	return out, rows.Err()
+}
+
+var _ SellerStatisticsRepository = (*SellerStatisticsPostgres)(nil)
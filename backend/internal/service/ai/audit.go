@@ -0,0 +1,197 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+// Статусы записей ai_generation_log
+const (
+	GenerationStatusOK      = "ok"
+	GenerationStatusInvalid = "invalid" // ответ не прошёл decodeStrictJSON или валидацию
+	GenerationStatusFailed  = "failed"
+	GenerationStatusCached  = "cached"
+)
+
+const (
+	defaultLogLimit  = 50
+	maxLogLimit      = 200
+	defaultUsageDays = 30
+)
+
+var ErrInvalidUsagePeriod = errors.New("invalid ai usage period")
+
+// TokenPrice is the provider price in USD per million tokens.
+type TokenPrice struct {
+	Input  float64
+	Output float64
+}
+
+func (p TokenPrice) cost(u Usage) float64 {
+	return (float64(u.PromptTokens)*p.Input + float64(u.CompletionTokens)*p.Output) / 1e6
+}
+
+// recordGeneration writes the provider calls of a generation to the audit log, one row per
+// provider: those the chain fell back from are failed rows with their own tokens and cost.
+// API keys are masked in everything stored; a failed insert never fails the generation.
+func (s *Service) recordGeneration(ctx context.Context, operation, prompt string, gen *generation, status string, genErr error) {
+	if s.logRepo == nil {
+		return
+	}
+	for _, row := range s.generationLogRows(operation, prompt, gen, status, genErr) {
+		if err := s.logRepo.Insert(ctx, row); err != nil {
+			slog.WarnContext(ctx, "write ai generation log", slog.String("operation", operation), slog.String("error", err.Error()))
+		}
+	}
+}
+
+func (s *Service) generationLogRows(operation, prompt string, gen *generation, status string, genErr error) []*repository.AIGenerationLogRow {
+	newRow := func(provider, model string) *repository.AIGenerationLogRow {
+		return &repository.AIGenerationLogRow{
+			Operation: operation,
+			Provider:  provider,
+			Model:     model,
+			Status:    status,
+			Prompt:    s.redact(prompt),
+		}
+	}
+	// the last row carries the outcome of the whole generation
+	finish := func(row *repository.AIGenerationLogRow) {
+		if gen != nil {
+			row.Response = s.redact(gen.Raw)
+		}
+		if genErr != nil {
+			row.Error = s.redact(genErr.Error())
+		}
+	}
+	if gen == nil || len(gen.Attempts) == 0 {
+		// no provider was called: a cache hit or every circuit open
+		row := newRow(s.provider, "")
+		if gen != nil && gen.Provider != "" {
+			row.Provider, row.Model = gen.Provider, gen.Model
+		}
+		finish(row)
+		return []*repository.AIGenerationLogRow{row}
+	}
+	rows := make([]*repository.AIGenerationLogRow, 0, len(gen.Attempts))
+	for i, a := range gen.Attempts {
+		row := newRow(a.Provider, a.Model)
+		row.LatencyMs = a.Latency.Milliseconds()
+		row.PromptTokens = a.Usage.PromptTokens
+		row.CompletionTokens = a.Usage.CompletionTokens
+		row.TotalTokens = a.Usage.TotalTokens
+		row.CostUSD = s.prices[a.Provider].cost(a.Usage)
+		if i < len(gen.Attempts)-1 {
+			row.Status = GenerationStatusFailed
+			if a.Err != nil {
+				row.Error = s.redact(a.Err.Error())
+			}
+		} else {
+			finish(row)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// redact masks configured API keys, e.g. echoed back in provider error bodies.
+func (s *Service) redact(text string) string {
+	for _, secret := range s.secrets {
+		text = strings.ReplaceAll(text, secret, "[REDACTED]")
+	}
+	return text
+}
+
+// redactedError keeps the error chain for errors.Is/As but masks API keys in the message,
+// which ends up in request logs and API responses.
+type redactedError struct {
+	err error
+	msg string
+}
+
+func (e *redactedError) Error() string { return e.msg }
+func (e *redactedError) Unwrap() error { return e.err }
+
+// ListGenerations returns the audit log newest first and the number of matching entries.
+func (s *Service) ListGenerations(ctx context.Context, filter repository.AIGenerationLogFilter) ([]*entity.AIGenerationLog, int64, error) {
+	if s.logRepo == nil {
+		return nil, 0, nil
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultLogLimit
+	}
+	if filter.Limit > maxLogLimit {
+		filter.Limit = maxLogLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	rows, total, err := s.logRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	out := make([]*entity.AIGenerationLog, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.AIGenerationLog{
+			ID:               row.ID,
+			Operation:        row.Operation,
+			Provider:         row.Provider,
+			Model:            row.Model,
+			Status:           row.Status,
+			Latency:          time.Duration(row.LatencyMs) * time.Millisecond,
+			PromptTokens:     row.PromptTokens,
+			CompletionTokens: row.CompletionTokens,
+			TotalTokens:      row.TotalTokens,
+			CostUSD:          row.CostUSD,
+			Prompt:           row.Prompt,
+			Response:         row.Response,
+			Error:            row.Error,
+			CreatedAt:        row.CreatedAt,
+		})
+	}
+	return out, total, nil
+}
+
+// DailyUsage returns tokens, cost and call counts per UTC day, provider and model.
+// Zero bounds mean the last 30 days.
+func (s *Service) DailyUsage(ctx context.Context, from, to time.Time) ([]*entity.AIUsageDay, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -defaultUsageDays)
+	}
+	if !from.Before(to) {
+		return nil, ErrInvalidUsagePeriod
+	}
+	if s.logRepo == nil {
+		return nil, nil
+	}
+	rows, err := s.logRepo.DailyUsage(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*entity.AIUsageDay, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.AIUsageDay{
+			Day:              row.Day,
+			Provider:         row.Provider,
+			Model:            row.Model,
+			Calls:            row.Calls,
+			FailedCalls:      row.FailedCalls,
+			CachedCalls:      row.CachedCalls,
+			PromptTokens:     row.PromptTokens,
+			CompletionTokens: row.CompletionTokens,
+			TotalTokens:      row.TotalTokens,
+			CostUSD:          row.CostUSD,
+			AvgLatency:       time.Duration(row.AvgLatencyMs * float64(time.Millisecond)),
+		})
+	}
+	return out, nil
+}
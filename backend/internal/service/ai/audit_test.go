@@ -0,0 +1,91 @@
+package ai
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestGenerationLogRows(t *testing.T) {
+	s := &Service{
+		provider: "gemini,groq",
+		secrets:  []string{"sk-secret"},
+		prices: map[string]TokenPrice{
+			providerGemini: {Input: 1, Output: 10},
+			providerGroq:   {Input: 0.1, Output: 0.2},
+		},
+	}
+	type wantRow struct {
+		provider string
+		status   string
+		tokens   int64
+		cost     float64
+		response string
+		err      string
+	}
+	tests := []struct {
+		name   string
+		gen    *generation
+		status string
+		genErr error
+		want   []wantRow
+	}{
+		{
+			name: "fallback priced per provider",
+			gen: &generation{
+				Raw:      `{"ok":true}`,
+				Provider: providerGroq,
+				Attempts: []*providerAttempt{
+					{Provider: providerGemini, Usage: Usage{PromptTokens: 1_000_000, TotalTokens: 1_000_000}, Err: errors.New("key sk-secret rejected")},
+					{Provider: providerGroq, Usage: Usage{PromptTokens: 1_000_000, CompletionTokens: 1_000_000, TotalTokens: 2_000_000}},
+				},
+			},
+			status: GenerationStatusOK,
+			want: []wantRow{
+				{provider: providerGemini, status: GenerationStatusFailed, tokens: 1_000_000, cost: 1, err: "key [REDACTED] rejected"},
+				{provider: providerGroq, status: GenerationStatusOK, tokens: 2_000_000, cost: 0.3, response: `{"ok":true}`},
+			},
+		},
+		{
+			name:   "cache hit",
+			gen:    &generation{Raw: `{"cached":1}`, Provider: providerGroq},
+			status: GenerationStatusCached,
+			want:   []wantRow{{provider: providerGroq, status: GenerationStatusCached, response: `{"cached":1}`}},
+		},
+		{
+			name:   "no provider called",
+			status: GenerationStatusFailed,
+			genErr: errors.New("bad sk-secret"),
+			want:   []wantRow{{provider: "gemini,groq", status: GenerationStatusFailed, err: "bad [REDACTED]"}},
+		},
+		{
+			name: "invalid answer",
+			gen: &generation{
+				Raw:      `{"echo":"sk-secret"}`,
+				Provider: providerGemini,
+				Attempts: []*providerAttempt{{Provider: providerGemini, Usage: Usage{CompletionTokens: 100_000, TotalTokens: 100_000}}},
+			},
+			status: GenerationStatusInvalid,
+			genErr: errors.New("decode"),
+			want:   []wantRow{{provider: providerGemini, status: GenerationStatusInvalid, tokens: 100_000, cost: 1, response: `{"echo":"[REDACTED]"}`, err: "decode"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := s.generationLogRows(operationThemes, "prompt with sk-secret", tt.gen, tt.status, tt.genErr)
+			if len(rows) != len(tt.want) {
+				t.Fatalf("got %d rows, want %d", len(rows), len(tt.want))
+			}
+			for i, w := range tt.want {
+				row := rows[i]
+				if row.Provider != w.provider || row.Status != w.status || row.TotalTokens != w.tokens ||
+					row.Response != w.response || row.Error != w.err || math.Abs(row.CostUSD-w.cost) > 1e-9 {
+					t.Errorf("row %d = %+v, want %+v", i, *row, w)
+				}
+				if row.Prompt != "prompt with [REDACTED]" || row.Operation != operationThemes {
+					t.Errorf("row %d prompt %q, operation %q", i, row.Prompt, row.Operation)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+
+	"wildberries/internal/repository"
+)
+
+// generateCached serves a generation from ai_generation_cache or asks the providers and
+// stores the validated result. Cached answers are parsed again, so a stricter validator
+// never serves stale invalid data. Cache failures only cost a provider call.
+func generateCached[T any](ctx context.Context, s *Service, kind, prompt string, force bool, parse func(raw string) (T, error)) (T, error) {
+	hash := promptHash(prompt)
+	if s.cacheRepo != nil && !force {
+		if result, row, ok := cachedGeneration(ctx, s, hash, parse); ok {
+			s.recordGeneration(ctx, kind, prompt, &generation{Raw: row.RawJSON, Provider: row.Provider, Model: row.Model}, GenerationStatusCached, nil)
+			return result, nil
+		}
+	}
+
+	var result T
+	gen, err := s.generateValidJSON(ctx, kind, prompt, func(raw string) (err error) {
+		result, err = parse(raw)
+		return err
+	})
+	if err != nil {
+		return result, err
+	}
+	if s.cacheRepo != nil {
+		err := s.cacheRepo.Put(ctx, &repository.AIGenerationCacheRow{
+			Provider:   gen.Provider,
+			Model:      gen.Model,
+			PromptHash: hash,
+			Kind:       kind,
+			RawJSON:    gen.Raw,
+		}, s.cacheTTL)
+		if err != nil {
+			slog.WarnContext(ctx, "store ai generation in cache", slog.String("kind", kind), slog.String("error", err.Error()))
+		}
+	}
+	return result, nil
+}
+
+// cachedGeneration looks the prompt up for every provider of the chain, in chain order.
+func cachedGeneration[T any](ctx context.Context, s *Service, hash string, parse func(raw string) (T, error)) (T, *repository.AIGenerationCacheRow, bool) {
+	var zero T
+	for _, m := range s.generator.members {
+		row, err := s.cacheRepo.Get(ctx, m.name, m.model, hash)
+		if errors.Is(err, repository.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "read ai generation cache", slog.String("error", err.Error()))
+			return zero, nil, false
+		}
+		if result, err := parse(row.RawJSON); err == nil {
+			return result, row, true
+		}
+	}
+	return zero, nil, false
+}
+
+// PurgeExpiredCache deletes expired generations; returns the number of removed rows.
+func (s *Service) PurgeExpiredCache(ctx context.Context) (int64, error) {
+	if s.cacheRepo == nil {
+		return 0, nil
+	}
+	return s.cacheRepo.DeleteExpired(ctx)
+}
+
+func promptHash(prompt string) string {
+	sum := sha256.Sum256([]byte(prompt))
+	return hex.EncodeToString(sum[:])
+}
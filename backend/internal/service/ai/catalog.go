@@ -0,0 +1,74 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"wildberries/internal/entity"
+)
+
+// maxPromptCategories — сколько крупнейших категорий каталога попадает в промпт
+const maxPromptCategories = 150
+
+// ErrNoCategorySupply — в каталоге нет категории, товаров которой хватит на slot_count позиций
+var ErrNoCategorySupply = errors.New("no catalog category has enough products to fill the slots")
+
+// catalogCategories returns the product categories segments may use, largest first.
+// slotCount > 0 keeps only categories with at least that many products, so every
+// segment position can be filled. nil means there is no catalog to check against.
+func (s *Service) catalogCategories(ctx context.Context, slotCount int) ([]*entity.CategoryStat, error) {
+	if s.productRepo == nil {
+		return nil, nil
+	}
+	rows, err := s.productRepo.CategoryStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load catalog categories: %w", err)
+	}
+	if len(rows) == 0 && slotCount <= 0 {
+		return nil, nil
+	}
+	categories := make([]*entity.CategoryStat, 0, len(rows))
+	for _, row := range rows {
+		if slotCount > 0 && row.Products < int64(slotCount) {
+			continue
+		}
+		categories = append(categories, &entity.CategoryStat{
+			Name:     strings.TrimSpace(row.CategoryName),
+			Products: row.Products,
+			Sellers:  row.Sellers,
+		})
+	}
+	if len(categories) == 0 {
+		return nil, fmt.Errorf("%w: slot_count %d", ErrNoCategorySupply, slotCount)
+	}
+	if len(categories) > maxPromptCategories {
+		categories = categories[:maxPromptCategories]
+	}
+	return categories, nil
+}
+
+// categoryIndex maps lower-cased names to catalog spelling; nil disables the check.
+func categoryIndex(categories []*entity.CategoryStat) map[string]string {
+	if len(categories) == 0 {
+		return nil
+	}
+	index := make(map[string]string, len(categories))
+	for _, c := range categories {
+		index[strings.ToLower(c.Name)] = c.Name
+	}
+	return index
+}
+
+func buildCategoriesBlock(categories []*entity.CategoryStat) string {
+	if len(categories) == 0 {
+		return ""
+	}
+	lines := make([]string, 0, len(categories)+1)
+	lines = append(lines, "Доступные категории каталога (товаров / продавцов):")
+	for _, c := range categories {
+		lines = append(lines, fmt.Sprintf("- %s (%d / %d)", c.Name, c.Products, c.Sellers))
+	}
+	return "\n" + strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,306 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"wildberries/internal/metrics"
+)
+
+const (
+	defaultRetryAttempts    = 3
+	defaultRetryBaseDelay   = 500 * time.Millisecond
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned for a provider whose circuit breaker is open.
+var ErrCircuitOpen = errors.New("ai provider circuit is open")
+
+// StatusError is a non-2xx response of an AI provider.
+type StatusError struct {
+	Provider   string
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s request failed: status=%d body=%s", e.Provider, e.StatusCode, e.Body)
+}
+
+// isTransient reports whether a retry of the same provider may succeed.
+func isTransient(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests ||
+			statusErr.StatusCode == http.StatusRequestTimeout ||
+			statusErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// Usage is the token usage reported by a provider.
+type Usage struct {
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+}
+
+func (u Usage) add(o Usage) Usage {
+	return Usage{
+		PromptTokens:     u.PromptTokens + o.PromptTokens,
+		CompletionTokens: u.CompletionTokens + o.CompletionTokens,
+		TotalTokens:      u.TotalTokens + o.TotalTokens,
+	}
+}
+
+// usageGenerator is implemented by clients that report token usage.
+type usageGenerator interface {
+	generateWithUsage(ctx context.Context, prompt string) (string, Usage, error)
+}
+
+// generation is one answer of the chain.
+type generation struct {
+	Raw      string
+	Provider string
+	Model    string
+	Attempts []*providerAttempt // providers called, in chain order; all but the last failed
+}
+
+// providerAttempt is one provider of the chain with its retries: the usage is priced
+// at this provider's rates, whichever provider answered in the end.
+type providerAttempt struct {
+	Provider string
+	Model    string
+	Usage    Usage
+	Latency  time.Duration
+	Err      error
+}
+
+// chainMember is one provider of the chain with its own circuit breaker.
+type chainMember struct {
+	name      string
+	model     string
+	generator JSONGenerator
+	breaker   *circuitBreaker
+}
+
+// chainGenerator tries providers in order: each is retried with backoff on transient
+// failures, and a provider with an open circuit is skipped.
+type chainGenerator struct {
+	members   []*chainMember
+	attempts  int
+	baseDelay time.Duration
+	sleep     func(ctx context.Context, d time.Duration) error
+}
+
+func newChainGenerator(members []*chainMember, attempts int, baseDelay time.Duration) *chainGenerator {
+	if attempts <= 0 {
+		attempts = defaultRetryAttempts
+	}
+	if baseDelay <= 0 {
+		baseDelay = defaultRetryBaseDelay
+	}
+	return &chainGenerator{members: members, attempts: attempts, baseDelay: baseDelay, sleep: sleepContext}
+}
+
+func (c *chainGenerator) GenerateJSON(ctx context.Context, prompt string) (string, error) {
+	gen, err := c.generate(ctx, prompt)
+	if err != nil {
+		return "", err
+	}
+	return gen.Raw, nil
+}
+
+// generate is GenerateJSON that also reports which providers were called and what each cost.
+// On failure the result still names the last provider tried, for the audit log.
+func (c *chainGenerator) generate(ctx context.Context, prompt string) (*generation, error) {
+	gen := &generation{}
+	if len(c.members) == 0 {
+		return gen, errors.New("no ai provider is configured")
+	}
+
+	failures := make([]string, 0, len(c.members))
+	var lastErr error
+	for _, m := range c.members {
+		allowed, trial := m.breaker.allow()
+		if !allowed {
+			failures = append(failures, fmt.Sprintf("%s: %v", m.name, ErrCircuitOpen))
+			lastErr = ErrCircuitOpen
+			continue
+		}
+		gen.Provider, gen.Model = m.name, m.model
+		startedAt := time.Now()
+		raw, usage, err := c.callMember(ctx, m, prompt, trial)
+		gen.Attempts = append(gen.Attempts, &providerAttempt{
+			Provider: m.name,
+			Model:    m.model,
+			Usage:    usage,
+			Latency:  time.Since(startedAt),
+			Err:      err,
+		})
+		if err == nil {
+			gen.Raw = raw
+			return gen, nil
+		}
+		if ctx.Err() != nil {
+			return gen, err
+		}
+		failures = append(failures, fmt.Sprintf("%s: %v", m.name, err))
+		lastErr = err
+	}
+	return gen, fmt.Errorf("all ai providers failed (%s): %w", strings.Join(failures, "; "), lastErr)
+}
+
+// callMember calls a provider the breaker let through and records the outcome. A call cut
+// short by the caller's context says nothing about the provider and is not counted; the
+// deferred release makes sure such a half-open trial does not keep the circuit shut for good.
+func (c *chainGenerator) callMember(ctx context.Context, m *chainMember, prompt string, trial bool) (string, Usage, error) {
+	if trial {
+		defer m.breaker.release()
+	}
+	raw, usage, err := c.tryMember(ctx, m, prompt)
+	switch {
+	case err == nil:
+		m.breaker.success()
+	case ctx.Err() == nil:
+		m.breaker.failure()
+	}
+	return raw, usage, err
+}
+
+// tryMember calls one provider, retrying transient failures with backoff.
+func (c *chainGenerator) tryMember(ctx context.Context, m *chainMember, prompt string) (string, Usage, error) {
+	var err error
+	var total Usage
+	for attempt := 0; attempt < c.attempts; attempt++ {
+		if attempt > 0 {
+			if sleepErr := c.sleep(ctx, backoff(c.baseDelay, attempt)); sleepErr != nil {
+				return "", total, sleepErr
+			}
+		}
+		startedAt := time.Now()
+		var raw string
+		var usage Usage
+		if g, ok := m.generator.(usageGenerator); ok {
+			raw, usage, err = g.generateWithUsage(ctx, prompt)
+		} else {
+			raw, err = m.generator.GenerateJSON(ctx, prompt)
+		}
+		metrics.ObserveAICall(m.name, time.Since(startedAt), err)
+		total = total.add(usage)
+		if err == nil {
+			return raw, total, nil
+		}
+		if !isTransient(err) || ctx.Err() != nil {
+			return "", total, err
+		}
+	}
+	return "", total, err
+}
+
+// Validate succeeds if at least one provider of the chain is configured.
+func (c *chainGenerator) Validate() error {
+	var errs []string
+	for _, m := range c.members {
+		v, ok := m.generator.(Validator)
+		if !ok {
+			return nil
+		}
+		err := v.Validate()
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err.Error())
+	}
+	if len(errs) == 0 {
+		return errors.New("no ai provider is configured")
+	}
+	return errors.New(strings.Join(errs, "; "))
+}
+
+// backoff is exponential with up to 50% jitter: base, 2*base, 4*base...
+func backoff(base time.Duration, attempt int) time.Duration {
+	d := base << (attempt - 1)
+	return d + time.Duration(rand.Int64N(int64(d)/2+1))
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// circuitBreaker opens after `threshold` consecutive failures and lets one trial
+// request through after `cooldown` (half-open); its result closes or reopens the circuit.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	trial     bool
+	now       func() time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		threshold = defaultBreakerThreshold
+	}
+	if cooldown <= 0 {
+		cooldown = defaultBreakerCooldown
+	}
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// allow reports whether a request may go to the provider and whether it is the half-open trial.
+func (b *circuitBreaker) allow() (allowed, trial bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return true, false
+	}
+	if b.trial || b.now().Before(b.openUntil) {
+		return false, false
+	}
+	b.trial = true
+	return true, true
+}
+
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.trial = false
+}
+
+// release ends a half-open trial that was neither a success nor a failure,
+// so the next request may try again; after success or failure it changes nothing.
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
+
+func (b *circuitBreaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.trial = false
+	if b.failures >= b.threshold {
+		b.openUntil = b.now().Add(b.cooldown)
+	}
+}
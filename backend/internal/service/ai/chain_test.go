@@ -0,0 +1,219 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const okCompletion = `{"choices":[{"message":{"content":"{\"ok\":true}"}}],"usage":{"prompt_tokens":3,"completion_tokens":2,"total_tokens":5}}`
+
+// fakeProvider is an OpenAI-compatible server answering with the given status codes in turn
+// (the last one repeats); 200 gets okCompletion.
+type fakeProvider struct {
+	*httptest.Server
+	calls atomic.Int32
+}
+
+func newFakeProvider(t *testing.T, statuses ...int) *fakeProvider {
+	t.Helper()
+	p := &fakeProvider{}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(p.calls.Add(1))
+		status := statuses[min(n, len(statuses))-1]
+		if status != http.StatusOK {
+			http.Error(w, "fail", status)
+			return
+		}
+		_, _ = w.Write([]byte(okCompletion))
+	}))
+	t.Cleanup(p.Close)
+	return p
+}
+
+func testMember(name, baseURL string, breaker *circuitBreaker) *chainMember {
+	return &chainMember{
+		name:      name,
+		model:     "test-model",
+		generator: NewOpenAIClient(Config{OpenAIAPIBaseURL: baseURL, OpenAIModel: "test-model"}),
+		breaker:   breaker,
+	}
+}
+
+func testChain(members ...*chainMember) *chainGenerator {
+	c := newChainGenerator(members, 3, time.Millisecond)
+	c.sleep = func(context.Context, time.Duration) error { return nil }
+	return c
+}
+
+func TestChainGenerator(t *testing.T) {
+	tests := []struct {
+		name         string
+		primary      []int
+		fallback     []int
+		wantProvider string
+		wantPrimary  int32 // calls to the primary provider
+		wantFallback int32
+		wantErr      bool
+	}{
+		{name: "retries transient error", primary: []int{503, 200}, fallback: []int{200}, wantProvider: "primary", wantPrimary: 2},
+		{name: "falls back after retries", primary: []int{500}, fallback: []int{200}, wantProvider: "fallback", wantPrimary: 3, wantFallback: 1},
+		{name: "does not retry client error", primary: []int{400}, fallback: []int{200}, wantProvider: "fallback", wantPrimary: 1, wantFallback: 1},
+		{name: "all providers fail", primary: []int{500}, fallback: []int{401}, wantProvider: "fallback", wantPrimary: 3, wantFallback: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, fallback := newFakeProvider(t, tt.primary...), newFakeProvider(t, tt.fallback...)
+			chain := testChain(
+				testMember("primary", primary.URL, newCircuitBreaker(5, time.Minute)),
+				testMember("fallback", fallback.URL, newCircuitBreaker(5, time.Minute)),
+			)
+			gen, err := chain.generate(context.Background(), "prompt")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gen.Provider != tt.wantProvider {
+				t.Errorf("provider = %q, want %q", gen.Provider, tt.wantProvider)
+			}
+			// one attempt per provider called, the answering one last
+			if last := gen.Attempts[len(gen.Attempts)-1]; last.Provider != tt.wantProvider {
+				t.Errorf("last attempt = %q, want %q", last.Provider, tt.wantProvider)
+			}
+			if want := 1 + min(tt.wantFallback, 1); len(gen.Attempts) != int(want) {
+				t.Errorf("attempts = %d, want %d", len(gen.Attempts), want)
+			}
+			if got := primary.calls.Load(); got != tt.wantPrimary {
+				t.Errorf("primary calls = %d, want %d", got, tt.wantPrimary)
+			}
+			if got := fallback.calls.Load(); got != tt.wantFallback {
+				t.Errorf("fallback calls = %d, want %d", got, tt.wantFallback)
+			}
+		})
+	}
+}
+
+func TestChainGeneratorBreaker(t *testing.T) {
+	primary, fallback := newFakeProvider(t, 500, 500, 500, 200), newFakeProvider(t, 200)
+	now := time.Now()
+	breaker := newCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+	chain := testChain(testMember("primary", primary.URL, breaker), testMember("fallback", fallback.URL, newCircuitBreaker(1, time.Minute)))
+
+	// the first failure opens the primary circuit
+	if _, err := chain.generate(context.Background(), "prompt"); err != nil {
+		t.Fatal(err)
+	}
+	// while open the primary is skipped
+	if gen, err := chain.generate(context.Background(), "prompt"); err != nil || gen.Provider != "fallback" {
+		t.Fatalf("open circuit: provider %q, err %v", gen.Provider, err)
+	}
+	if got := primary.calls.Load(); got != 3 {
+		t.Fatalf("primary calls with open circuit = %d, want 3", got)
+	}
+	// after the cooldown one trial goes through and its success closes the circuit
+	now = now.Add(2 * time.Minute)
+	if gen, err := chain.generate(context.Background(), "prompt"); err != nil || gen.Provider != "primary" {
+		t.Fatalf("trial: provider %q, err %v", gen.Provider, err)
+	}
+	if allowed, trial := breaker.allow(); !allowed || trial {
+		t.Errorf("after successful trial allow() = %v, %v; want closed circuit", allowed, trial)
+	}
+}
+
+func TestChainGeneratorCancelledTrial(t *testing.T) {
+	started := make(chan struct{}, 1)
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the body must be read for the server to notice the client going away
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-r.Context().Done()
+	}))
+	defer provider.Close()
+
+	now := time.Now()
+	breaker := newCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+	breaker.failure() // open
+	now = now.Add(2 * time.Minute)
+
+	chain := testChain(testMember("primary", provider.URL, breaker))
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	if _, err := chain.generate(ctx, "prompt"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	// the cancelled trial is neither success nor failure: the next request gets a new trial
+	if allowed, trial := breaker.allow(); !allowed || !trial {
+		t.Errorf("after cancelled trial allow() = %v, %v; want a new trial", allowed, trial)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	type step struct {
+		op      string // allow | success | failure | release | wait
+		allowed bool
+		trial   bool
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{name: "closed below threshold", steps: []step{
+			{op: "failure"}, {op: "allow", allowed: true},
+		}},
+		{name: "opens at threshold", steps: []step{
+			{op: "failure"}, {op: "failure"}, {op: "allow"},
+		}},
+		{name: "single trial after cooldown", steps: []step{
+			{op: "failure"}, {op: "failure"}, {op: "wait"},
+			{op: "allow", allowed: true, trial: true}, {op: "allow"},
+		}},
+		{name: "failed trial reopens", steps: []step{
+			{op: "failure"}, {op: "failure"}, {op: "wait"},
+			{op: "allow", allowed: true, trial: true}, {op: "failure"}, {op: "allow"},
+		}},
+		{name: "successful trial closes", steps: []step{
+			{op: "failure"}, {op: "failure"}, {op: "wait"},
+			{op: "allow", allowed: true, trial: true}, {op: "success"}, {op: "allow", allowed: true},
+		}},
+		{name: "released trial allows another", steps: []step{
+			{op: "failure"}, {op: "failure"}, {op: "wait"},
+			{op: "allow", allowed: true, trial: true}, {op: "release"}, {op: "allow", allowed: true, trial: true},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			b := newCircuitBreaker(2, time.Minute)
+			b.now = func() time.Time { return now }
+			for i, s := range tt.steps {
+				switch s.op {
+				case "allow":
+					allowed, trial := b.allow()
+					if allowed != s.allowed || trial != s.trial {
+						t.Fatalf("step %d: allow() = %v, %v; want %v, %v", i, allowed, trial, s.allowed, s.trial)
+					}
+				case "success":
+					b.success()
+				case "failure":
+					b.failure()
+				case "release":
+					b.release()
+				case "wait":
+					now = now.Add(2 * time.Minute)
+				}
+			}
+		})
+	}
+}
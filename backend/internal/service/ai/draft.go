@@ -0,0 +1,201 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"wildberries/internal/entity"
+)
+
+const (
+	defaultDraftSegments = 6
+	// 4 вопроса по 3 варианта: по 2 свободных варианта у первых трёх и 3 у последнего
+	maxDraftSegments = 9
+)
+
+var (
+	ErrThemeRequired = errors.New("theme is required")
+	// ErrInconsistentDraft — дерево ответов не сходится со сгенерированными вопросами или сегментами
+	ErrInconsistentDraft = errors.New("answer tree does not match generated quiz")
+)
+
+// DraftStore stores a generated draft as a NOT_READY promotion; the promotion service implements it.
+type DraftStore interface {
+	CreatePromotionDraft(ctx context.Context, p *entity.Promotion, draft *entity.PromotionDraft) (int64, []int64, error)
+}
+
+// GeneratePromotionDraft generates segments, quiz questions, the answer tree, name and
+// description for a theme. The answer tree is generated for the produced segments and
+// questions and checked against them; a tree that does not fit is re-prompted once.
+func (s *Service) GeneratePromotionDraft(ctx context.Context, theme string, segmentCount, slotCount int) (*entity.PromotionDraft, error) {
+	theme = strings.TrimSpace(theme)
+	if theme == "" {
+		return nil, ErrThemeRequired
+	}
+	if segmentCount <= 0 {
+		segmentCount = defaultDraftSegments
+	}
+	if segmentCount > maxDraftSegments {
+		segmentCount = maxDraftSegments
+	}
+
+	segments, err := s.GenerateSegments(ctx, theme, segmentCount, slotCount, false)
+	if err != nil {
+		return nil, fmt.Errorf("generate segments: %w", err)
+	}
+	if err := entity.CheckSegmentNames(segments); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInconsistentDraft, err)
+	}
+	questions, err := s.GenerateQuestions(ctx, theme, QuizShape{}, false)
+	if err != nil {
+		return nil, fmt.Errorf("generate questions: %w", err)
+	}
+	tree, err := s.generateDraftAnswerTree(ctx, theme, segments, questions)
+	if err != nil {
+		return nil, fmt.Errorf("generate answer tree: %w", err)
+	}
+
+	params := map[string]string{
+		"theme":               theme,
+		"segments":            draftSegmentsContext(segments),
+		"questions":           draftQuestionsContext(questions),
+		"identification_mode": "questions",
+	}
+	params["target"] = "promotion_name"
+	name, err := s.GetText(ctx, params, 0)
+	if err != nil {
+		return nil, fmt.Errorf("generate name: %w", err)
+	}
+	params["promotion_name"] = name
+	params["target"] = "promotion_description"
+	description, err := s.GetText(ctx, params, 0)
+	if err != nil {
+		return nil, fmt.Errorf("generate description: %w", err)
+	}
+
+	return &entity.PromotionDraft{
+		Theme:       theme,
+		Name:        name,
+		Description: description,
+		Segments:    segments,
+		Questions:   questions,
+		AnswerTree:  tree,
+	}, nil
+}
+
+// savePromotionDraft runs a promotion_draft job: generates the draft and stores it with the
+// promotion settings from the request.
+func (s *Service) savePromotionDraft(ctx context.Context, req JobRequest) (*entity.SavedPromotionDraft, error) {
+	if s.drafts == nil || req.Promotion == nil {
+		return nil, ErrDraftSettings
+	}
+	draft, err := s.GeneratePromotionDraft(ctx, req.Theme, req.Limit, req.SlotCount)
+	if err != nil {
+		return nil, err
+	}
+	promo := *req.Promotion
+	promo.Name = draft.Name
+	promo.Description = draft.Description
+	promo.Theme = draft.Theme
+	id, segmentIDs, err := s.drafts.CreatePromotionDraft(ctx, &promo, draft)
+	if err != nil {
+		return nil, fmt.Errorf("save draft: %w", err)
+	}
+	return &entity.SavedPromotionDraft{PromotionID: id, SegmentIDs: segmentIDs, Draft: draft}, nil
+}
+
+func (s *Service) generateDraftAnswerTree(ctx context.Context, theme string, segments []*entity.SegmentSuggestion, questions []*entity.QuestionSuggestion) ([]*entity.AnswerTreeNode, error) {
+	if s.provider == providerStub {
+		tree := stubAnswerTree(quizShapeOf(questions))
+		return tree, validateDraftAnswerTree(tree, questions, len(segments))
+	}
+
+	promptContext := strings.Join([]string{
+		"Выбранная тема: " + theme,
+		"Идентификация: questions",
+		"Сегменты акции: " + draftSegmentsContext(segments),
+		"Текущие вопросы: " + draftQuestionsContext(questions),
+	}, "\n")
+	prompt, err := s.buildAnswerTreePrompt(ctx, promptContext, quizShapeOf(questions))
+	if err != nil {
+		return nil, err
+	}
+	var result []*entity.AnswerTreeNode
+	_, err = s.generateValidJSON(ctx, operationAnswerTree, prompt, func(raw string) (err error) {
+		if result, err = parseAnswerTree(raw, quizShapeOf(questions)); err != nil {
+			return err
+		}
+		return validateDraftAnswerTree(result, questions, len(segments))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// validateDraftAnswerTree checks that every option of every question has exactly one edge,
+// question targets only move forward (so the quiz always ends) and segment targets exist.
+func validateDraftAnswerTree(nodes []*entity.AnswerTreeNode, questions []*entity.QuestionSuggestion, segmentCount int) error {
+	covered := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		if n.Label == "meta:start" {
+			start, _ := strconv.Atoi(n.Value)
+			if start < 0 || start >= len(questions) {
+				return fmt.Errorf("%w: meta:start points to question %d of %d", ErrInconsistentDraft, start, len(questions))
+			}
+			continue
+		}
+		match := answerTreeLabelRegexp.FindStringSubmatch(n.Label)
+		q, _ := strconv.Atoi(match[1])
+		o, _ := strconv.Atoi(match[2])
+		if q >= len(questions) || o >= len(questions[q].Options) {
+			return fmt.Errorf("%w: %s has no matching question option", ErrInconsistentDraft, n.Label)
+		}
+		covered[n.Label] = struct{}{}
+
+		target := answerTreeValueRegexp.FindStringSubmatch(n.Value)
+		if target[1] == "question" {
+			next, _ := strconv.Atoi(target[2])
+			if next <= q || next >= len(questions) {
+				return fmt.Errorf("%w: %s must lead to a later question, got question:%d", ErrInconsistentDraft, n.Label, next)
+			}
+			continue
+		}
+		segment, _ := strconv.Atoi(segmentTargetRegexp.FindStringSubmatch(target[2])[1])
+		if segment < 1 || segment > segmentCount {
+			return fmt.Errorf("%w: %s targets segment-%d, only %d segments", ErrInconsistentDraft, n.Label, segment, segmentCount)
+		}
+	}
+	for q, question := range questions {
+		for o := range question.Options {
+			if _, ok := covered[fmt.Sprintf("edge:q%d:o%d", q, o)]; !ok {
+				return fmt.Errorf("%w: edge:q%d:o%d is missing", ErrInconsistentDraft, q, o)
+			}
+		}
+	}
+	return nil
+}
+
+// draftSegmentsContext lists segments the way the admin UI passes them to the prompts.
+func draftSegmentsContext(segments []*entity.SegmentSuggestion) string {
+	parts := make([]string, 0, len(segments))
+	for i, seg := range segments {
+		parts = append(parts, fmt.Sprintf("segment-%d=%q [категория: %s]", i+1, seg.Name, seg.CategoryName))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func draftQuestionsContext(questions []*entity.QuestionSuggestion) string {
+	parts := make([]string, 0, len(questions))
+	for qi, q := range questions {
+		options := make([]string, 0, len(q.Options))
+		for oi, opt := range q.Options {
+			options = append(options, fmt.Sprintf("o%d=%q", oi, opt.Text))
+		}
+		parts = append(parts, fmt.Sprintf("q%d=%q -> %s", qi, q.Text, strings.Join(options, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
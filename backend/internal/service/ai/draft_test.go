@@ -0,0 +1,96 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"wildberries/internal/entity"
+)
+
+func TestValidateDraftAnswerTree(t *testing.T) {
+	questions := []*entity.QuestionSuggestion{
+		{Options: []*entity.OptionSuggestion{{}, {}}},
+		{Options: []*entity.OptionSuggestion{{}, {}}},
+	}
+	edge := func(label, value string) *entity.AnswerTreeNode {
+		return &entity.AnswerTreeNode{Label: label, Value: value}
+	}
+	tests := []struct {
+		name string
+		tree []*entity.AnswerTreeNode
+		ok   bool
+	}{
+		{name: "complete", ok: true, tree: []*entity.AnswerTreeNode{
+			edge("meta:start", "0"),
+			edge("edge:q0:o0", "question:1"), edge("edge:q0:o1", "segment:segment-1"),
+			edge("edge:q1:o0", "segment:segment-2"), edge("edge:q1:o1", "segment:segment-1"),
+		}},
+		{name: "missing edge", tree: []*entity.AnswerTreeNode{
+			edge("edge:q0:o0", "question:1"), edge("edge:q0:o1", "segment:segment-1"),
+			edge("edge:q1:o0", "segment:segment-2"),
+		}},
+		{name: "backward question", tree: []*entity.AnswerTreeNode{
+			edge("edge:q0:o0", "question:1"), edge("edge:q0:o1", "segment:segment-1"),
+			edge("edge:q1:o0", "question:0"), edge("edge:q1:o1", "segment:segment-1"),
+		}},
+		{name: "unknown segment", tree: []*entity.AnswerTreeNode{
+			edge("edge:q0:o0", "segment:segment-3"), edge("edge:q0:o1", "segment:segment-1"),
+			edge("edge:q1:o0", "segment:segment-2"), edge("edge:q1:o1", "segment:segment-1"),
+		}},
+		{name: "unknown option", tree: []*entity.AnswerTreeNode{
+			edge("edge:q0:o0", "question:1"), edge("edge:q0:o1", "segment:segment-1"), edge("edge:q0:o2", "segment:segment-1"),
+			edge("edge:q1:o0", "segment:segment-2"), edge("edge:q1:o1", "segment:segment-1"),
+		}},
+		{name: "start out of range", tree: []*entity.AnswerTreeNode{edge("meta:start", "2")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDraftAnswerTree(tt.tree, questions, 2)
+			if tt.ok != (err == nil) {
+				t.Fatalf("err = %v, want ok %v", err, tt.ok)
+			}
+			if err != nil && !errors.Is(err, ErrInconsistentDraft) {
+				t.Errorf("err = %v, want ErrInconsistentDraft", err)
+			}
+		})
+	}
+}
+
+type fakeDraftStore struct {
+	promotion *entity.Promotion
+	draft     *entity.PromotionDraft
+}
+
+func (f *fakeDraftStore) CreatePromotionDraft(_ context.Context, p *entity.Promotion, draft *entity.PromotionDraft) (int64, []int64, error) {
+	f.promotion, f.draft = p, draft
+	ids := make([]int64, len(draft.Segments))
+	for i := range ids {
+		ids[i] = int64(100 + i)
+	}
+	return 42, ids, nil
+}
+
+func TestSavePromotionDraft(t *testing.T) {
+	store := &fakeDraftStore{}
+	s := New(Config{}, nil, nil, nil, nil, nil, nil, nil, store)
+	settings := &entity.Promotion{DateFrom: "2026-11-01T00:00:00Z", SlotCount: 4}
+
+	saved, err := s.savePromotionDraft(context.Background(), JobRequest{Theme: "зодиак", Limit: 3, SlotCount: 4, Promotion: settings})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.PromotionID != 42 || len(saved.SegmentIDs) != 3 || len(saved.Draft.Segments) != 3 {
+		t.Fatalf("saved = %+v", saved)
+	}
+	if store.promotion.Name != saved.Draft.Name || store.promotion.Theme != "зодиак" || store.promotion.DateFrom != settings.DateFrom {
+		t.Errorf("stored promotion = %+v", store.promotion)
+	}
+	if settings.Name != "" {
+		t.Error("job request settings must not be modified")
+	}
+
+	if _, err := s.savePromotionDraft(context.Background(), JobRequest{Theme: "зодиак"}); !errors.Is(err, ErrDraftSettings) {
+		t.Errorf("without settings err = %v, want ErrDraftSettings", err)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"wildberries/internal/tracing"
 )
 
 type geminiClient struct {
@@ -36,12 +38,27 @@ func NewGeminiClient(cfg Config) JSONGenerator {
 	}
 }
 
-func (c *geminiClient) GenerateJSON(ctx context.Context, prompt string) (string, error) {
+func (c *geminiClient) Validate() error {
 	if c.apiKey == "" {
-		return "", errors.New("gemini api key is not configured")
+		return errors.New("gemini api key is not configured")
 	}
 	if c.model == "" {
-		return "", errors.New("gemini model is not configured")
+		return errors.New("gemini model is not configured")
+	}
+	return nil
+}
+
+func (c *geminiClient) GenerateJSON(ctx context.Context, prompt string) (string, error) {
+	text, _, err := c.generateWithUsage(ctx, prompt)
+	return text, err
+}
+
+func (c *geminiClient) generateWithUsage(ctx context.Context, prompt string) (_ string, _ Usage, err error) {
+	ctx, span := startProviderSpan(ctx, providerGemini, c.model)
+	defer func() { tracing.End(span, err) }()
+
+	if err := c.Validate(); err != nil {
+		return "", Usage{}, err
 	}
 
 	requestPayload := map[string]any{
@@ -60,29 +77,29 @@ func (c *geminiClient) GenerateJSON(ctx context.Context, prompt string) (string,
 	}
 	body, err := json.Marshal(requestPayload)
 	if err != nil {
-		return "", fmt.Errorf("marshal gemini request: %w", err)
+		return "", Usage{}, fmt.Errorf("marshal gemini request: %w", err)
 	}
 
 	endpoint := fmt.Sprintf("%s/v1beta/models/%s:generateContent", c.baseURL, url.PathEscape(c.model))
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
 	if err != nil {
-		return "", fmt.Errorf("build gemini request: %w", err)
+		return "", Usage{}, fmt.Errorf("build gemini request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("x-goog-api-key", c.apiKey)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("request gemini: %w", err)
+		return "", Usage{}, fmt.Errorf("request gemini: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
 	if err != nil {
-		return "", fmt.Errorf("read gemini response: %w", err)
+		return "", Usage{}, fmt.Errorf("read gemini response: %w", err)
 	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return "", fmt.Errorf("gemini request failed: status=%d body=%s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+		return "", Usage{}, &StatusError{Provider: providerGemini, StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(respBody))}
 	}
 
 	type responsePart struct {
@@ -94,21 +111,32 @@ func (c *geminiClient) GenerateJSON(ctx context.Context, prompt string) (string,
 	type candidate struct {
 		Content responseContent `json:"content"`
 	}
+	type usageMetadata struct {
+		PromptTokenCount     int64 `json:"promptTokenCount"`
+		CandidatesTokenCount int64 `json:"candidatesTokenCount"`
+		TotalTokenCount      int64 `json:"totalTokenCount"`
+	}
 	type generateResponse struct {
-		Candidates []candidate `json:"candidates"`
+		Candidates    []candidate   `json:"candidates"`
+		UsageMetadata usageMetadata `json:"usageMetadata"`
 	}
 
 	var parsed generateResponse
 	if err := json.Unmarshal(respBody, &parsed); err != nil {
-		return "", fmt.Errorf("decode gemini envelope: %w", err)
+		return "", Usage{}, fmt.Errorf("decode gemini envelope: %w", err)
 	}
 	if len(parsed.Candidates) == 0 || len(parsed.Candidates[0].Content.Parts) == 0 {
-		return "", errors.New("gemini response has no candidates")
+		return "", Usage{}, errors.New("gemini response has no candidates")
 	}
 
+	usage := Usage{
+		PromptTokens:     parsed.UsageMetadata.PromptTokenCount,
+		CompletionTokens: parsed.UsageMetadata.CandidatesTokenCount,
+		TotalTokens:      parsed.UsageMetadata.TotalTokenCount,
+	}
 	text := strings.TrimSpace(parsed.Candidates[0].Content.Parts[0].Text)
 	if text == "" {
-		return "", errors.New("gemini response text is empty")
+		return "", usage, errors.New("gemini response text is empty")
 	}
-	return text, nil
+	return text, usage, nil
 }
@@ -0,0 +1,276 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+// Виды асинхронных генераций
+const (
+	JobKindThemes     = operationThemes
+	JobKindSegments   = operationSegments
+	JobKindQuestions  = operationQuestions
+	JobKindAnswerTree = operationAnswerTree
+	JobKindText       = operationText
+	// генерация черновика акции целиком и его сохранение
+	JobKindPromotionDraft = "promotion_draft"
+)
+
+// Статусы асинхронной генерации
+const (
+	JobStatusQueued  = "queued"
+	JobStatusRunning = "running"
+	JobStatusDone    = "done"
+	JobStatusFailed  = "failed"
+)
+
+const (
+	defaultJobTimeout = 5 * time.Minute
+	// задача, которую воркеры брали столько раз, роняет процесс или не успевает — больше не пробуем
+	maxJobAttempts  = 3
+	jobPollInterval = 2 * time.Second
+	jobRetention    = 7 * 24 * time.Hour
+)
+
+var (
+	ErrJobsDisabled   = errors.New("async generation jobs are not configured")
+	ErrUnknownJobKind = errors.New("unknown generation job kind")
+	ErrDraftSettings  = errors.New("promotion draft job requires promotion settings")
+)
+
+// JobRequest — параметры генерации; используются поля, нужные Kind
+type JobRequest struct {
+	Theme       string            `json:"theme,omitempty"`
+	Limit       int               `json:"limit,omitempty"` // число сегментов или тем
+	SlotCount   int               `json:"slot_count,omitempty"`
+	Force       bool              `json:"force,omitempty"`
+	Params      map[string]string `json:"params,omitempty"`
+	SegmentID   int64             `json:"segment_id,omitempty"`
+	PromotionID int64             `json:"promotion_id,omitempty"` // тексты всех сегментов акции; закреплённые за ней промпты
+	Save        bool              `json:"save,omitempty"`         // сохранить тексты сегментов новой версией
+	QuizShape                     // вопросы и дерево ответов
+	// настройки акции-черновика; название, описание и тему заполняет генерация
+	Promotion *entity.Promotion `json:"promotion,omitempty"`
+}
+
+type jobResult struct {
+	Themes       []*entity.ThemeItem          `json:"themes,omitempty"`
+	Segments     []*entity.SegmentSuggestion  `json:"segments,omitempty"`
+	Questions    []*entity.QuestionSuggestion `json:"questions,omitempty"`
+	AnswerTree   []*entity.AnswerTreeNode     `json:"answer_tree,omitempty"`
+	Text         string                       `json:"text,omitempty"`
+	SegmentTexts []*entity.SegmentText        `json:"segment_texts,omitempty"`
+	Draft        *entity.SavedPromotionDraft  `json:"draft,omitempty"`
+}
+
+// SubmitJob queues a generation and returns immediately; workers started by RunJobWorkers
+// in any instance pick it up. The job survives restarts.
+func (s *Service) SubmitJob(ctx context.Context, kind string, req JobRequest) (*entity.AIGenerationJob, error) {
+	if s.jobRepo == nil {
+		return nil, ErrJobsDisabled
+	}
+	// форму проверяем сразу, чтобы ошибка пришла в ответ, а не в задачу
+	switch kind {
+	case JobKindThemes:
+		if _, err := normalizeThemeCount(req.Limit); err != nil {
+			return nil, err
+		}
+	case JobKindQuestions, JobKindAnswerTree:
+		if _, err := req.QuizShape.normalize(); err != nil {
+			return nil, err
+		}
+	case JobKindSegments, JobKindText:
+	case JobKindPromotionDraft:
+		if req.Promotion == nil {
+			return nil, ErrDraftSettings
+		}
+		if strings.TrimSpace(req.Theme) == "" {
+			return nil, ErrThemeRequired
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownJobKind, kind)
+	}
+	request, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	row := &repository.AIGenerationJobRow{ID: uuid.NewString(), Kind: kind, Request: request}
+	if err := s.jobRepo.Create(ctx, row); err != nil {
+		return nil, err
+	}
+	select {
+	case s.jobWake <- struct{}{}:
+	default:
+	}
+	return &entity.AIGenerationJob{ID: row.ID, Kind: kind, Status: JobStatusQueued, CreatedAt: time.Now().UTC()}, nil
+}
+
+// GetJob returns the job state and, once done, its result. Unknown ids give repository.ErrNotFound.
+func (s *Service) GetJob(ctx context.Context, id string) (*entity.AIGenerationJob, error) {
+	if s.jobRepo == nil {
+		return nil, ErrJobsDisabled
+	}
+	row, err := s.jobRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	job := &entity.AIGenerationJob{
+		ID:         row.ID,
+		Kind:       row.Kind,
+		Status:     row.Status,
+		Error:      row.Error,
+		Attempts:   row.Attempts,
+		CreatedAt:  row.CreatedAt,
+		StartedAt:  row.StartedAt,
+		FinishedAt: row.FinishedAt,
+	}
+	if row.Status == JobStatusDone && len(row.Result) > 0 {
+		var result jobResult
+		if err := json.Unmarshal(row.Result, &result); err != nil {
+			return nil, fmt.Errorf("decode job %s result: %w", row.ID, err)
+		}
+		job.Themes = result.Themes
+		job.Segments = result.Segments
+		job.Questions = result.Questions
+		job.AnswerTree = result.AnswerTree
+		job.Text = result.Text
+		job.SegmentTexts = result.SegmentTexts
+		job.PromotionDraft = result.Draft
+	}
+	return job, nil
+}
+
+// RunJobWorkers executes queued jobs with the given number of workers until ctx is done.
+// A job interrupted by shutdown goes back to the queue.
+func (s *Service) RunJobWorkers(ctx context.Context, workers int) {
+	if s.jobRepo == nil || workers <= 0 {
+		return
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.jobWorker(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+func (s *Service) jobWorker(ctx context.Context) {
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+	for {
+		// разбираем очередь, пока есть задачи
+		for ctx.Err() == nil {
+			row, err := s.jobRepo.Claim(ctx, s.jobTimeout+time.Minute)
+			if errors.Is(err, repository.ErrNotFound) {
+				break
+			}
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.ErrorContext(ctx, "claim ai generation job", slog.String("error", err.Error()))
+				}
+				break
+			}
+			s.runJob(ctx, row)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.jobWake:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) runJob(ctx context.Context, row *repository.AIGenerationJobRow) {
+	// статус пишем и после отмены ctx, иначе задача повиснет до истечения аренды
+	storeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	logger := slog.With(slog.String("job_id", row.ID), slog.String("kind", row.Kind))
+
+	if row.Attempts > maxJobAttempts {
+		if err := s.jobRepo.Fail(storeCtx, row.ID, fmt.Sprintf("abandoned after %d attempts", maxJobAttempts)); err != nil {
+			logger.ErrorContext(ctx, "fail ai generation job", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	jobCtx, cancelJob := context.WithTimeout(ctx, s.jobTimeout)
+	defer cancelJob()
+	result, err := s.executeJob(jobCtx, row)
+	if err != nil && ctx.Err() != nil {
+		if err := s.jobRepo.Requeue(storeCtx, row.ID); err != nil {
+			logger.ErrorContext(ctx, "requeue ai generation job", slog.String("error", err.Error()))
+		}
+		return
+	}
+	if err != nil {
+		logger.WarnContext(ctx, "ai generation job failed", slog.String("error", err.Error()))
+		err = s.jobRepo.Fail(storeCtx, row.ID, s.redact(err.Error()))
+	} else {
+		err = s.jobRepo.Complete(storeCtx, row.ID, result)
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "store ai generation job", slog.String("error", err.Error()))
+	}
+}
+
+func (s *Service) executeJob(ctx context.Context, row *repository.AIGenerationJobRow) ([]byte, error) {
+	var req JobRequest
+	if err := json.Unmarshal(row.Request, &req); err != nil {
+		return nil, fmt.Errorf("decode job request: %w", err)
+	}
+	ctx = WithPromotion(ctx, req.PromotionID)
+	var (
+		result jobResult
+		err    error
+	)
+	switch row.Kind {
+	case JobKindThemes:
+		result.Themes, err = s.GenerateThemes(ctx, req.Limit)
+	case JobKindSegments:
+		result.Segments, err = s.GenerateSegments(ctx, req.Theme, req.Limit, req.SlotCount, req.Force)
+	case JobKindQuestions:
+		result.Questions, err = s.GenerateQuestions(ctx, req.Theme, req.QuizShape, req.Force)
+	case JobKindAnswerTree:
+		result.AnswerTree, err = s.GenerateAnswerTree(ctx, req.Theme, req.QuizShape)
+	case JobKindText:
+		if req.PromotionID == 0 && req.SegmentID == 0 && !req.Save {
+			result.Text, err = s.GetText(ctx, req.Params, req.SegmentID)
+			break
+		}
+		result.SegmentTexts, err = s.GenerateSegmentTexts(ctx, req.Params, req.PromotionID, req.SegmentID, req.Save)
+		if err == nil && len(result.SegmentTexts) == 1 {
+			result.Text = result.SegmentTexts[0].Text
+		}
+	case JobKindPromotionDraft:
+		result.Draft, err = s.savePromotionDraft(ctx, req)
+	default:
+		err = fmt.Errorf("%w: %q", ErrUnknownJobKind, row.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(result)
+}
+
+// PurgeFinishedJobs deletes jobs finished more than a week ago.
+func (s *Service) PurgeFinishedJobs(ctx context.Context) (int64, error) {
+	if s.jobRepo == nil {
+		return 0, nil
+	}
+	return s.jobRepo.DeleteFinished(ctx, time.Now().Add(-jobRetention))
+}
@@ -0,0 +1,165 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+// fakeJobRepo records how runJob finished a job.
+type fakeJobRepo struct {
+	repository.AIGenerationJobRepository
+	created []*repository.AIGenerationJobRow
+	status  string
+	errText string
+	result  []byte
+}
+
+func (f *fakeJobRepo) Create(_ context.Context, row *repository.AIGenerationJobRow) error {
+	f.created = append(f.created, row)
+	return nil
+}
+
+func (f *fakeJobRepo) Complete(_ context.Context, _ string, result []byte) error {
+	f.status, f.result = JobStatusDone, result
+	return nil
+}
+
+func (f *fakeJobRepo) Fail(_ context.Context, _, errText string) error {
+	f.status, f.errText = JobStatusFailed, errText
+	return nil
+}
+
+func (f *fakeJobRepo) Requeue(context.Context, string) error {
+	f.status = JobStatusQueued
+	return nil
+}
+
+// shutdownDraftStore stops the worker while the draft is being saved.
+type shutdownDraftStore struct {
+	stop  context.CancelFunc
+	calls int
+}
+
+func (f *shutdownDraftStore) CreatePromotionDraft(ctx context.Context, _ *entity.Promotion, _ *entity.PromotionDraft) (int64, []int64, error) {
+	f.calls++
+	f.stop()
+	<-ctx.Done()
+	return 0, nil, ctx.Err()
+}
+
+func TestRunJob(t *testing.T) {
+	draftRequest := `{"theme":"зодиак","limit":3,"slot_count":4,"promotion":{"slot_count":4}}`
+	tests := []struct {
+		name      string
+		kind      string
+		request   string
+		attempts  int
+		want      string // final job status
+		wantErr   string
+		wantSaves int // draft store calls
+	}{
+		{name: "done", kind: JobKindThemes, request: `{"limit":3}`, attempts: 1, want: JobStatusDone},
+		{name: "bad request fails", kind: JobKindThemes, request: `{`, attempts: 1, want: JobStatusFailed, wantErr: "decode job request"},
+		{name: "unknown kind fails", kind: "poem", request: `{}`, attempts: 1, want: JobStatusFailed, wantErr: "unknown generation job kind"},
+		{name: "shutdown requeues", kind: JobKindPromotionDraft, request: draftRequest, attempts: 1, want: JobStatusQueued, wantSaves: 1},
+		{name: "last attempt runs", kind: JobKindPromotionDraft, request: draftRequest, attempts: maxJobAttempts, want: JobStatusQueued, wantSaves: 1},
+		{
+			name: "abandoned after max attempts", kind: JobKindPromotionDraft, request: draftRequest, attempts: maxJobAttempts + 1,
+			want: JobStatusFailed, wantErr: fmt.Sprintf("abandoned after %d attempts", maxJobAttempts),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			repo := &fakeJobRepo{}
+			store := &shutdownDraftStore{stop: cancel}
+			s := New(Config{}, nil, nil, repo, nil, nil, nil, nil, store)
+
+			s.runJob(ctx, &repository.AIGenerationJobRow{ID: "job-1", Kind: tt.kind, Request: []byte(tt.request), Attempts: tt.attempts})
+			if repo.status != tt.want {
+				t.Fatalf("status = %q, want %q", repo.status, tt.want)
+			}
+			if !strings.Contains(repo.errText, tt.wantErr) || tt.wantErr == "" && repo.errText != "" {
+				t.Errorf("error = %q, want %q", repo.errText, tt.wantErr)
+			}
+			if store.calls != tt.wantSaves {
+				t.Errorf("draft store calls = %d, want %d", store.calls, tt.wantSaves)
+			}
+			if tt.want == JobStatusDone {
+				var result jobResult
+				if err := json.Unmarshal(repo.result, &result); err != nil {
+					t.Fatal(err)
+				}
+				if len(result.Themes) != 3 {
+					t.Errorf("themes = %d, want 3", len(result.Themes))
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitJob(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    string
+		req     JobRequest
+		wantErr error
+	}{
+		{name: "themes", kind: JobKindThemes, req: JobRequest{Limit: 5}},
+		{name: "negative theme count", kind: JobKindThemes, req: JobRequest{Limit: -1}, wantErr: ErrInvalidThemeCount},
+		{name: "questions", kind: JobKindQuestions, req: JobRequest{Theme: "зодиак", QuizShape: QuizShape{MinQuestions: 2, MaxQuestions: 3}}},
+		{name: "questions bad shape", kind: JobKindQuestions, req: JobRequest{QuizShape: QuizShape{MinQuestions: 4, MaxQuestions: 2}}, wantErr: ErrInvalidQuizShape},
+		{name: "answer tree bad shape", kind: JobKindAnswerTree, req: JobRequest{QuizShape: QuizShape{Options: -1}}, wantErr: ErrInvalidQuizShape},
+		{name: "segments", kind: JobKindSegments, req: JobRequest{Theme: "зодиак"}},
+		{name: "text", kind: JobKindText, req: JobRequest{PromotionID: 7, Save: true}},
+		{name: "draft", kind: JobKindPromotionDraft, req: JobRequest{Theme: "зодиак", Promotion: &entity.Promotion{}}},
+		{name: "draft without settings", kind: JobKindPromotionDraft, req: JobRequest{Theme: "зодиак"}, wantErr: ErrDraftSettings},
+		{name: "draft without theme", kind: JobKindPromotionDraft, req: JobRequest{Theme: " ", Promotion: &entity.Promotion{}}, wantErr: ErrThemeRequired},
+		{name: "unknown kind", kind: "poem", wantErr: ErrUnknownJobKind},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeJobRepo{}
+			s := New(Config{}, nil, nil, repo, nil, nil, nil, nil, nil)
+
+			job, err := s.SubmitJob(context.Background(), tt.kind, tt.req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				if len(repo.created) != 0 {
+					t.Error("rejected job must not be queued")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(repo.created) != 1 || repo.created[0].ID != job.ID || repo.created[0].Kind != tt.kind {
+				t.Fatalf("created = %+v, job = %+v", repo.created, job)
+			}
+			if job.Status != JobStatusQueued {
+				t.Errorf("status = %q, want %q", job.Status, JobStatusQueued)
+			}
+			var stored JobRequest
+			if err := json.Unmarshal(repo.created[0].Request, &stored); err != nil {
+				t.Fatal(err)
+			}
+			if stored.Theme != tt.req.Theme || stored.QuizShape != tt.req.QuizShape {
+				t.Errorf("stored request = %+v, want %+v", stored, tt.req)
+			}
+		})
+	}
+
+	if _, err := New(Config{}, nil, nil, nil, nil, nil, nil, nil, nil).SubmitJob(context.Background(), JobKindThemes, JobRequest{}); !errors.Is(err, ErrJobsDisabled) {
+		t.Errorf("without job repository err = %v, want ErrJobsDisabled", err)
+	}
+}
@@ -0,0 +1,173 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"wildberries/internal/tracing"
+)
+
+// openAIClient speaks the OpenAI chat-completions API: Groq, OpenAI itself
+// and self-hosted servers such as Ollama or vLLM.
+type openAIClient struct {
+	name        string
+	baseURL     string
+	apiKey      string
+	keyOptional bool
+	model       string
+	headers     map[string]string
+	jsonMode    bool // send response_format=json_object
+	httpClient  *http.Client
+}
+
+// NewOpenAIClient creates a client for any OpenAI-compatible server.
+// The API key is optional since local servers usually run without auth.
+func NewOpenAIClient(cfg Config) JSONGenerator {
+	baseURL := strings.TrimSpace(cfg.OpenAIAPIBaseURL)
+	if baseURL == "" {
+		baseURL = "http://localhost:11434/v1"
+	}
+	timeout := cfg.OpenAITimeout
+	if timeout <= 0 {
+		timeout = 120 * time.Second
+	}
+	return &openAIClient{
+		name:        providerOpenAI,
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		apiKey:      strings.TrimSpace(cfg.OpenAIAPIKey),
+		keyOptional: true,
+		model:       strings.TrimSpace(cfg.OpenAIModel),
+		headers:     cfg.OpenAIHeaders,
+		jsonMode:    !cfg.OpenAIDisableJSONMode,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (c *openAIClient) Validate() error {
+	if c.apiKey == "" && !c.keyOptional {
+		return fmt.Errorf("%s api key is not configured", c.name)
+	}
+	if c.model == "" {
+		return fmt.Errorf("%s model is not configured", c.name)
+	}
+	return nil
+}
+
+func (c *openAIClient) GenerateJSON(ctx context.Context, prompt string) (string, error) {
+	text, _, err := c.generateWithUsage(ctx, prompt)
+	return text, err
+}
+
+func (c *openAIClient) generateWithUsage(ctx context.Context, prompt string) (_ string, _ Usage, err error) {
+	ctx, span := startProviderSpan(ctx, c.name, c.model)
+	defer func() { tracing.End(span, err) }()
+
+	if err := c.Validate(); err != nil {
+		return "", Usage{}, err
+	}
+
+	requestPayload := map[string]any{
+		"model": c.model,
+		"messages": []map[string]string{
+			{
+				"role":    "user",
+				"content": prompt,
+			},
+		},
+		"temperature": 0.2,
+	}
+	if c.jsonMode {
+		requestPayload["response_format"] = map[string]string{
+			"type": "json_object",
+		}
+	}
+	body, err := json.Marshal(requestPayload)
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("marshal %s request: %w", c.name, err)
+	}
+
+	endpoint := fmt.Sprintf("%s/chat/completions", c.baseURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("build %s request: %w", c.name, err)
+	}
+	for key, value := range c.headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("request %s: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", Usage{}, fmt.Errorf("read %s response: %w", c.name, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", Usage{}, &StatusError{Provider: c.name, StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(respBody))}
+	}
+
+	type responseMessage struct {
+		Content string `json:"content"`
+	}
+	type choice struct {
+		Message responseMessage `json:"message"`
+	}
+	type completionUsage struct {
+		PromptTokens     int64 `json:"prompt_tokens"`
+		CompletionTokens int64 `json:"completion_tokens"`
+		TotalTokens      int64 `json:"total_tokens"`
+	}
+	type completionResponse struct {
+		Choices []choice        `json:"choices"`
+		Usage   completionUsage `json:"usage"`
+	}
+
+	var parsed completionResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", Usage{}, fmt.Errorf("decode %s envelope: %w", c.name, err)
+	}
+	if len(parsed.Choices) == 0 {
+		return "", Usage{}, fmt.Errorf("%s response has no choices", c.name)
+	}
+
+	usage := Usage{
+		PromptTokens:     parsed.Usage.PromptTokens,
+		CompletionTokens: parsed.Usage.CompletionTokens,
+		TotalTokens:      parsed.Usage.TotalTokens,
+	}
+	text := stripCodeFence(parsed.Choices[0].Message.Content)
+	if text == "" {
+		return "", usage, fmt.Errorf("%s response text is empty", c.name)
+	}
+	return text, usage, nil
+}
+
+// stripCodeFence unwraps ```json ... ``` that local models often add despite the prompt,
+// also on a single line. The language tag is cut up to the first non-letter, so JSON
+// starting right after the fence ("```{...}```") is kept whole.
+func stripCodeFence(text string) string {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "```") {
+		return text
+	}
+	text = strings.TrimPrefix(text, "```")
+	text = strings.TrimLeftFunc(text, func(r rune) bool {
+		return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+	})
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(text), "```"))
+}
@@ -0,0 +1,142 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestOpenAIClientGenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		want      string
+		wantUsage Usage
+		wantErr   string
+	}{
+		{
+			name:      "success",
+			status:    http.StatusOK,
+			body:      `{"choices":[{"message":{"content":"{\"a\":1}"}}],"usage":{"prompt_tokens":7,"completion_tokens":3,"total_tokens":10}}`,
+			want:      `{"a":1}`,
+			wantUsage: Usage{PromptTokens: 7, CompletionTokens: 3, TotalTokens: 10},
+		},
+		{
+			name:   "code fenced content",
+			status: http.StatusOK,
+			body:   `{"choices":[{"message":{"content":"` + "```json\\n{\\\"a\\\":1}\\n```" + `"}}]}`,
+			want:   `{"a":1}`,
+		},
+		{
+			name:    "non-2xx",
+			status:  http.StatusBadGateway,
+			body:    `upstream down`,
+			wantErr: "status=502 body=upstream down",
+		},
+		{
+			name:    "malformed envelope",
+			status:  http.StatusOK,
+			body:    `{"choices":[`,
+			wantErr: "decode openai envelope",
+		},
+		{
+			name:    "no choices",
+			status:  http.StatusOK,
+			body:    `{"choices":[]}`,
+			wantErr: "no choices",
+		},
+		{
+			name:    "empty content",
+			status:  http.StatusOK,
+			body:    `{"choices":[{"message":{"content":"  "}}]}`,
+			wantErr: "text is empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var request map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/chat/completions" {
+					t.Errorf("path = %s", r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+					t.Errorf("Authorization = %q", got)
+				}
+				if got := r.Header.Get("X-Team"); got != "promo" {
+					t.Errorf("X-Team = %q", got)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Errorf("decode request: %v", err)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewOpenAIClient(Config{
+				OpenAIAPIBaseURL: server.URL + "/v1/",
+				OpenAIAPIKey:     "secret",
+				OpenAIModel:      "test-model",
+				OpenAIHeaders:    map[string]string{"X-Team": "promo"},
+			}).(*openAIClient)
+			got, usage, err := client.generateWithUsage(context.Background(), "prompt")
+			if request["model"] != "test-model" || request["response_format"] == nil {
+				t.Errorf("request = %v", request)
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want || usage != tt.wantUsage {
+				t.Errorf("got %q, %+v; want %q, %+v", got, usage, tt.want, tt.wantUsage)
+			}
+		})
+	}
+}
+
+func TestOpenAIClientStatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "slow down", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	_, err := NewOpenAIClient(Config{OpenAIAPIBaseURL: server.URL, OpenAIModel: "m"}).GenerateJSON(context.Background(), "prompt")
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("err = %v, want StatusError 429", err)
+	}
+	if !isTransient(err) {
+		t.Error("429 must be retried")
+	}
+}
+
+func TestStripCodeFence(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: `{"a":1}`, want: `{"a":1}`},
+		{in: "  {\"a\":1}\n", want: `{"a":1}`},
+		{in: "```json\n{\"a\":1}\n```", want: `{"a":1}`},
+		{in: "```\n{\"a\":1}\n```", want: `{"a":1}`},
+		{in: "```{\n\"a\":1\n}\n```", want: "{\n\"a\":1\n}"},
+		{in: "```{\"a\":1}```", want: `{"a":1}`},
+		{in: "```json {\"a\":1}```", want: `{"a":1}`},
+		{in: "```JSON\n[1,2]\n```", want: `[1,2]`},
+		{in: "```json\n```", want: ""},
+	}
+	for _, tt := range tests {
+		if got := stripCodeFence(tt.in); got != tt.want {
+			t.Errorf("stripCodeFence(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,376 @@
+package ai
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/jackc/pgx/v5"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+//go:embed prompts/*.tmpl
+var builtinPromptFiles embed.FS
+
+var (
+	ErrUnknownPrompt         = errors.New("unknown prompt")
+	ErrInvalidPromptTemplate = errors.New("invalid prompt template")
+	ErrPromptsDisabled       = errors.New("prompt storage is not configured")
+)
+
+// Промпты, которые можно редактировать, и их переменные с примерами значений.
+// Примеры подставляются при проверке новой версии. В шаблонах доступна функция
+// plural: {{plural .options "вариант" "варианта" "вариантов"}} — "3 варианта".
+var promptVariables = map[string]map[string]any{
+	operationThemes:     {"count": 3},
+	operationSegments:   {"context": "- Выбранная тема: пример", "limit": 12},
+	operationQuestions:  {"context": "- Выбранная тема: пример", "min_questions": 2, "max_questions": 4, "options": 3},
+	operationAnswerTree: {"context": "- Выбранная тема: пример", "questions": 4, "options": 3, "edges": 12},
+	operationText:       {"context": "- Выбранная тема: пример", "target": "promotion_description"},
+}
+
+// Другие формы опроса и числа: версия проверяется и с ними, а текст должен меняться
+// от каждого значения варианта — иначе форма зашита в шаблон («4 вопроса»)
+// и не совпадёт с запрошенной.
+var promptVariants = map[string][]map[string]any{
+	operationThemes:   {{"count": 1}, {"count": 10}},
+	operationSegments: {{"limit": 1}, {"limit": 30}},
+	operationQuestions: {
+		{"min_questions": 1, "max_questions": 1},
+		{"options": 6},
+		{"min_questions": 10, "max_questions": 10, "options": 2},
+	},
+	operationAnswerTree: {
+		{"questions": 1, "edges": 3},
+		{"options": 2, "edges": 8},
+		{"questions": 10, "options": 6, "edges": 60},
+	},
+}
+
+var promptFuncs = template.FuncMap{"plural": pluralRu}
+
+var promptNames = []string{operationThemes, operationSegments, operationQuestions, operationAnswerTree, operationText}
+
+// builtinPrompts — версия 0 каждого промпта, из prompts/<name>.tmpl
+var builtinPrompts = mustParseBuiltinPrompts()
+
+func mustParseBuiltinPrompts() map[string]*template.Template {
+	out := make(map[string]*template.Template, len(promptNames))
+	for _, name := range promptNames {
+		body, err := builtinPromptFiles.ReadFile(path.Join("prompts", name+".tmpl"))
+		if err != nil {
+			panic(err)
+		}
+		tmpl, err := parsePrompt(name, string(body))
+		if err != nil {
+			panic(err)
+		}
+		out[name] = tmpl
+	}
+	return out
+}
+
+// parsePrompt parses a prompt body and renders it with example values and their variants,
+// so a template with an unknown variable, a runtime error or a hardcoded shape is rejected up front.
+func parsePrompt(name, body string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(promptFuncs).Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPromptTemplate, err)
+	}
+	example, err := executePrompt(tmpl, promptVariables[name])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPromptTemplate, err)
+	}
+	if example == "" {
+		return nil, fmt.Errorf("%w: empty prompt", ErrInvalidPromptTemplate)
+	}
+	for _, variant := range promptVariants[name] {
+		vars := maps.Clone(promptVariables[name])
+		maps.Copy(vars, variant)
+		if _, err := executePrompt(tmpl, vars); err != nil {
+			return nil, fmt.Errorf("%w: with %v: %v", ErrInvalidPromptTemplate, variant, err)
+		}
+		for key, value := range variant {
+			vars := maps.Clone(promptVariables[name])
+			vars[key] = value
+			text, err := executePrompt(tmpl, vars)
+			if err != nil {
+				return nil, fmt.Errorf("%w: with %s=%v: %v", ErrInvalidPromptTemplate, key, value, err)
+			}
+			if text == example {
+				return nil, fmt.Errorf("%w: prompt does not change with %s, the value is hardcoded", ErrInvalidPromptTemplate, key)
+			}
+		}
+	}
+	return tmpl, nil
+}
+
+type promotionScopeKey struct{}
+
+// WithPromotion makes generations under ctx use prompt versions pinned for the promotion.
+func WithPromotion(ctx context.Context, promotionID int64) context.Context {
+	if promotionID <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, promotionScopeKey{}, promotionID)
+}
+
+func promotionFromContext(ctx context.Context) int64 {
+	id, _ := ctx.Value(promotionScopeKey{}).(int64)
+	return id
+}
+
+// renderPrompt renders the version of the prompt pinned for the promotion in ctx,
+// otherwise the active one. If the stored version cannot be loaded or fails on these
+// variables (it was only checked with the example ones) the built-in prompt is used.
+func (s *Service) renderPrompt(ctx context.Context, name string, vars map[string]any) (string, error) {
+	tmpl, ok := builtinPrompts[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownPrompt, name)
+	}
+	if s.promptRepo != nil {
+		custom, err := s.storedPrompt(ctx, name)
+		switch {
+		case err != nil:
+			slog.WarnContext(ctx, "load prompt template, using built-in", slog.String("name", name), slog.String("error", err.Error()))
+		case custom != nil:
+			text, err := executePrompt(custom, vars)
+			if err == nil {
+				return text, nil
+			}
+			slog.WarnContext(ctx, "render prompt template, using built-in", slog.String("name", name), slog.String("error", err.Error()))
+		}
+	}
+	text, err := executePrompt(tmpl, vars)
+	if err != nil {
+		return "", fmt.Errorf("render prompt %s: %w", name, err)
+	}
+	return text, nil
+}
+
+func executePrompt(tmpl *template.Template, vars map[string]any) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, vars); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// storedPrompt returns nil when the built-in version is in use. Versions never change,
+// so parsed templates are kept for the life of the process.
+func (s *Service) storedPrompt(ctx context.Context, name string) (*template.Template, error) {
+	version, err := s.promptRepo.Resolve(ctx, name, promotionFromContext(ctx))
+	if err != nil || version == 0 {
+		return nil, err
+	}
+	key := fmt.Sprintf("%s@%d", name, version)
+	if cached, ok := s.promptCache.Load(key); ok {
+		return cached.(*template.Template), nil
+	}
+	row, err := s.promptRepo.Get(ctx, name, version)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := parsePrompt(name, row.Body)
+	if err != nil {
+		return nil, err
+	}
+	s.promptCache.Store(key, tmpl)
+	return tmpl, nil
+}
+
+// PromptTemplates lists editable prompts with their active and latest versions.
+func (s *Service) PromptTemplates(ctx context.Context) ([]*entity.PromptTemplate, error) {
+	summaries := make(map[string]*repository.PromptTemplateSummaryRow)
+	if s.promptRepo != nil {
+		rows, err := s.promptRepo.Summaries(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			summaries[row.Name] = row
+		}
+	}
+	out := make([]*entity.PromptTemplate, 0, len(promptNames))
+	for _, name := range promptNames {
+		item := &entity.PromptTemplate{Name: name, Variables: promptVariableNames(name)}
+		if row, ok := summaries[name]; ok {
+			item.ActiveVersion, item.LatestVersion = row.ActiveVersion, row.LatestVersion
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// PromptTemplateVersions returns stored versions of a prompt, newest first, followed by the built-in version 0.
+func (s *Service) PromptTemplateVersions(ctx context.Context, name string) ([]*entity.PromptTemplateVersion, error) {
+	if _, ok := builtinPrompts[name]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPrompt, name)
+	}
+	var (
+		rows   []*repository.PromptTemplateRow
+		active int
+	)
+	if s.promptRepo != nil {
+		var err error
+		if rows, err = s.promptRepo.Versions(ctx, name); err != nil {
+			return nil, err
+		}
+		if active, err = s.promptRepo.Resolve(ctx, name, 0); err != nil {
+			return nil, err
+		}
+	}
+	out := make([]*entity.PromptTemplateVersion, 0, len(rows)+1)
+	for _, row := range rows {
+		out = append(out, &entity.PromptTemplateVersion{
+			Name:      row.Name,
+			Version:   row.Version,
+			Body:      row.Body,
+			Comment:   row.Comment,
+			Active:    row.Version == active,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	body, err := builtinPromptFiles.ReadFile(path.Join("prompts", name+".tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, &entity.PromptTemplateVersion{
+		Name:    name,
+		Body:    string(body),
+		Comment: "встроенный промпт",
+		Active:  active == 0,
+	})
+	return out, nil
+}
+
+// CreatePromptTemplateVersion validates and stores a new version of a prompt; with activate
+// generations switch to it right away, except promotions pinned to another version.
+func (s *Service) CreatePromptTemplateVersion(ctx context.Context, name, body, comment string, activate bool) (*entity.PromptTemplateVersion, error) {
+	if s.promptRepo == nil {
+		return nil, ErrPromptsDisabled
+	}
+	if _, ok := builtinPrompts[name]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPrompt, name)
+	}
+	if _, err := parsePrompt(name, body); err != nil {
+		return nil, err
+	}
+	row := &repository.PromptTemplateRow{Name: name, Body: body, Comment: strings.TrimSpace(comment)}
+	version, err := s.promptRepo.Create(ctx, row, activate)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.promptRepo.Get(ctx, name, version)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.PromptTemplateVersion{
+		Name:      created.Name,
+		Version:   created.Version,
+		Body:      created.Body,
+		Comment:   created.Comment,
+		Active:    activate,
+		CreatedAt: created.CreatedAt,
+	}, nil
+}
+
+// ActivatePromptTemplate switches generations to a stored version; 0 rolls back to the built-in prompt.
+func (s *Service) ActivatePromptTemplate(ctx context.Context, name string, version int) error {
+	if err := s.checkPromptVersion(ctx, name, version); err != nil {
+		return err
+	}
+	return s.promptRepo.Activate(ctx, name, version)
+}
+
+// PromotionPromptPins returns prompt versions pinned for a promotion.
+func (s *Service) PromotionPromptPins(ctx context.Context, promotionID int64) ([]*entity.PromptPin, error) {
+	if s.promptRepo == nil {
+		return nil, nil
+	}
+	rows, err := s.promptRepo.Pins(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*entity.PromptPin, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.PromptPin{
+			PromotionID: row.PromotionID,
+			Name:        row.Name,
+			Version:     row.Version,
+			CreatedAt:   row.CreatedAt,
+		})
+	}
+	return out, nil
+}
+
+// PinPromotionPrompt makes generations for the promotion use the given version
+// regardless of which one is active; 0 pins the built-in prompt.
+func (s *Service) PinPromotionPrompt(ctx context.Context, promotionID int64, name string, version int) error {
+	if err := s.checkPromptVersion(ctx, name, version); err != nil {
+		return err
+	}
+	if s.promotionRepo != nil {
+		_, err := s.promotionRepo.GetByID(ctx, promotionID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return s.promptRepo.Pin(ctx, promotionID, name, version)
+}
+
+// UnpinPromotionPrompt returns the promotion to the active version of the prompt.
+func (s *Service) UnpinPromotionPrompt(ctx context.Context, promotionID int64, name string) error {
+	if s.promptRepo == nil {
+		return ErrPromptsDisabled
+	}
+	if _, ok := builtinPrompts[name]; !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownPrompt, name)
+	}
+	return s.promptRepo.Unpin(ctx, promotionID, name)
+}
+
+// checkPromptVersion returns repository.ErrNotFound for a version that was never stored
+// and ErrInvalidPromptTemplate for a stored one that no longer passes the check.
+func (s *Service) checkPromptVersion(ctx context.Context, name string, version int) error {
+	if s.promptRepo == nil {
+		return ErrPromptsDisabled
+	}
+	if _, ok := builtinPrompts[name]; !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownPrompt, name)
+	}
+	if version < 0 {
+		return repository.ErrNotFound
+	}
+	if version == 0 {
+		return nil
+	}
+	row, err := s.promptRepo.Get(ctx, name, version)
+	if err != nil {
+		return err
+	}
+	// версии, сохранённые до ужесточения проверки, проверяем заново
+	_, err = parsePrompt(name, row.Body)
+	return err
+}
+
+func promptVariableNames(name string) []string {
+	names := make([]string, 0, len(promptVariables[name]))
+	for key := range promptVariables[name] {
+		names = append(names, key)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,131 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"wildberries/internal/repository"
+)
+
+func TestParsePrompt(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		ok   bool
+	}{
+		{name: "valid", body: "Предложи {{plural .count \"тему\" \"темы\" \"тем\"}}", ok: true},
+		{name: "syntax error", body: "Предложи {{.count"},
+		{name: "unknown variable", body: "Предложи {{.limit}} тем"},
+		{name: "unknown function", body: "Предложи {{upper .count}} тем"},
+		{name: "runtime error", body: "Предложи {{index .count 1}} тем"},
+		{name: "empty output", body: "{{if gt .count 10}}много{{end}}  "},
+		{name: "fails for another count", body: "{{if eq .count 10}}{{.missing}}{{end}}Предложи {{.count}} тем"},
+		{name: "hardcoded count", body: "Предложи 3 темы"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePrompt(operationThemes, tt.body)
+			if tt.ok != (err == nil) {
+				t.Fatalf("err = %v, want ok %v", err, tt.ok)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidPromptTemplate) {
+				t.Errorf("err = %v, want ErrInvalidPromptTemplate", err)
+			}
+		})
+	}
+}
+
+func TestParsePromptShape(t *testing.T) {
+	tests := []struct {
+		name string
+		op   string
+		body string
+		ok   bool
+	}{
+		{name: "questions use the shape", op: operationQuestions, ok: true,
+			body: "{{.context}} От {{.min_questions}} до {{.max_questions}} вопросов, по {{.options}} варианта"},
+		{name: "questions hardcode the count", op: operationQuestions,
+			body: "{{.context}} Ровно 4 вопроса, по {{plural .options \"вариант\" \"варианта\" \"вариантов\"}}"},
+		{name: "questions hardcode the options", op: operationQuestions,
+			body: "{{.context}} От {{.min_questions}} до {{.max_questions}} вопросов, по 3 варианта"},
+		{name: "answer tree hardcodes questions", op: operationAnswerTree,
+			body: "{{.context}} 4 вопроса по {{.options}} варианта, {{.edges}} рёбер"},
+		{name: "answer tree uses the shape", op: operationAnswerTree, ok: true,
+			body: "{{.context}} {{.questions}} вопросов по {{.options}} варианта, {{.edges}} рёбер"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePrompt(tt.op, tt.body)
+			if tt.ok != (err == nil) {
+				t.Fatalf("err = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestBuiltinPromptsRender(t *testing.T) {
+	for _, name := range promptNames {
+		text, err := (&Service{}).renderPrompt(context.Background(), name, promptVariables[name])
+		if err != nil || text == "" {
+			t.Errorf("%s: %q, %v", name, text, err)
+		}
+	}
+}
+
+// fakePromptRepo serves one stored version of every prompt.
+type fakePromptRepo struct {
+	repository.PromptTemplateRepository
+	body    string
+	version int
+	err     error
+}
+
+func (f *fakePromptRepo) Resolve(context.Context, string, int64) (int, error) {
+	return f.version, f.err
+}
+
+func (f *fakePromptRepo) Get(_ context.Context, name string, version int) (*repository.PromptTemplateRow, error) {
+	return &repository.PromptTemplateRow{Name: name, Version: version, Body: f.body}, nil
+}
+
+func TestRenderPrompt(t *testing.T) {
+	// passes the check with the example and variant counts, fails for 7
+	const fragile = `{{if eq .count 7}}{{.missing}}{{end}}Свой промпт: {{.count}}`
+	tests := []struct {
+		name     string
+		repo     *fakePromptRepo
+		count    int
+		wantText string // prefix of the rendered prompt; "" — the built-in one
+	}{
+		{name: "stored version", repo: &fakePromptRepo{body: fragile, version: 2}, count: 3, wantText: "Свой промпт: 3"},
+		{name: "built-in active", repo: &fakePromptRepo{body: fragile}, count: 3},
+		{name: "stored version fails at render", repo: &fakePromptRepo{body: fragile, version: 3}, count: 7},
+		{name: "repository error", repo: &fakePromptRepo{version: 4, err: errors.New("db down")}, count: 3},
+	}
+	builtin, err := executePrompt(builtinPrompts[operationThemes], map[string]any{"count": 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	builtinPrefix := builtin[:40]
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{promptRepo: tt.repo}
+			text, err := s.renderPrompt(context.Background(), operationThemes, map[string]any{"count": tt.count})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := tt.wantText
+			if want == "" {
+				want = builtinPrefix
+			}
+			if !strings.HasPrefix(text, want) {
+				t.Errorf("prompt = %.60q, want prefix %q", text, want)
+			}
+		})
+	}
+	if _, err := (&Service{}).renderPrompt(context.Background(), "unknown", nil); !errors.Is(err, ErrUnknownPrompt) {
+		t.Errorf("unknown prompt err = %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package ai
+
+import (
+	"errors"
+	"fmt"
+
+	"wildberries/internal/entity"
+)
+
+const (
+	defaultThemeCount    = 1
+	maxThemeCount        = 10
+	defaultQuestionCount = 4
+	maxQuestionCount     = 10
+	defaultOptionCount   = 3
+	minOptionCount       = 2
+	maxOptionCount       = 6
+)
+
+var (
+	ErrInvalidQuizShape  = errors.New("invalid quiz shape")
+	ErrInvalidThemeCount = errors.New("invalid theme count")
+)
+
+// QuizShape — форма опроса: число вопросов от MinQuestions до MaxQuestions и по Options вариантов
+// у каждого. Нулевые поля — значения по умолчанию: 4 вопроса по 3 варианта.
+type QuizShape struct {
+	MinQuestions int `json:"min_questions,omitempty"`
+	MaxQuestions int `json:"max_questions,omitempty"`
+	Options      int `json:"options,omitempty"`
+}
+
+// normalize fills defaults for zero fields and rejects values outside the supported limits
+// with ErrInvalidQuizShape; with only one bound set the quiz has exactly that many questions.
+func (q QuizShape) normalize() (QuizShape, error) {
+	if q.MinQuestions < 0 || q.MaxQuestions < 0 || q.Options < 0 {
+		return q, fmt.Errorf("%w: values must not be negative", ErrInvalidQuizShape)
+	}
+	switch {
+	case q.MinQuestions == 0 && q.MaxQuestions == 0:
+		q.MinQuestions, q.MaxQuestions = defaultQuestionCount, defaultQuestionCount
+	case q.MinQuestions == 0:
+		q.MinQuestions = q.MaxQuestions
+	case q.MaxQuestions == 0:
+		q.MaxQuestions = q.MinQuestions
+	}
+	if q.MaxQuestions > maxQuestionCount {
+		return q, fmt.Errorf("%w: at most %d questions, got %d", ErrInvalidQuizShape, maxQuestionCount, q.MaxQuestions)
+	}
+	if q.MinQuestions > q.MaxQuestions {
+		return q, fmt.Errorf("%w: min_questions %d is greater than max_questions %d", ErrInvalidQuizShape, q.MinQuestions, q.MaxQuestions)
+	}
+	if q.Options == 0 {
+		q.Options = defaultOptionCount
+	}
+	if q.Options < minOptionCount || q.Options > maxOptionCount {
+		return q, fmt.Errorf("%w: options must be %d..%d, got %d", ErrInvalidQuizShape, minOptionCount, maxOptionCount, q.Options)
+	}
+	return q, nil
+}
+
+// normalizeThemeCount returns the default for zero and rejects counts outside 1..10.
+func normalizeThemeCount(count int) (int, error) {
+	if count == 0 {
+		return defaultThemeCount, nil
+	}
+	if count < 0 || count > maxThemeCount {
+		return 0, fmt.Errorf("%w: count must be 1..%d, got %d", ErrInvalidThemeCount, maxThemeCount, count)
+	}
+	return count, nil
+}
+
+// quizShapeOf returns the shape of generated questions: their count and the largest option count.
+func quizShapeOf(questions []*entity.QuestionSuggestion) QuizShape {
+	shape := QuizShape{MinQuestions: len(questions), MaxQuestions: len(questions)}
+	for _, q := range questions {
+		shape.Options = max(shape.Options, len(q.Options))
+	}
+	return shape
+}
+
+// pluralRu returns n with the Russian noun form for it: 1 вопрос, 2 вопроса, 5 вопросов.
+func pluralRu(n int, one, few, many string) string {
+	form := many
+	switch mod10, mod100 := n%10, n%100; {
+	case mod100 >= 11 && mod100 <= 14:
+	case mod10 == 1:
+		form = one
+	case mod10 >= 2 && mod10 <= 4:
+		form = few
+	}
+	return fmt.Sprintf("%d %s", n, form)
+}
@@ -0,0 +1,86 @@
+package ai
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestQuizShapeNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      QuizShape
+		want    QuizShape
+		wantErr bool
+	}{
+		{name: "defaults", want: QuizShape{MinQuestions: 4, MaxQuestions: 4, Options: 3}},
+		{name: "range", in: QuizShape{MinQuestions: 2, MaxQuestions: 5, Options: 4}, want: QuizShape{MinQuestions: 2, MaxQuestions: 5, Options: 4}},
+		{name: "only max", in: QuizShape{MaxQuestions: 6}, want: QuizShape{MinQuestions: 6, MaxQuestions: 6, Options: 3}},
+		{name: "only min", in: QuizShape{MinQuestions: 2}, want: QuizShape{MinQuestions: 2, MaxQuestions: 2, Options: 3}},
+		{name: "limits", in: QuizShape{MinQuestions: 1, MaxQuestions: 10, Options: 6}, want: QuizShape{MinQuestions: 1, MaxQuestions: 10, Options: 6}},
+		{name: "too many questions", in: QuizShape{MaxQuestions: 11}, wantErr: true},
+		{name: "only min too many", in: QuizShape{MinQuestions: 12}, wantErr: true},
+		{name: "min above max", in: QuizShape{MinQuestions: 5, MaxQuestions: 3}, wantErr: true},
+		{name: "negative", in: QuizShape{MaxQuestions: -1}, wantErr: true},
+		{name: "one option", in: QuizShape{Options: 1}, wantErr: true},
+		{name: "too many options", in: QuizShape{Options: 7}, wantErr: true},
+		{name: "negative options", in: QuizShape{Options: -3}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.in.normalize()
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidQuizShape) {
+					t.Fatalf("err = %v, want ErrInvalidQuizShape", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("normalize(%+v) = %+v, %v; want %+v", tt.in, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeThemeCount(t *testing.T) {
+	tests := []struct {
+		in, want int
+		wantErr  bool
+	}{
+		{in: 0, want: 1},
+		{in: 1, want: 1},
+		{in: 10, want: 10},
+		{in: 11, wantErr: true},
+		{in: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := normalizeThemeCount(tt.in)
+		if tt.wantErr != errors.Is(err, ErrInvalidThemeCount) || got != tt.want {
+			t.Errorf("normalizeThemeCount(%d) = %d, %v; want %d, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestPluralRu(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "0 вопросов"},
+		{1, "1 вопрос"},
+		{2, "2 вопроса"},
+		{4, "4 вопроса"},
+		{5, "5 вопросов"},
+		{11, "11 вопросов"},
+		{12, "12 вопросов"},
+		{14, "14 вопросов"},
+		{21, "21 вопрос"},
+		{22, "22 вопроса"},
+		{111, "111 вопросов"},
+		{101, "101 вопрос"},
+	}
+	for _, tt := range tests {
+		if got := pluralRu(tt.n, "вопрос", "вопроса", "вопросов"); got != tt.want {
+			t.Errorf("pluralRu(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+const targetSegmentText = "segment_text"
+
+var ErrSegmentTextTarget = errors.New("segment_id or promotion_id is required")
+
+// GenerateSegmentTexts generates copy for one segment or, without segmentID, for every segment
+// of the promotion. Missing theme, promotion name and description are taken from the promotion.
+// With save the texts become the segments' new current versions once all of them are
+// generated, in one transaction: a failure leaves every segment as it was.
+func (s *Service) GenerateSegmentTexts(ctx context.Context, params map[string]string, promotionID, segmentID int64, save bool) ([]*entity.SegmentText, error) {
+	if s.segmentRepo == nil || s.promotionRepo == nil {
+		return nil, errors.New("segment storage is not configured")
+	}
+	var segments []*repository.SegmentRow
+	switch {
+	case segmentID > 0:
+		seg, err := s.segmentRepo.GetByID(ctx, segmentID)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && promotionID > 0 && seg.PromotionID != promotionID) {
+			return nil, repository.ErrNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		segments, promotionID = []*repository.SegmentRow{seg}, seg.PromotionID
+	case promotionID > 0:
+		var err error
+		if segments, err = s.segmentRepo.ByPromotionID(ctx, promotionID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrSegmentTextTarget
+	}
+
+	promo, err := s.promotionRepo.GetByID(ctx, promotionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	base := segmentTextParams(params, promo, segments)
+	ctx = WithPromotion(ctx, promotionID)
+
+	texts := make([]*entity.SegmentText, 0, len(segments))
+	for _, seg := range segments {
+		p := maps.Clone(base)
+		p["segment_name"] = seg.Name
+		if seg.CategoryName != nil {
+			p["segment_category"] = *seg.CategoryName
+		}
+		text, err := s.GetText(ctx, p, seg.ID)
+		if err != nil {
+			return nil, fmt.Errorf("segment %d text: %w", seg.ID, err)
+		}
+		texts = append(texts, &entity.SegmentText{SegmentID: seg.ID, Text: text, Source: entity.SegmentTextSourceAI})
+	}
+	if !save {
+		return texts, nil
+	}
+
+	inputs := make([]repository.SegmentTextInput, 0, len(texts))
+	for _, item := range texts {
+		inputs = append(inputs, repository.SegmentTextInput{SegmentID: item.SegmentID, Text: item.Text})
+	}
+	versions, err := s.segmentRepo.UpdateTexts(ctx, inputs, entity.SegmentTextSourceAI)
+	if err != nil {
+		return nil, fmt.Errorf("save segment texts: %w", err)
+	}
+	savedAt := time.Now().UTC()
+	for i, item := range texts {
+		item.Version, item.CreatedAt = versions[i], savedAt
+	}
+	return texts, nil
+}
+
+// segmentTextParams fills the promotion context the caller did not pass.
+func segmentTextParams(params map[string]string, promo *repository.PromotionRow, segments []*repository.SegmentRow) map[string]string {
+	out := maps.Clone(params)
+	if out == nil {
+		out = make(map[string]string)
+	}
+	defaults := map[string]string{
+		"target":                targetSegmentText,
+		"theme":                 promo.Theme,
+		"promotion_name":        promo.Name,
+		"promotion_description": promo.Description,
+	}
+	if len(segments) > 1 {
+		names := make([]string, 0, len(segments))
+		for _, seg := range segments {
+			names = append(names, seg.Name)
+		}
+		defaults["segments"] = strings.Join(names, ", ")
+	}
+	for key, value := range defaults {
+		if strings.TrimSpace(out[key]) == "" && value != "" {
+			out[key] = value
+		}
+	}
+	return out
+}
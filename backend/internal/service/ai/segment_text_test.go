@@ -0,0 +1,134 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+type fakeSegmentRepo struct {
+	repository.SegmentRepository
+	segments []*repository.SegmentRow
+	saved    [][]repository.SegmentTextInput
+	saveErr  error
+}
+
+func (f *fakeSegmentRepo) ByPromotionID(context.Context, int64) ([]*repository.SegmentRow, error) {
+	return f.segments, nil
+}
+
+func (f *fakeSegmentRepo) UpdateTexts(_ context.Context, texts []repository.SegmentTextInput, source string) ([]int, error) {
+	if source != entity.SegmentTextSourceAI {
+		return nil, errors.New("unexpected source " + source)
+	}
+	if f.saveErr != nil {
+		return nil, f.saveErr
+	}
+	f.saved = append(f.saved, texts)
+	versions := make([]int, len(texts))
+	for i := range versions {
+		versions[i] = 10 + i
+	}
+	return versions, nil
+}
+
+type fakePromotionRepo struct {
+	repository.PromotionRepository
+}
+
+func (fakePromotionRepo) GetByID(_ context.Context, id int64) (*repository.PromotionRow, error) {
+	return &repository.PromotionRow{ID: id, Name: "Гороскоп скидок", Theme: "зодиак"}, nil
+}
+
+// segmentTextProvider answers text prompts and fails the ones that mention failOn;
+// calls counts the provider requests.
+func segmentTextProvider(t *testing.T, failOn string, calls *atomic.Int32) *Service {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		if failOn != "" && strings.Contains(string(body), failOn) {
+			http.Error(w, "fail", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"content":"{\"text\":\"Скидки для вас\"}"}}]}`))
+	}))
+	t.Cleanup(server.Close)
+	return &Service{
+		provider:  "primary",
+		generator: testChain(testMember("primary", server.URL, newCircuitBreaker(5, time.Minute))),
+	}
+}
+
+func TestGenerateSegmentTexts(t *testing.T) {
+	tests := []struct {
+		name      string
+		failOn    string // prompt line the provider fails on
+		save      bool
+		saveErr   error
+		wantErr   bool
+		wantSaves int // UpdateTexts calls
+		wantCalls int32
+	}{
+		{name: "saves all texts at once", save: true, wantSaves: 1, wantCalls: 2},
+		{name: "preview does not save", save: false, wantCalls: 2},
+		{name: "provider failure saves nothing", failOn: "Сегмент: Телец", save: true, wantErr: true, wantCalls: 2},
+		{name: "store failure", save: true, saveErr: errors.New("tx aborted"), wantErr: true, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segments := &fakeSegmentRepo{
+				segments: []*repository.SegmentRow{{ID: 1, PromotionID: 7, Name: "Овен"}, {ID: 2, PromotionID: 7, Name: "Телец"}},
+				saveErr:  tt.saveErr,
+			}
+			var calls atomic.Int32
+			s := segmentTextProvider(t, tt.failOn, &calls)
+			s.segmentRepo, s.promotionRepo = segments, fakePromotionRepo{}
+
+			texts, err := s.GenerateSegmentTexts(context.Background(), nil, 7, 0, tt.save)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("provider calls = %d, want %d", calls.Load(), tt.wantCalls)
+			}
+			if len(segments.saved) != tt.wantSaves {
+				t.Fatalf("UpdateTexts calls = %d, want %d", len(segments.saved), tt.wantSaves)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(texts) != 2 {
+				t.Fatalf("texts = %d, want 2", len(texts))
+			}
+			for i, text := range texts {
+				if text.SegmentID != segments.segments[i].ID || text.Text != "Скидки для вас" {
+					t.Errorf("text %d = %+v", i, text)
+				}
+				wantVersion := 0
+				if tt.save {
+					wantVersion = 10 + i
+					if got := segments.saved[0][i]; got.SegmentID != text.SegmentID || got.Text != text.Text {
+						t.Errorf("saved %d = %+v, want %+v", i, got, text)
+					}
+				}
+				if text.Version != wantVersion || text.CreatedAt.IsZero() == tt.save {
+					t.Errorf("text %d version = %d, created %v", i, text.Version, text.CreatedAt)
+				}
+			}
+		})
+	}
+
+	if _, err := (&Service{segmentRepo: &fakeSegmentRepo{}, promotionRepo: fakePromotionRepo{}}).GenerateSegmentTexts(context.Background(), nil, 0, 0, true); !errors.Is(err, ErrSegmentTextTarget) {
+		t.Errorf("without target err = %v, want ErrSegmentTextTarget", err)
+	}
+}
@@ -10,14 +10,27 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"wildberries/internal/entity"
+	"wildberries/internal/repository"
 )
 
 const (
 	providerStub   = "stub"
 	providerGemini = "gemini"
 	providerGroq   = "groq"
+	providerOpenAI = "openai"
+)
+
+// Операции генерации: ключ кэша и поле operation в ai_generation_log
+const (
+	operationThemes     = "themes"
+	operationSegments   = "segments"
+	operationQuestions  = "questions"
+	operationAnswerTree = "answer_tree"
+	operationText       = "text"
 )
 
 var (
@@ -28,56 +41,260 @@ var (
 )
 
 // Config contains AI provider settings.
+// Provider is a comma-separated fallback chain, e.g. "gemini,groq"; "stub" is only valid alone.
 type Config struct {
 	Provider         string
+	RetryAttempts    int                   // attempts per provider on transient failures
+	RetryBaseDelay   time.Duration         // first backoff delay, doubled on each retry
+	BreakerThreshold int                   // consecutive failures that open a provider circuit
+	BreakerCooldown  time.Duration         // how long an open circuit skips the provider
+	CacheTTL         time.Duration         // lifetime of cached generations, 0 disables the cache
+	TokenPrices      map[string]TokenPrice // by provider, for the audit log
+	JobTimeout       time.Duration         // limit for one async generation job
 	GeminiAPIKey     string
 	GeminiModel      string
 	GeminiAPIBaseURL string
 	GroqAPIKey       string
 	GroqModel        string
 	GroqAPIBaseURL   string
+	// any OpenAI-compatible server (OpenAI, Ollama, vLLM...)
+	OpenAIAPIKey          string
+	OpenAIModel           string
+	OpenAIAPIBaseURL      string
+	OpenAIHeaders         map[string]string
+	OpenAITimeout         time.Duration
+	OpenAIDisableJSONMode bool // for servers that reject response_format
 }
 
 type JSONGenerator interface {
 	GenerateJSON(ctx context.Context, prompt string) (string, error)
 }
 
+// Validator is implemented by generators that can check their configuration without
+// calling the provider; Ready treats a generator without it as always configured.
+type Validator interface {
+	Validate() error
+}
+
 // Service handles AI business logic.
 type Service struct {
-	provider  string
-	generator JSONGenerator
+	provider      string // primary provider
+	generator     *chainGenerator
+	unsupported   []string
+	cacheRepo     repository.AIGenerationCacheRepository // nil — без кэша
+	cacheTTL      time.Duration
+	logRepo       repository.AIGenerationLogRepository
+	prices        map[string]TokenPrice
+	secrets       []string                             // never written to the audit log
+	jobRepo       repository.AIGenerationJobRepository // nil — без асинхронных задач
+	jobTimeout    time.Duration
+	jobWake       chan struct{}
+	productRepo   repository.ProductRepository // категории каталога для сегментов; nil — без проверки
+	segmentRepo   repository.SegmentRepository // тексты сегментов
+	promotionRepo repository.PromotionRepository
+	promptRepo    repository.PromptTemplateRepository // nil — только встроенные промпты
+	drafts        DraftStore                          // черновики акций из асинхронных задач
+	promptCache   sync.Map                            // разобранные версии промптов по "name@version"
 }
 
 // New creates a new AI service.
-func New(cfg Config) *Service {
-	provider := strings.ToLower(strings.TrimSpace(cfg.Provider))
-	if provider == "" {
-		provider = providerStub
+func New(cfg Config, cacheRepo repository.AIGenerationCacheRepository, logRepo repository.AIGenerationLogRepository, jobRepo repository.AIGenerationJobRepository, productRepo repository.ProductRepository, segmentRepo repository.SegmentRepository, promotionRepo repository.PromotionRepository, promptRepo repository.PromptTemplateRepository, drafts DraftStore) *Service {
+	var providers []string
+	for _, name := range strings.Split(cfg.Provider, ",") {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			providers = append(providers, name)
+		}
 	}
-
-	s := &Service{provider: provider}
-	switch provider {
-	case providerGemini:
-		s.generator = NewGeminiClient(cfg)
-	case providerGroq:
-		s.generator = NewGroqClient(cfg)
+	if len(providers) == 0 {
+		providers = []string{providerStub}
+	}
+
+	s := &Service{
+		provider:      providers[0],
+		cacheTTL:      cfg.CacheTTL,
+		logRepo:       logRepo,
+		prices:        cfg.TokenPrices,
+		jobRepo:       jobRepo,
+		jobTimeout:    cfg.JobTimeout,
+		jobWake:       make(chan struct{}, 1),
+		productRepo:   productRepo,
+		segmentRepo:   segmentRepo,
+		promotionRepo: promotionRepo,
+		promptRepo:    promptRepo,
+		drafts:        drafts,
+	}
+	if s.jobTimeout <= 0 {
+		s.jobTimeout = defaultJobTimeout
+	}
+	for _, secret := range []string{cfg.GeminiAPIKey, cfg.GroqAPIKey, cfg.OpenAIAPIKey} {
+		if secret = strings.TrimSpace(secret); secret != "" {
+			s.secrets = append(s.secrets, secret)
+		}
+	}
+	for _, value := range cfg.OpenAIHeaders {
+		if value = strings.TrimSpace(value); value != "" {
+			s.secrets = append(s.secrets, value)
+		}
+	}
+	if cfg.CacheTTL > 0 {
+		s.cacheRepo = cacheRepo
 	}
+	if s.provider == providerStub {
+		return s
+	}
+	members := make([]*chainMember, 0, len(providers))
+	for _, name := range providers {
+		var generator JSONGenerator
+		var model string
+		switch name {
+		case providerGemini:
+			generator, model = NewGeminiClient(cfg), cfg.GeminiModel
+		case providerGroq:
+			generator, model = NewGroqClient(cfg), cfg.GroqModel
+		case providerOpenAI:
+			generator, model = NewOpenAIClient(cfg), cfg.OpenAIModel
+		default:
+			s.unsupported = append(s.unsupported, name)
+			continue
+		}
+		members = append(members, &chainMember{
+			name:      name,
+			model:     strings.TrimSpace(model),
+			generator: generator,
+			breaker:   newCircuitBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown),
+		})
+	}
+	s.generator = newChainGenerator(members, cfg.RetryAttempts, cfg.RetryBaseDelay)
 	return s
 }
 
-// GenerateThemes generates themes.
-func (s *Service) GenerateThemes(ctx context.Context) ([]*entity.ThemeItem, error) {
+// GenerateThemes generates count theme ideas to pick from, 1 by default and at most 10.
+// Themes are not cached: the prompt depends only on count, so a cache would keep
+// answering the same ideas, while the admin calls it to get new ones.
+func (s *Service) GenerateThemes(ctx context.Context, count int) ([]*entity.ThemeItem, error) {
+	count, err := normalizeThemeCount(count)
+	if err != nil {
+		return nil, err
+	}
+	if s.provider == providerStub {
+		return stubThemes(count), nil
+	}
+
+	prompt, err := s.buildThemesPrompt(ctx, count)
+	if err != nil {
+		return nil, err
+	}
+	var result []*entity.ThemeItem
+	_, err = s.generateValidJSON(ctx, operationThemes, prompt, func(raw string) (err error) {
+		result, err = parseThemes(raw, count)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GenerateSegments generates segments with category_name taken from the product catalog.
+// slotCount > 0 restricts categories to those that can fill that many positions.
+// The result is cached per prompt unless force is set.
+func (s *Service) GenerateSegments(ctx context.Context, theme string, limit, slotCount int, force bool) ([]*entity.SegmentSuggestion, error) {
+	if limit <= 0 {
+		limit = 12
+	}
+	if limit > 30 {
+		limit = 30
+	}
+
+	categories, err := s.catalogCategories(ctx, slotCount)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.provider == providerStub {
+		return stubSegments(theme, limit, categories), nil
+	}
+
+	prompt, err := s.buildSegmentsPrompt(ctx, theme, limit, categories)
+	if err != nil {
+		return nil, err
+	}
+	allowed := categoryIndex(categories)
+	return generateCached(ctx, s, operationSegments, prompt, force, func(raw string) ([]*entity.SegmentSuggestion, error) {
+		return parseSegments(raw, limit, allowed)
+	})
+}
+
+// GenerateQuestions generates quiz questions of the given shape.
+// The result is cached per prompt unless force is set.
+func (s *Service) GenerateQuestions(ctx context.Context, theme string, shape QuizShape, force bool) ([]*entity.QuestionSuggestion, error) {
+	shape, err := shape.normalize()
+	if err != nil {
+		return nil, err
+	}
+	if s.provider == providerStub {
+		return stubQuestions(theme, shape), nil
+	}
+
+	prompt, err := s.buildQuestionsPrompt(ctx, theme, shape)
+	if err != nil {
+		return nil, err
+	}
+	return generateCached(ctx, s, operationQuestions, prompt, force, func(raw string) ([]*entity.QuestionSuggestion, error) {
+		return parseQuestions(raw, shape)
+	})
+}
+
+// GenerateAnswerTree generates the answer tree for a quiz of the given shape;
+// MaxQuestions is the number of questions.
+func (s *Service) GenerateAnswerTree(ctx context.Context, theme string, shape QuizShape) ([]*entity.AnswerTreeNode, error) {
+	shape, err := shape.normalize()
+	if err != nil {
+		return nil, err
+	}
 	if s.provider == providerStub {
-		return []*entity.ThemeItem{
-			{Value: "shopping-zodiac", Label: "Какой ты знак зодиака в шопинге?"},
-		}, nil
+		return stubAnswerTree(shape), nil
 	}
 
-	raw, err := s.generateJSON(ctx, buildThemesPrompt())
+	prompt, err := s.buildAnswerTreePrompt(ctx, theme, shape)
+	if err != nil {
+		return nil, err
+	}
+	var result []*entity.AnswerTreeNode
+	_, err = s.generateValidJSON(ctx, operationAnswerTree, prompt, func(raw string) (err error) {
+		result, err = parseAnswerTree(raw, shape)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
+	return result, nil
+}
+
+// GetText gets text.
+func (s *Service) GetText(ctx context.Context, params map[string]string, segmentID int64) (string, error) {
+	target := strings.ToLower(strings.TrimSpace(params["target"]))
+	if target == "" {
+		target = "promotion_description"
+	}
+
+	if s.provider == providerStub {
+		return stubText(target), nil
+	}
+
+	prompt, err := s.buildTextPrompt(ctx, params, segmentID, target)
+	if err != nil {
+		return "", err
+	}
+	var text string
+	_, err = s.generateValidJSON(ctx, operationText, prompt, func(raw string) (err error) {
+		text, err = parseText(raw)
+		return err
+	})
+	return text, err
+}
 
+func parseThemes(raw string, count int) ([]*entity.ThemeItem, error) {
 	type themeJSON struct {
 		Value string `json:"value"`
 		Label string `json:"label"`
@@ -90,8 +307,8 @@ func (s *Service) GenerateThemes(ctx context.Context) ([]*entity.ThemeItem, erro
 	if err := decodeStrictJSON(raw, &parsed); err != nil {
 		return nil, fmt.Errorf("decode themes json: %w", err)
 	}
-	if len(parsed.Themes) != 1 {
-		return nil, fmt.Errorf("themes count must be 1, got %d", len(parsed.Themes))
+	if len(parsed.Themes) != count {
+		return nil, fmt.Errorf("themes count must be %d, got %d", count, len(parsed.Themes))
 	}
 
 	seenValues := make(map[string]struct{}, len(parsed.Themes))
@@ -115,24 +332,9 @@ func (s *Service) GenerateThemes(ctx context.Context) ([]*entity.ThemeItem, erro
 	return result, nil
 }
 
-// GenerateSegments generates segments.
-func (s *Service) GenerateSegments(ctx context.Context, theme string, limit int) ([]*entity.SegmentSuggestion, error) {
-	if limit <= 0 {
-		limit = 12
-	}
-	if limit > 30 {
-		limit = 30
-	}
-
-	if s.provider == providerStub {
-		return stubSegments(theme, limit), nil
-	}
-
-	raw, err := s.generateJSON(ctx, buildSegmentsPrompt(theme, limit))
-	if err != nil {
-		return nil, err
-	}
-
+// parseSegments validates the generated segments; with allowed set, category_name must be
+// one of the catalog categories and is normalized to the catalog spelling.
+func parseSegments(raw string, limit int, allowed map[string]string) ([]*entity.SegmentSuggestion, error) {
 	type segmentJSON struct {
 		Name            string `json:"name"`
 		CategoryName    string `json:"category_name"`
@@ -167,6 +369,13 @@ func (s *Service) GenerateSegments(ctx context.Context, theme string, limit int)
 		if category == "" {
 			return nil, fmt.Errorf("segment[%d].category_name is required", i)
 		}
+		if allowed != nil {
+			known, ok := allowed[strings.ToLower(category)]
+			if !ok {
+				return nil, fmt.Errorf("segment[%d].category_name %q is not a catalog category", i, category)
+			}
+			category = known
+		}
 		normalized := strings.ToLower(name)
 		if _, exists := seen[normalized]; exists {
 			return nil, fmt.Errorf("segment[%d].name is duplicated", i)
@@ -178,17 +387,7 @@ func (s *Service) GenerateSegments(ctx context.Context, theme string, limit int)
 	return result, nil
 }
 
-// GenerateQuestions generates questions.
-func (s *Service) GenerateQuestions(ctx context.Context, theme string) ([]*entity.QuestionSuggestion, error) {
-	if s.provider == providerStub {
-		return stubQuestions(theme), nil
-	}
-
-	raw, err := s.generateJSON(ctx, buildQuestionsPrompt(theme))
-	if err != nil {
-		return nil, err
-	}
-
+func parseQuestions(raw string, shape QuizShape) ([]*entity.QuestionSuggestion, error) {
 	type optionJSON struct {
 		Text  string `json:"text"`
 		Value string `json:"value"`
@@ -205,8 +404,11 @@ func (s *Service) GenerateQuestions(ctx context.Context, theme string) ([]*entit
 	if err := decodeStrictJSON(raw, &parsed); err != nil {
 		return nil, fmt.Errorf("decode questions json: %w", err)
 	}
-	if len(parsed.Questions) != 4 {
-		return nil, fmt.Errorf("questions count must be 4, got %d", len(parsed.Questions))
+	if n := len(parsed.Questions); n < shape.MinQuestions || n > shape.MaxQuestions {
+		if shape.MinQuestions == shape.MaxQuestions {
+			return nil, fmt.Errorf("questions count must be %d, got %d", shape.MaxQuestions, n)
+		}
+		return nil, fmt.Errorf("questions count must be %d..%d, got %d", shape.MinQuestions, shape.MaxQuestions, n)
 	}
 
 	result := make([]*entity.QuestionSuggestion, 0, len(parsed.Questions))
@@ -215,8 +417,8 @@ func (s *Service) GenerateQuestions(ctx context.Context, theme string) ([]*entit
 		if text == "" {
 			return nil, fmt.Errorf("question[%d].text is required", i)
 		}
-		if len(q.Options) != 3 {
-			return nil, fmt.Errorf("question[%d].options count must be 3, got %d", i, len(q.Options))
+		if len(q.Options) != shape.Options {
+			return nil, fmt.Errorf("question[%d].options count must be %d, got %d", i, shape.Options, len(q.Options))
 		}
 		options := make([]*entity.OptionSuggestion, 0, len(q.Options))
 		for j, opt := range q.Options {
@@ -236,17 +438,9 @@ func (s *Service) GenerateQuestions(ctx context.Context, theme string) ([]*entit
 	return result, nil
 }
 
-// GenerateAnswerTree generates answer tree.
-func (s *Service) GenerateAnswerTree(ctx context.Context, theme string) ([]*entity.AnswerTreeNode, error) {
-	if s.provider == providerStub {
-		return stubAnswerTree(), nil
-	}
-
-	raw, err := s.generateJSON(ctx, buildAnswerTreePrompt(theme))
-	if err != nil {
-		return nil, err
-	}
-
+// parseAnswerTree checks the tree against the quiz shape: MaxQuestions questions with Options
+// options each, every option has exactly one edge and targets point at existing questions.
+func parseAnswerTree(raw string, shape QuizShape) ([]*entity.AnswerTreeNode, error) {
 	type nodeJSON struct {
 		NodeID          string `json:"node_id"`
 		NodeIDAlt       string `json:"nodeId"`
@@ -268,7 +462,6 @@ func (s *Service) GenerateAnswerTree(ctx context.Context, theme string) ([]*enti
 	}
 
 	hasMeta := false
-	hasEdge := false
 	edges := make(map[string]struct{}, len(parsed.Nodes))
 	ids := make(map[string]struct{}, len(parsed.Nodes))
 
@@ -301,14 +494,23 @@ func (s *Service) GenerateAnswerTree(ctx context.Context, theme string) ([]*enti
 
 		if label == "meta:start" {
 			hasMeta = true
-			if _, err := strconv.Atoi(value); err != nil {
+			start, err := strconv.Atoi(value)
+			if err != nil {
 				return nil, fmt.Errorf("node[%d].value must be integer for meta:start", i)
 			}
+			if start < 0 || start >= shape.MaxQuestions {
+				return nil, fmt.Errorf("node[%d].value meta:start must be 0..%d", i, shape.MaxQuestions-1)
+			}
 		} else {
 			match := answerTreeLabelRegexp.FindStringSubmatch(label)
 			if len(match) != 3 {
 				return nil, fmt.Errorf("node[%d].label must match edge:q<idx>:o<idx>", i)
 			}
+			q, _ := strconv.Atoi(match[1])
+			o, _ := strconv.Atoi(match[2])
+			if q >= shape.MaxQuestions || o >= shape.Options {
+				return nil, fmt.Errorf("node[%d].label %s is outside %d questions with %d options", i, label, shape.MaxQuestions, shape.Options)
+			}
 			if _, exists := edges[label]; exists {
 				return nil, fmt.Errorf("node[%d].label is duplicated", i)
 			}
@@ -319,14 +521,16 @@ func (s *Service) GenerateAnswerTree(ctx context.Context, theme string) ([]*enti
 				return nil, fmt.Errorf("node[%d].value must match question:<idx> or segment:segment-<n>", i)
 			}
 			if valueMatch[1] == "question" {
-				if _, err := strconv.Atoi(valueMatch[2]); err != nil {
+				next, err := strconv.Atoi(valueMatch[2])
+				if err != nil {
 					return nil, fmt.Errorf("node[%d].value has invalid question target", i)
 				}
+				if next < 0 || next >= shape.MaxQuestions {
+					return nil, fmt.Errorf("node[%d].value question target must be 0..%d", i, shape.MaxQuestions-1)
+				}
 			} else if !segmentTargetRegexp.MatchString(valueMatch[2]) {
 				return nil, fmt.Errorf("node[%d].value has invalid segment target", i)
 			}
-
-			hasEdge = true
 		}
 
 		result = append(result, &entity.AnswerTreeNode{
@@ -340,29 +544,18 @@ func (s *Service) GenerateAnswerTree(ctx context.Context, theme string) ([]*enti
 	if !hasMeta {
 		return nil, errors.New(`answer-tree must contain node with label "meta:start"`)
 	}
-	if !hasEdge {
-		return nil, errors.New("answer-tree must contain edge nodes")
+	for q := 0; q < shape.MaxQuestions; q++ {
+		for o := 0; o < shape.Options; o++ {
+			if _, ok := edges[fmt.Sprintf("edge:q%d:o%d", q, o)]; !ok {
+				return nil, fmt.Errorf("answer-tree has no edge:q%d:o%d node", q, o)
+			}
+		}
 	}
 
 	return result, nil
 }
 
-// GetText gets text.
-func (s *Service) GetText(ctx context.Context, params map[string]string, segmentID int64) (string, error) {
-	target := strings.ToLower(strings.TrimSpace(params["target"]))
-	if target == "" {
-		target = "promotion_description"
-	}
-
-	if s.provider == providerStub {
-		return stubText(target), nil
-	}
-
-	raw, err := s.generateJSON(ctx, buildTextPrompt(params, segmentID, target))
-	if err != nil {
-		return "", err
-	}
-
+func parseText(raw string) (string, error) {
 	type payload struct {
 		Text string `json:"text"`
 	}
@@ -378,14 +571,53 @@ func (s *Service) GetText(ctx context.Context, params map[string]string, segment
 	return text, nil
 }
 
-func (s *Service) generateJSON(ctx context.Context, prompt string) (string, error) {
-	if s.provider != providerGemini && s.provider != providerGroq {
-		return "", fmt.Errorf("unsupported ai provider: %s", s.provider)
+// Ready reports whether at least one configured provider can serve requests.
+func (s *Service) Ready() error {
+	if s.provider == providerStub {
+		return nil
+	}
+	if len(s.unsupported) > 0 {
+		return fmt.Errorf("unsupported ai provider: %s", strings.Join(s.unsupported, ", "))
+	}
+	return s.generator.Validate()
+}
+
+// generateJSON asks the providers and writes the call with its validation outcome
+// to the audit log.
+func (s *Service) generateJSON(ctx context.Context, operation, prompt string, parse func(raw string) error) (*generation, error) {
+	if len(s.unsupported) > 0 {
+		return nil, fmt.Errorf("unsupported ai provider: %s", strings.Join(s.unsupported, ", "))
+	}
+	gen, err := s.generator.generate(ctx, prompt)
+	if err != nil {
+		s.recordGeneration(ctx, operation, prompt, gen, GenerationStatusFailed, err)
+		return nil, &redactedError{err: err, msg: s.redact(err.Error())}
 	}
-	if s.generator == nil {
-		return "", fmt.Errorf("%s provider is not configured", s.provider)
+	if err := parse(gen.Raw); err != nil {
+		s.recordGeneration(ctx, operation, prompt, gen, GenerationStatusInvalid, err)
+		return gen, err
 	}
-	return s.generator.GenerateJSON(ctx, prompt)
+	s.recordGeneration(ctx, operation, prompt, gen, GenerationStatusOK, nil)
+	return gen, nil
+}
+
+// generateValidJSON asks the providers for JSON and passes it to parse. A response that
+// fails decoding or validation is re-prompted once with the error appended.
+// Returns the accepted response with the provider that produced it.
+func (s *Service) generateValidJSON(ctx context.Context, operation, prompt string, parse func(raw string) error) (*generation, error) {
+	gen, firstErr := s.generateJSON(ctx, operation, prompt, parse)
+	if firstErr == nil {
+		return gen, nil
+	}
+	if gen == nil {
+		return nil, firstErr
+	}
+
+	gen, err := s.generateJSON(ctx, operation, buildRepairPrompt(prompt, firstErr), parse)
+	if gen != nil && err != nil {
+		return nil, fmt.Errorf("%w (first attempt: %v)", err, firstErr)
+	}
+	return gen, err
 }
 
 func decodeStrictJSON(raw string, dst any) error {
@@ -400,7 +632,7 @@ func decodeStrictJSON(raw string, dst any) error {
 	return nil
 }
 
-func stubSegments(theme string, limit int) []*entity.SegmentSuggestion {
+func stubSegments(theme string, limit int, catalog []*entity.CategoryStat) []*entity.SegmentSuggestion {
 	base := []string{"Любители новинок", "Рациональные покупатели", "Охотники за выгодой", "Премиум-аудитория"}
 	if strings.TrimSpace(theme) != "" {
 		base = []string{
@@ -411,6 +643,12 @@ func stubSegments(theme string, limit int) []*entity.SegmentSuggestion {
 		}
 	}
 	categories := []string{"Электроника", "Дом и кухня", "Красота", "Одежда"}
+	if len(catalog) > 0 {
+		categories = categories[:0]
+		for _, c := range catalog {
+			categories = append(categories, c.Name)
+		}
+	}
 	result := make([]*entity.SegmentSuggestion, 0, limit)
 	for i := 0; i < len(base) && i < limit; i++ {
 		result = append(result, &entity.SegmentSuggestion{Name: base[i], CategoryName: categories[i%len(categories)]})
@@ -418,12 +656,31 @@ func stubSegments(theme string, limit int) []*entity.SegmentSuggestion {
 	return result
 }
 
-func stubQuestions(theme string) []*entity.QuestionSuggestion {
+func stubThemes(count int) []*entity.ThemeItem {
+	base := []*entity.ThemeItem{
+		{Value: "shopping-zodiac", Label: "Какой ты знак зодиака в шопинге?"},
+		{Value: "city-of-the-world", Label: "Какой ты город мира?"},
+		{Value: "energy-of-the-week", Label: "Твоя энергия недели"},
+		{Value: "vacation-type", Label: "Какой ты тип отдыха?"},
+		{Value: "hidden-superpower", Label: "Твоя скрытая суперсила"},
+	}
+	themes := make([]*entity.ThemeItem, 0, count)
+	for i := 0; i < count; i++ {
+		if i < len(base) {
+			themes = append(themes, base[i])
+			continue
+		}
+		themes = append(themes, &entity.ThemeItem{Value: fmt.Sprintf("stub-theme-%d", i+1), Label: fmt.Sprintf("Тема %d", i+1)})
+	}
+	return themes
+}
+
+func stubQuestions(theme string, shape QuizShape) []*entity.QuestionSuggestion {
 	prefix := strings.TrimSpace(theme)
 	if prefix == "" {
 		prefix = "Тема"
 	}
-	return []*entity.QuestionSuggestion{
+	base := []*entity.QuestionSuggestion{
 		{
 			Text: fmt.Sprintf("%s: что для вас важнее при выборе товара?", prefix),
 			Options: []*entity.OptionSuggestion{
@@ -457,16 +714,34 @@ func stubQuestions(theme string) []*entity.QuestionSuggestion {
 			},
 		},
 	}
+
+	questions := make([]*entity.QuestionSuggestion, 0, shape.MaxQuestions)
+	for i := 0; i < shape.MaxQuestions; i++ {
+		q := &entity.QuestionSuggestion{Text: fmt.Sprintf("Вопрос %d: что вам ближе?", i+1)}
+		if i < len(base) {
+			q.Text, q.Options = base[i].Text, base[i].Options
+		}
+		for j := len(q.Options); j < shape.Options; j++ {
+			q.Options = append(q.Options, &entity.OptionSuggestion{
+				Text:  fmt.Sprintf("Вариант %d", j+1),
+				Value: fmt.Sprintf("q%d_option_%d", i+1, j+1),
+			})
+		}
+		q.Options = q.Options[:shape.Options]
+		questions = append(questions, q)
+	}
+	return questions
 }
 
-func stubAnswerTree() []*entity.AnswerTreeNode {
+// stubAnswerTree walks the questions in order; options of the last question lead to segments.
+func stubAnswerTree(shape QuizShape) []*entity.AnswerTreeNode {
 	nodes := []*entity.AnswerTreeNode{
 		{NodeID: "meta-start", ParentNodeID: "", Label: "meta:start", Value: "0"},
 	}
-	for q := 0; q < 4; q++ {
-		for o := 0; o < 3; o++ {
+	for q := 0; q < shape.MaxQuestions; q++ {
+		for o := 0; o < shape.Options; o++ {
 			value := fmt.Sprintf("segment:segment-%d", o+1)
-			if q < 3 {
+			if q < shape.MaxQuestions-1 {
 				value = fmt.Sprintf("question:%d", q+1)
 			}
 			nodes = append(nodes, &entity.AnswerTreeNode{
@@ -486,244 +761,56 @@ func stubText(target string) string {
 		return "Персональные Скидки Недели"
 	case "promotion_description":
 		return "Персонализированная акция с подборкой релевантных товаров и выгодных предложений для разных сегментов покупателей."
+	case targetSegmentText:
+		return "Подборка товаров для вашего сегмента с лучшими скидками акции."
 	default:
 		return "Текст акции сгенерирован в тестовом stub-режиме."
 	}
 }
 
-func buildThemesPrompt() string {
-	return strings.TrimSpace(`
-Верни только валидный JSON, без markdown/code fences. Язык: русский. Контекст: e-commerce акции.
-
-Сгенерируй ровно 1 тему для персонализированной акции на маркетплейсе.
-Формат ответа строго:
-{
-  "themes": [
-    { "value": "machine-slug", "label": "Человекочитаемое название" }
-  ]
-}
-
-Требования:
-- Каждая тема должна быть достаточно широкой, чтобы на её основе можно было придумать сегменты, вопросы, название и описание акции.
-- Темы должны отличаться по эмоциональному образу и покупательской мотивации.
-- Избегай слишком узких, мемных, конфликтных или трудно коммерциализируемых тем.
-- Темы должны звучать оригинально, как готовая идея спецпроекта или квиза, а не как абстрактная категория.
-- Предпочитай русские формулировки label, которые хочется показать в UI как название акции/квиза.
-- "value" уникальный slug в lower-case (латиница/цифры/дефис), например "zodiac".
-- "label" непустой, естественный русский текст из 1-4 слов, пригодный для UI.
-- Никаких дополнительных полей.
-
-Референсы по уровню оригинальности и формату идеи:
-- "Какой ты архетип покупателя?"
-- "Кто ты в мире стартапов?"
-- "Твоя цифровая вселенная"
-- "Какой ты город мира?"
-- "Твоя энергия недели"
-- "Какой ты герой мифа?"
-- "Твой идеальный день"
-- "Какой ты тип отдыха?"
-- "Твоя скрытая суперсила"
-- "Какой ты стиль будущего?"
-- "Какой ты знак зодиака в шопинге?"
-
-Важно:
-- Не копируй референсы дословно без необходимости.
-- Используй их как ориентир по креативности, целостности и маркетинговой привлекательности.
-- Верни только одну, самую сильную и цельную идею.
-`)
-}
-
-func buildSegmentsPrompt(theme string, limit int) string {
-	contextBlock := buildFreeformContextBlock(theme)
-	return strings.TrimSpace(fmt.Sprintf(`
-Верни только валидный JSON, без markdown/code fences. Язык: русский. Контекст: e-commerce акции.
-
-Используй контекст ниже, чтобы придумать сегменты аудитории для акции.
-
-Контекст акции:
-%s
-
-Сгенерируй от 1 до %d сегментов аудитории.
-Формат ответа строго:
-{
-  "segments": [
-    { "name": "Название сегмента", "category_name": "Категория товаров" }
-  ]
-}
-
-Требования:
-- Не больше %d элементов.
-- Сначала выполни внутреннюю последовательность:
-  1. Определи ядро темы по названию и описанию акции.
-  2. Определи, является ли тема открытой интерпретационной или закрытой/канонической.
-  3. Если тема закрытая/каноническая, найди её фиксированный, общеизвестный или логически заданный набор сущностей.
-  4. Если тема открытая, только тогда аккуратно придумай авторские сегменты в логике темы.
-- Закрытая/каноническая тема = тема, где уже существует конкретный набор сущностей первого порядка: факультеты, герои, знаки, города, архетипы, роли, энергии, типы отдыха, стили, сценарии и т.п.
-- Для закрытых тем сегменты должны быть именно сущностями первого порядка из самой темы, а не производными маркетинговыми подкатегориями.
-- Если тема "Знаки зодиака", сегменты = сами знаки зодиака.
-- Если тема/описание про факультеты Хогвартса, сегменты = факультеты Хогвартса.
-- Если тема/описание про героев вселенной Гарри Поттера, сегменты = популярные герои этой вселенной, а не факультеты, артефакты, заклинания, черты характера или рекламные рубрики.
-- Если тема про города мира, сегменты = конкретные города.
-- Если тема про типы отдыха, сегменты = сами типы отдыха.
-- Для аналогичных тем применяй тот же принцип: сегменты = главные сущности темы, а не красивые вариации вокруг неё.
-- Если канонический набор слишком большой, выбери релевантное подмножество, но оно должно оставаться точным, узнаваемым и состоять из сущностей первого порядка.
-- Приоритет точного смыслового попадания в тему выше креативности. Лучше точный канонический набор, чем красивые, но неверные сегменты "по мотивам".
-- Запрещено:
-  - придумывать сегменты "по мотивам" вместо сущностей темы;
-  - смешивать разные уровни абстракции;
-  - подменять канонические сущности рекламными рубриками;
-  - делать размытые сегменты, которые нельзя однозначно распознать как элементы исходной темы.
-- Для открытых тем сегменты по-прежнему можно генерировать креативно, но они должны логично продолжать тему, быть различимыми, короткими, понятными и коммерчески осмысленными.
-- Если в контексте перечислены доступные категории, выбирай "category_name" только из этого списка.
-- "name" должен быть коротким, понятным в интерфейсе, различимым и не дублировать соседние сегменты по смыслу.
-- "category_name" должна быть конкретной товарной категорией маркетплейса. Она описывает товары, но не заменяет сущность темы.
-- "name" = сущность темы. "category_name" = товарная категория.
-- Уникальные "name".
-- Поля непустые.
-- Никаких дополнительных полей.
-
-Примеры уровня сегментации:
-- Для "Какой ты архетип покупателя?": "Импульсивный охотник", "Рациональный стратег", "Эстет-коллекционер", "Экономный оптимизатор".
-- Для "Кто ты в мире стартапов?": "Визионер", "Хакер", "Операционный маг", "Инвестор", "Продуктовый гик", "Маркетинговый алхимик".
-- Для "Твоя цифровая вселенная": "Cyberpunk", "Minimal Tech", "Retro Wave", "AI Native", "Digital Nomad", "Creator Economy", "Gamer Core", "Zen Tech".
-- Для "Какой ты город мира?": "Токио", "Париж", "Берлин", "Сеул", "Амстердам", "Лондон".
-- Для "Твоя энергия недели": "Энергия роста", "Энергия отдыха", "Энергия денег", "Энергия общения", "Энергия фокуса".
-- Для "Какой ты герой мифа?": "Воин", "Маг", "Трикстер", "Хранитель", "Искатель".
-
-Важно:
-- Не обязательно повторять эти примеры буквально.
-- Нужно выдавать сегменты того же качества: яркие, различимые, с понятным характером и коммерческим смыслом.
-- Но если контекст явно задаёт канонический набор сегментов, приоритет у точного попадания в этот набор, а не у произвольной креативности.
-`, contextBlock, limit, limit))
-}
-
-func buildQuestionsPrompt(theme string) string {
-	contextBlock := buildFreeformContextBlock(theme)
-	return strings.TrimSpace(fmt.Sprintf(`
-Верни только валидный JSON, без markdown/code fences. Язык: русский. Контекст: e-commerce акции.
-
-Используй контекст ниже, чтобы собрать сегментирующий опрос для акции.
-
-Контекст акции:
-%s
-
-Сгенерируй структуру для опроса сегментации: ровно 4 вопроса и у каждого ровно 3 варианта ответа.
-Формат ответа строго:
-{
-  "questions": [
-    {
-      "text": "Текст вопроса",
-      "options": [
-        { "text": "Текст варианта", "value": "machine_value" }
-      ]
-    }
-  ]
-}
-
-Требования:
-- Ровно 4 вопроса.
-- У каждого вопроса ровно 3 варианта.
-- Перед генерацией вопросов определи, какие точные сегменты заданы темой и описанием акции. Вопросы должны вести именно к ним, а не к производным состояниям рядом с темой.
-- Вопросы должны помогать различать покупателей по разным осям: мотивация, сценарий покупки, предпочтения, категорийный интерес.
-- Если в контексте уже есть сегменты, вопросы должны помогать развести пользователей именно по этим сегментам. Но не спрашивать напрямую к какому сегменту относится пользователь.
-- В сумме вопросы и варианты должны давать материал для дерева, в котором каждый сегмент из контекста получит хотя бы один сценарий пользователя.
-- Не задавай декоративные, слишком абстрактные или дублирующие друг друга вопросы.
-- Каждый вариант должен быть реалистичным, взаимоисключающим и коротким.
-- Вопросы не должны в лоб называть итоговые сегменты, но должны мягко вести к ним через выбор поведения, вкуса, ритма жизни, мотивации или сценария покупки.
-- Хороший вопрос звучит как нормальный пользовательский выбор, а не как формальная анкета.
-- Если сегменты канонические и относятся к закрытой теме, вопросы должны различать именно их смысловые отличия.
-- Вопросы должны работать на одном уровне абстракции с сегментами и не уводить в посторонние маркетинговые интерпретации.
-- Все тексты непустые.
-- "value" — непустое machine-friendly значение в lower-case latin/underscore.
-- Никаких дополнительных полей.
-
-Примеры хороших направлений для вопросов:
-- про стиль покупки: "Что чаще всего цепляет вас в товаре первым?"
-- про мотивацию: "Какой результат от покупки радует вас сильнее всего?"
-- про сценарий: "Как выглядит ваш идеальный способ провести выходной?"
-- про визуальный вкус: "Какой вайб вам ближе?"
-- про поведение: "Когда вы видите интересную новинку, что делаете?"
-- про отдых/лайфстайл: "Какой формат перезагрузки вам ближе?"
-
-Примеры тональности ответов:
-- "Схватить сразу, пока горит"
-- "Сравнить и выбрать лучшее"
-- "Найти что-то редкое и красивое"
-- "Взять максимум пользы за бюджет"
-- "Технологично и функционально"
-- "Уютно и эстетично"
-
-Важно:
-- Не копируй примеры буквально, если они не подходят теме.
-- Используй их как ориентир, чтобы вопросы были живыми, небанальными и действительно помогали маршрутизировать пользователя в подходящий сегмент.
-- Если сегменты канонические и хорошо известные, вопросы должны раскрывать различия между ними через характер, стиль, выбор, мотивацию или поведение.
-- Пример: если сегменты = факультеты Хогвартса, вопросы должны разделять смелость/амбицию/мудрость/лояльность, а не спрашивать напрямую "какой вы факультет?".
-- Пример: если сегменты = герои вселенной Гарри Поттера, вопросы должны разводить пользователя по типу поведения и ценностям, которые приближают к этим героям.
-- Пример: если сегменты = знаки зодиака, вопросы должны вести к архетипам знаков, а не к выдуманным производным вроде "обувная удача" или "зодиакальные девушки".
-- Запрещено уводить вопросы в более расплывчатую или более рекламную плоскость, чем исходная тема.
-`, contextBlock))
-}
-
-func buildAnswerTreePrompt(theme string) string {
-	contextBlock := buildFreeformContextBlock(theme)
-	return strings.TrimSpace(fmt.Sprintf(`
-Верни только валидный JSON, без markdown/code fences. Язык: русский. Контекст: e-commerce акции.
-
-Используй контекст ниже, чтобы построить дерево переходов для сегментирующего опроса.
-
-Контекст акции:
-%s
-
-Построй дерево ответов для 4 вопросов и 3 вариантов на вопрос.
-Формат ответа строго:
-{
-  "nodes": [
-    { "node_id": "meta-start", "parent_node_id": "", "label": "meta:start", "value": "0" },
-    { "node_id": "edge-q0-o0", "parent_node_id": "meta-start", "label": "edge:q0:o0", "value": "question:1" }
-  ]
-}
-
-Правила:
-- Обязательно 1 meta-узел: label="meta:start", value — индекс стартового вопроса (обычно "0").
-- Для каждого перехода: label строго "edge:q<questionIndex>:o<optionIndex>".
-- value строго:
-  - "question:<idx>" для перехода к следующему вопросу
-  - "segment:segment-<n>" для финального сегмента
-- Если в контексте перечислены итоговые сегменты как segment-1..segment-N, используй только эти target values.
-- Каждый итоговый сегмент из контекста должен встретиться хотя бы в одном переходе к segment:segment-<n>.
-- Разрешено завершать сценарий сегментом раньше последнего вопроса, если это нужно для покрытия всех сегментов.
-- Переходы должны опираться на реальные смысловые различия между сегментами темы. Не делай случайное распределение.
-- Если сегменты относятся к закрытой/канонической теме, дерево должно сохранять тот же уровень точности и не подменять их производными интерпретациями.
-- Дерево должно быть логичным по смыслу ответов, а не случайным.
-- Для 4x3 структуры должно быть 1 + 12 узлов.
-- Никаких дополнительных полей.
-`, contextBlock))
-}
-
-func buildTextPrompt(params map[string]string, segmentID int64, target string) string {
-	contextBlock := buildTextContextBlock(params, segmentID)
-	return strings.TrimSpace(fmt.Sprintf(`
-Верни только валидный JSON, без markdown/code fences. Язык: русский. Контекст: e-commerce акции.
-
-Используй контекст ниже, чтобы сгенерировать текст для акции.
-
-Контекст акции:
-%s
-
-Target: %q.
-
-Формат ответа строго:
-{
-  "text": "..."
-}
-
-Правила:
-- Пиши естественным русским языком, без markdown, эмодзи и без кавычек вокруг всего текста.
-- Если target="promotion_name": короткое и запоминающееся название акции (3-6 слов, до 60 символов), в котором чувствуется тема и покупательская выгода.
-- Если target="promotion_description": краткое описание акции (1-2 предложения), которое объясняет механику/ценность акции и опирается на тему, сегменты и категории, если они переданы.
-- Для других target верни нейтральный маркетинговый текст акции с опорой на переданный контекст.
-- Никаких дополнительных полей.
-`, contextBlock, target))
+func buildRepairPrompt(prompt string, parseErr error) string {
+	var b strings.Builder
+	b.WriteString(prompt)
+	b.WriteString("\n\nПредыдущий ответ отклонён валидатором: ")
+	b.WriteString(parseErr.Error())
+	b.WriteString("\nИсправь ошибку и верни только валидный JSON строго по схеме выше, без пояснений.\n")
+	return b.String()
+}
+
+func (s *Service) buildThemesPrompt(ctx context.Context, count int) (string, error) {
+	return s.renderPrompt(ctx, operationThemes, map[string]any{"count": count})
+}
+
+func (s *Service) buildSegmentsPrompt(ctx context.Context, theme string, limit int, categories []*entity.CategoryStat) (string, error) {
+	return s.renderPrompt(ctx, operationSegments, map[string]any{
+		"context": buildFreeformContextBlock(theme) + buildCategoriesBlock(categories),
+		"limit":   limit,
+	})
+}
+
+func (s *Service) buildQuestionsPrompt(ctx context.Context, theme string, shape QuizShape) (string, error) {
+	return s.renderPrompt(ctx, operationQuestions, map[string]any{
+		"context":       buildFreeformContextBlock(theme),
+		"min_questions": shape.MinQuestions,
+		"max_questions": shape.MaxQuestions,
+		"options":       shape.Options,
+	})
+}
+
+func (s *Service) buildAnswerTreePrompt(ctx context.Context, theme string, shape QuizShape) (string, error) {
+	return s.renderPrompt(ctx, operationAnswerTree, map[string]any{
+		"context":   buildFreeformContextBlock(theme),
+		"questions": shape.MaxQuestions,
+		"options":   shape.Options,
+		"edges":     shape.MaxQuestions * shape.Options,
+	})
+}
+
+func (s *Service) buildTextPrompt(ctx context.Context, params map[string]string, segmentID int64, target string) (string, error) {
+	return s.renderPrompt(ctx, operationText, map[string]any{
+		"context": buildTextContextBlock(params, segmentID),
+		"target":  target,
+	})
 }
 
 func buildFreeformContextBlock(raw string) string {
@@ -766,6 +853,8 @@ func buildTextContextBlock(params map[string]string, segmentID int64) string {
 		{key: "promotion_name", label: "Название акции"},
 		{key: "promotion_description", label: "Описание акции"},
 		{key: "segments", label: "Сегменты акции"},
+		{key: "segment_name", label: "Сегмент"},
+		{key: "segment_category", label: "Категория сегмента"},
 		{key: "questions", label: "Текущие вопросы"},
 		{key: "pricing_model", label: "Модель ценообразования"},
 		{key: "identification_mode", label: "Идентификация"},
@@ -781,17 +870,19 @@ func buildTextContextBlock(params map[string]string, segmentID int64) string {
 	}
 
 	excluded := map[string]struct{}{
-		"theme":               {},
-		"theme_label":         {},
-		"promotion_name":      {},
+		"theme":                 {},
+		"theme_label":           {},
+		"promotion_name":        {},
 		"promotion_description": {},
-		"segments":            {},
-		"questions":           {},
-		"pricing_model":       {},
-		"identification_mode": {},
-		"slot_count":          {},
-		"discount_range":      {},
-		"target":              {},
+		"segments":              {},
+		"segment_name":          {},
+		"segment_category":      {},
+		"questions":             {},
+		"pricing_model":         {},
+		"identification_mode":   {},
+		"slot_count":            {},
+		"discount_range":        {},
+		"target":                {},
 	}
 
 	otherKeys := make([]string, 0)
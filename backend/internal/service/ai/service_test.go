@@ -0,0 +1,165 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseThemes(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		count   int
+		wantErr string
+	}{
+		{name: "ok", raw: `{"themes":[{"value":"Zodiac","label":"Знаки зодиака"}]}`, count: 1},
+		{name: "wrong count", raw: `{"themes":[{"value":"zodiac","label":"Зодиак"}]}`, count: 2, wantErr: "themes count must be 2, got 1"},
+		{name: "not a slug", raw: `{"themes":[{"value":"знаки","label":"Зодиак"}]}`, count: 1, wantErr: "must be slug"},
+		{name: "no label", raw: `{"themes":[{"value":"zodiac","label":" "}]}`, count: 1, wantErr: "label is required"},
+		{name: "duplicate", raw: `{"themes":[{"value":"zodiac","label":"А"},{"value":"ZODIAC","label":"Б"}]}`, count: 2, wantErr: "duplicated"},
+		{name: "unknown field", raw: `{"themes":[],"extra":1}`, count: 0, wantErr: "decode themes json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			themes, err := parseThemes(tt.raw, tt.count)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if themes[0].Value != "zodiac" {
+				t.Errorf("value = %q, want lower-case slug", themes[0].Value)
+			}
+		})
+	}
+}
+
+// questionsJSON builds a questions answer with the given number of options per question.
+func questionsJSON(options ...int) string {
+	type option struct {
+		Text  string `json:"text"`
+		Value string `json:"value"`
+	}
+	type question struct {
+		Text    string   `json:"text"`
+		Options []option `json:"options"`
+	}
+	questions := make([]question, 0, len(options))
+	for q, n := range options {
+		item := question{Text: fmt.Sprintf("Вопрос %d", q)}
+		for o := 0; o < n; o++ {
+			item.Options = append(item.Options, option{Text: fmt.Sprintf("Вариант %d", o), Value: fmt.Sprintf("q%d_o%d", q, o)})
+		}
+		questions = append(questions, item)
+	}
+	raw, _ := json.Marshal(map[string]any{"questions": questions})
+	return string(raw)
+}
+
+func TestParseQuestions(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		shape   QuizShape
+		wantErr string
+	}{
+		{name: "exact count", raw: questionsJSON(3, 3, 3, 3), shape: QuizShape{MinQuestions: 4, MaxQuestions: 4, Options: 3}},
+		{name: "within range", raw: questionsJSON(2, 2, 2), shape: QuizShape{MinQuestions: 2, MaxQuestions: 5, Options: 2}},
+		{name: "single question", raw: questionsJSON(6), shape: QuizShape{MinQuestions: 1, MaxQuestions: 1, Options: 6}},
+		{name: "too few", raw: questionsJSON(3), shape: QuizShape{MinQuestions: 2, MaxQuestions: 5, Options: 3}, wantErr: "questions count must be 2..5, got 1"},
+		{name: "not exact", raw: questionsJSON(3, 3, 3), shape: QuizShape{MinQuestions: 4, MaxQuestions: 4, Options: 3}, wantErr: "questions count must be 4, got 3"},
+		{name: "wrong options", raw: questionsJSON(3, 2), shape: QuizShape{MinQuestions: 2, MaxQuestions: 2, Options: 3}, wantErr: "question[1].options count must be 3, got 2"},
+		{name: "empty option value", raw: `{"questions":[{"text":"В","options":[{"text":"А","value":" "},{"text":"Б","value":"b"}]}]}`, shape: QuizShape{MinQuestions: 1, MaxQuestions: 1, Options: 2}, wantErr: "options[0].value is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			questions, err := parseQuestions(tt.raw, tt.shape)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if shape := quizShapeOf(questions); shape.MaxQuestions < tt.shape.MinQuestions || shape.Options != tt.shape.Options {
+				t.Errorf("parsed shape %+v does not fit %+v", shape, tt.shape)
+			}
+		})
+	}
+}
+
+// answerTreeJSON builds a tree where every option leads to segment-1 and "edge:q<q>:o<o>" keys
+// in override replace the target, or drop the edge when the target is empty.
+func answerTreeJSON(questions, options int, start string, override map[string]string) string {
+	nodes := []map[string]string{{"node_id": "start", "label": "meta:start", "value": start}}
+	labels := make([]string, 0, questions*options)
+	for q := 0; q < questions; q++ {
+		for o := 0; o < options; o++ {
+			labels = append(labels, fmt.Sprintf("edge:q%d:o%d", q, o))
+		}
+	}
+	for label := range override {
+		if !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	for _, label := range labels {
+		value := "segment:segment-1"
+		if v, ok := override[label]; ok {
+			value = v
+		}
+		if value == "" {
+			continue
+		}
+		nodes = append(nodes, map[string]string{"node_id": label, "parent_node_id": "start", "label": label, "value": value})
+	}
+	raw, _ := json.Marshal(map[string]any{"nodes": nodes})
+	return string(raw)
+}
+
+func TestParseAnswerTree(t *testing.T) {
+	shape := QuizShape{MinQuestions: 2, MaxQuestions: 2, Options: 2}
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{name: "complete", raw: answerTreeJSON(2, 2, "0", map[string]string{"edge:q0:o0": "question:1"})},
+		{name: "missing edge", raw: answerTreeJSON(2, 2, "0", map[string]string{"edge:q1:o1": ""}), wantErr: "no edge:q1:o1 node"},
+		{name: "question out of range", raw: answerTreeJSON(2, 2, "0", map[string]string{"edge:q2:o0": "segment:segment-1"}), wantErr: "outside 2 questions"},
+		{name: "option out of range", raw: answerTreeJSON(2, 2, "0", map[string]string{"edge:q0:o2": "segment:segment-1"}), wantErr: "outside 2 questions with 2 options"},
+		{name: "question target out of range", raw: answerTreeJSON(2, 2, "0", map[string]string{"edge:q0:o0": "question:2"}), wantErr: "question target must be 0..1"},
+		{name: "start out of range", raw: answerTreeJSON(2, 2, "2", nil), wantErr: "meta:start must be 0..1"},
+		{name: "bad segment target", raw: answerTreeJSON(2, 2, "0", map[string]string{"edge:q0:o0": "segment:Овен"}), wantErr: "invalid segment target"},
+		{name: "no meta", raw: `{"nodes":[{"node_id":"a","label":"edge:q0:o0","value":"segment:segment-1"}]}`, wantErr: "meta:start"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, err := parseAnswerTree(tt.raw, shape)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(nodes) != 5 {
+				t.Errorf("got %d nodes, want 5", len(nodes))
+			}
+		})
+	}
+	if _, err := parseAnswerTree(answerTreeJSON(10, 6, "0", nil), QuizShape{MaxQuestions: 10, Options: 6}); err != nil {
+		t.Errorf("largest shape: %v", err)
+	}
+}
@@ -0,0 +1,21 @@
+package ai
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"wildberries/internal/tracing"
+)
+
+// startProviderSpan opens a span around one AI provider request.
+func startProviderSpan(ctx context.Context, provider, model string) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, provider+".GenerateJSON",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("ai.provider", provider),
+			attribute.String("ai.model", model),
+		),
+	)
+}
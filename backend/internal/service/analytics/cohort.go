@@ -0,0 +1,100 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"wildberries/internal/entity"
+)
+
+const (
+	// defaultCohortSize is how many latest completed promotions are compared when none are given
+	defaultCohortSize = 8
+	// MaxCohortSize limits how many promotions can be compared at once
+	MaxCohortSize = 20
+)
+
+var (
+	ErrTooManyPromotions     = errors.New("too many promotions to compare")
+	ErrPromotionNotCompleted = errors.New("promotion is not completed")
+)
+
+// ComparePromotions returns side-by-side metrics of COMPLETED promotions.
+// Without ids the latest completed promotions are compared.
+func (s *Service) ComparePromotions(ctx context.Context, promotionIDs []int64) ([]*entity.PromotionCohort, error) {
+	ids := uniqueIDs(promotionIDs)
+	if len(ids) > MaxCohortSize {
+		return nil, fmt.Errorf("%w: at most %d", ErrTooManyPromotions, MaxCohortSize)
+	}
+	limit := defaultCohortSize
+	if len(ids) > 0 {
+		limit = len(ids)
+	}
+	rows, err := s.reportRepo.CompareCompleted(ctx, ids, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) < len(ids) {
+		found := make(map[int64]struct{}, len(rows))
+		for _, row := range rows {
+			found[row.PromotionID] = struct{}{}
+		}
+		for _, id := range ids {
+			if _, ok := found[id]; !ok {
+				return nil, fmt.Errorf("%w: %d", ErrPromotionNotCompleted, id)
+			}
+		}
+	}
+
+	out := make([]*entity.PromotionCohort, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.PromotionCohort{
+			PromotionID:          row.PromotionID,
+			Name:                 row.Name,
+			Theme:                row.Theme,
+			IdentificationMode:   entity.ParseIdentificationMode(row.IdentificationMode),
+			PricingModel:         entity.ParsePricingModel(row.PricingModel),
+			DateFrom:             row.DateFrom,
+			DateTo:               row.DateTo,
+			TotalSlots:           row.TotalSlots,
+			FilledSlots:          row.FilledSlots,
+			FillRate:             ratio(row.FilledSlots, row.TotalSlots),
+			AvgClearingPrice:     row.AvgClearingPrice,
+			Bets:                 row.Bets,
+			Bidders:              row.Bidders,
+			ApprovedApplications: row.ApprovedApplications,
+			RejectedApplications: row.RejectedApplications,
+			RejectionRate:        ratio(row.RejectedApplications, row.ApprovedApplications+row.RejectedApplications),
+			ViewSessions:         row.ViewSessions,
+			ResolvedSessions:     row.ResolvedSessions,
+			ClickSessions:        row.ClickSessions,
+			QuizCompletionRate:   ratio(row.ResolvedSessions, row.ViewSessions),
+			BuyerConversion:      ratio(row.ClickSessions, row.ViewSessions),
+		})
+	}
+	return out, nil
+}
+
+func uniqueIDs(ids []int64) []int64 {
+	out := make([]int64, 0, len(ids))
+	seen := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
+
+func ratio(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
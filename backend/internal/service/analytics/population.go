@@ -0,0 +1,117 @@
+package analytics
+
+import (
+	"context"
+	"log/slog"
+	"math"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+	"wildberries/internal/service/buyer"
+)
+
+type quizSimulator interface {
+	SimulateIdentification(ctx context.Context, promotionID int64, optionIDs []int64) (*buyer.IdentificationSimulation, error)
+}
+
+// EstimateSegmentPopulation estimates how many buyers land in each segment of the promotion.
+// Observed quiz outcomes are used once there are any; before that the average audience of
+// same-theme promotions is split by the share of simulated quiz paths reaching each segment.
+func (s *Service) EstimateSegmentPopulation(ctx context.Context, promotionID int64) (map[int64]*entity.SegmentPopulation, error) {
+	promo, err := s.promotionRepo.GetByID(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := s.segmentRepo.ByPromotionID(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int64]*entity.SegmentPopulation, len(segments))
+	if len(segments) == 0 {
+		return out, nil
+	}
+
+	observed, err := s.eventRepo.SegmentOutcomes(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	var observedTotal int64
+	for _, seg := range segments {
+		observedTotal += observed[seg.ID]
+	}
+	if observedTotal > 0 {
+		for _, seg := range segments {
+			out[seg.ID] = &entity.SegmentPopulation{
+				SegmentID:  seg.ID,
+				Population: observed[seg.ID],
+				Share:      float64(observed[seg.ID]) / float64(observedTotal),
+				Source:     entity.PopulationSourceObserved,
+			}
+		}
+		return out, nil
+	}
+
+	shares := s.segmentShares(ctx, promotionID, segments)
+	audience, err := s.eventRepo.ThemeOutcomeAverage(ctx, promo.Theme, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	source := entity.PopulationSourceSimulation
+	if audience > 0 {
+		source = entity.PopulationSourceHistorical
+	}
+	for _, seg := range segments {
+		out[seg.ID] = &entity.SegmentPopulation{
+			SegmentID:  seg.ID,
+			Population: int64(math.Round(audience * shares[seg.ID])),
+			Share:      shares[seg.ID],
+			Source:     source,
+		}
+	}
+	return out, nil
+}
+
+// segmentShares splits buyers by simulating the quiz; an error only costs the estimate its precision.
+func (s *Service) segmentShares(ctx context.Context, promotionID int64, segments []*repository.SegmentRow) map[int64]float64 {
+	var sim *buyer.IdentificationSimulation
+	if s.simulator != nil {
+		var err error
+		sim, err = s.simulator.SimulateIdentification(ctx, promotionID, nil)
+		if err != nil {
+			slog.WarnContext(ctx, "simulate quiz for population, using even split",
+				slog.Int64("promotion_id", promotionID), slog.String("error", err.Error()))
+			sim = nil
+		}
+	}
+	return simulatedShares(sim, segments)
+}
+
+// simulatedShares splits buyers by the probability of reaching each segment, assuming every
+// option of a question is equally likely: a path weighs the product of 1/len(options) along it.
+// Paths that end without a segment are left out. Falls back to an even split.
+func simulatedShares(sim *buyer.IdentificationSimulation, segments []*repository.SegmentRow) map[int64]float64 {
+	shares := make(map[int64]float64, len(segments))
+	if sim != nil {
+		known := make(map[int64]bool, len(segments))
+		for _, seg := range segments {
+			known[seg.ID] = true
+		}
+		var total float64
+		for _, path := range sim.Paths {
+			if known[path.ResultSegmentID] {
+				shares[path.ResultSegmentID] += path.Probability
+				total += path.Probability
+			}
+		}
+		if total > 0 {
+			for id := range shares {
+				shares[id] /= total
+			}
+			return shares
+		}
+	}
+	for _, seg := range segments {
+		shares[seg.ID] = 1 / float64(len(segments))
+	}
+	return shares
+}
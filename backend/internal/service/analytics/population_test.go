@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+
+	"wildberries/internal/repository"
+	"wildberries/internal/service/buyer"
+)
+
+func TestSimulatedShares(t *testing.T) {
+	segments := []*repository.SegmentRow{{ID: 1}, {ID: 2}}
+	tests := []struct {
+		name string
+		sim  *buyer.IdentificationSimulation
+		want map[int64]float64
+	}{
+		{
+			name: "no simulation",
+			want: map[int64]float64{1: 0.5, 2: 0.5},
+		},
+		{
+			// the first answer decides segment 1 for half of the buyers,
+			// the other half splits over three options of the next question
+			name: "weighted by option count",
+			sim: &buyer.IdentificationSimulation{Paths: []*buyer.SimulationPath{
+				{ResultSegmentID: 1, Probability: 0.5},
+				{ResultSegmentID: 1, Probability: 0.5 / 3},
+				{ResultSegmentID: 2, Probability: 0.5 / 3},
+				{ResultSegmentID: 2, Probability: 0.5 / 3},
+			}},
+			want: map[int64]float64{1: 2.0 / 3, 2: 1.0 / 3},
+		},
+		{
+			name: "unresolved paths are left out",
+			sim: &buyer.IdentificationSimulation{Paths: []*buyer.SimulationPath{
+				{ResultSegmentID: 2, Probability: 0.25},
+				{Loop: true, Probability: 0.75},
+			}},
+			want: map[int64]float64{2: 1},
+		},
+		{
+			name: "no resolved paths",
+			sim: &buyer.IdentificationSimulation{Paths: []*buyer.SimulationPath{
+				{ResultSegmentID: 99, Probability: 1},
+			}},
+			want: map[int64]float64{1: 0.5, 2: 0.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := simulatedShares(tt.sim, segments)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for id, want := range tt.want {
+				if math.Abs(got[id]-want) > 1e-9 {
+					t.Errorf("share of %d = %v, want %v", id, got[id], want)
+				}
+			}
+		})
+	}
+}
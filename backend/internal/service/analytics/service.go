@@ -0,0 +1,169 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+// defaultPeriod is used when the caller does not pass a start of the range
+const defaultPeriod = 30 * 24 * time.Hour
+
+var ErrInvalidPeriod = errors.New("invalid analytics period")
+
+// Service computes promotion analytics from stored buyer events
+type Service struct {
+	eventRepo     repository.BuyerEventRepository
+	promotionRepo repository.PromotionRepository
+	segmentRepo   repository.SegmentRepository
+	reportRepo    repository.PromotionReportRepository
+	simulator     quizSimulator
+}
+
+// New creates a new analytics service
+func New(
+	eventRepo repository.BuyerEventRepository,
+	promotionRepo repository.PromotionRepository,
+	segmentRepo repository.SegmentRepository,
+	reportRepo repository.PromotionReportRepository,
+	simulator quizSimulator,
+) *Service {
+	return &Service{
+		eventRepo:     eventRepo,
+		promotionRepo: promotionRepo,
+		segmentRepo:   segmentRepo,
+		reportRepo:    reportRepo,
+		simulator:     simulator,
+	}
+}
+
+// Period is a half-open [From, To) time range; zero values mean "last 30 days" / "now"
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+func (p Period) normalize() (Period, error) {
+	if p.To.IsZero() {
+		p.To = time.Now()
+	}
+	if p.From.IsZero() {
+		p.From = p.To.Add(-defaultPeriod)
+	}
+	if !p.From.Before(p.To) {
+		return p, ErrInvalidPeriod
+	}
+	return p, nil
+}
+
+// GetPromotionFunnel returns the promotion funnel and a funnel per resolved segment.
+// Every segment of the promotion is present, even without traffic.
+func (s *Service) GetPromotionFunnel(ctx context.Context, promotionID int64, period Period) (*entity.PromotionFunnel, error) {
+	period, err := period.normalize()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.promotionRepo.GetByID(ctx, promotionID); err != nil {
+		return nil, err
+	}
+	segments, err := s.segmentRepo.ByPromotionID(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.eventRepo.Funnel(ctx, promotionID, period.From, period.To)
+	if err != nil {
+		return nil, err
+	}
+
+	funnel := &entity.PromotionFunnel{
+		PromotionID: promotionID,
+		From:        period.From,
+		To:          period.To,
+		Segments:    make([]*entity.SegmentFunnel, 0, len(segments)),
+	}
+	bySegment := make(map[int64]entity.FunnelStages, len(rows))
+	for _, row := range rows {
+		stages := entity.FunnelStages{
+			Views:           row.Views,
+			QuizStarts:      row.QuizStarts,
+			Completions:     row.Completions,
+			SegmentResolved: row.SegmentResolved,
+			ProductClicks:   row.ProductClicks,
+		}
+		switch {
+		case row.IsTotal:
+			funnel.Total = stages
+		case row.SegmentID != nil:
+			bySegment[*row.SegmentID] = stages
+		}
+	}
+	for _, seg := range segments {
+		funnel.Segments = append(funnel.Segments, &entity.SegmentFunnel{
+			SegmentID:   seg.ID,
+			SegmentName: seg.Name,
+			Stages:      bySegment[seg.ID],
+		})
+	}
+	return funnel, nil
+}
+
+// GetSegmentDistribution returns how quiz outcomes split across the promotion's segments.
+func (s *Service) GetSegmentDistribution(ctx context.Context, promotionID int64, period Period) ([]*entity.SegmentShare, error) {
+	funnel, err := s.GetPromotionFunnel(ctx, promotionID, period)
+	if err != nil {
+		return nil, err
+	}
+	var total int64
+	for _, seg := range funnel.Segments {
+		total += seg.Stages.SegmentResolved
+	}
+	out := make([]*entity.SegmentShare, 0, len(funnel.Segments))
+	for _, seg := range funnel.Segments {
+		share := 0.0
+		if total > 0 {
+			share = float64(seg.Stages.SegmentResolved) / float64(total)
+		}
+		out = append(out, &entity.SegmentShare{
+			SegmentID:   seg.SegmentID,
+			SegmentName: seg.SegmentName,
+			Sessions:    seg.Stages.SegmentResolved,
+			Share:       share,
+		})
+	}
+	return out, nil
+}
+
+// GetEventTimeSeries returns event counts per bucket ("hour" or "day", default "day").
+func (s *Service) GetEventTimeSeries(ctx context.Context, promotionID int64, period Period, bucket string, eventTypes []entity.BuyerEventType) ([]*entity.TimeSeriesPoint, error) {
+	period, err := period.normalize()
+	if err != nil {
+		return nil, err
+	}
+	if bucket == "" {
+		bucket = "day"
+	}
+	types := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		if _, ok := entity.ParseBuyerEventType(string(t)); !ok {
+			return nil, fmt.Errorf("unknown event type %q: %w", t, repository.ErrInvalidFilter)
+		}
+		types = append(types, string(t))
+	}
+	rows, err := s.eventRepo.TimeSeries(ctx, promotionID, period.From, period.To, bucket, types)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*entity.TimeSeriesPoint, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.TimeSeriesPoint{
+			BucketStart: row.BucketStart,
+			EventType:   entity.BuyerEventType(row.EventType),
+			Count:       row.Count,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,150 @@
+package buyer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+const (
+	// MaxEventBatch is the largest batch accepted by TrackEvents.
+	MaxEventBatch = 500
+	// Events are accepted only within this window of the server time, so they never land
+	// outside the pre-created daily partitions and a replay cannot outlive its dedup row.
+	// Events without a time get the server time.
+	eventMaxAge    = 24 * time.Hour
+	eventMaxFuture = 5 * time.Minute
+	// dedup rows are kept a little longer than the window for requests in flight
+	eventDedupRetention = eventMaxAge + time.Hour
+	// EventPartitionDaysAhead is how many daily buyer_event partitions are kept ahead of today,
+	// so a week of failed maintenance runs still does not send events to the default partition.
+	EventPartitionDaysAhead = 7
+)
+
+var ErrEventBatchTooLarge = errors.New("event batch too large")
+
+// RejectedEvent is an event of the batch that failed validation
+type RejectedEvent struct {
+	Index  int
+	Reason string
+}
+
+// TrackEvents validates a batch of buyer events and stores the valid ones in one insert.
+// Returns the number of stored events (duplicates by event id are not counted) and the rejected ones.
+func (s *Service) TrackEvents(ctx context.Context, events []*entity.BuyerEvent) (int, []RejectedEvent, error) {
+	if len(events) > MaxEventBatch {
+		return 0, nil, ErrEventBatchTooLarge
+	}
+	now := time.Now().UTC()
+	rows := make([]*repository.BuyerEventRow, 0, len(events))
+	var rejected []RejectedEvent
+	for i, e := range events {
+		if reason := validateBuyerEvent(e); reason != "" {
+			rejected = append(rejected, RejectedEvent{Index: i, Reason: reason})
+			continue
+		}
+		occurredAt := e.OccurredAt.UTC()
+		if e.OccurredAt.IsZero() {
+			occurredAt = now
+		} else if occurredAt.Before(now.Add(-eventMaxAge)) || occurredAt.After(now.Add(eventMaxFuture)) {
+			rejected = append(rejected, RejectedEvent{Index: i, Reason: "occurred_at is outside the accepted window"})
+			continue
+		}
+		eventID := e.EventID
+		if eventID == "" {
+			eventID = newEventID()
+		}
+		props := []byte("{}")
+		if len(e.Properties) > 0 {
+			props, _ = json.Marshal(e.Properties)
+		}
+		rows = append(rows, &repository.BuyerEventRow{
+			EventID:     eventID,
+			EventType:   string(e.Type),
+			PromotionID: e.PromotionID,
+			SegmentID:   optionalID(e.SegmentID),
+			QuestionID:  optionalID(e.QuestionID),
+			OptionID:    optionalID(e.OptionID),
+			ProductID:   optionalID(e.ProductID),
+			SlotID:      optionalID(e.SlotID),
+			SessionID:   e.SessionID,
+			Properties:  props,
+			OccurredAt:  occurredAt,
+		})
+	}
+	if s.eventRepo == nil || len(rows) == 0 {
+		return 0, rejected, nil
+	}
+	accepted, err := s.eventRepo.InsertBatch(ctx, rows)
+	if err != nil {
+		return 0, nil, err
+	}
+	return accepted, rejected, nil
+}
+
+// EnsureEventPartitions creates buyer_event partitions from the oldest accepted event time
+// through EventPartitionDaysAhead days after today.
+func (s *Service) EnsureEventPartitions(ctx context.Context) error {
+	if s.eventRepo == nil {
+		return nil
+	}
+	from := time.Now().Add(-eventMaxAge)
+	return s.eventRepo.EnsurePartitions(ctx, from, EventPartitionDaysAhead+2)
+}
+
+// PurgeEventDedup forgets ids of events older than the accepted window.
+func (s *Service) PurgeEventDedup(ctx context.Context) (int64, error) {
+	if s.eventRepo == nil {
+		return 0, nil
+	}
+	return s.eventRepo.PurgeDedup(ctx, time.Now().Add(-eventDedupRetention))
+}
+
+func validateBuyerEvent(e *entity.BuyerEvent) string {
+	if e == nil {
+		return "empty event"
+	}
+	if _, ok := entity.ParseBuyerEventType(string(e.Type)); !ok {
+		return "unknown event type"
+	}
+	if e.PromotionID <= 0 {
+		return "promotion_id is required"
+	}
+	switch e.Type {
+	case entity.BuyerEventQuestionAnswered:
+		if e.QuestionID <= 0 || e.OptionID <= 0 {
+			return "question_id and option_id are required"
+		}
+	case entity.BuyerEventSegmentResolved:
+		if e.SegmentID <= 0 {
+			return "segment_id is required"
+		}
+	case entity.BuyerEventProductImpression, entity.BuyerEventProductClick:
+		if e.ProductID <= 0 {
+			return "product_id is required"
+		}
+	}
+	if len(e.EventID) > 64 {
+		return "event_id is too long"
+	}
+	return ""
+}
+
+func optionalID(id int64) *int64 {
+	if id <= 0 {
+		return nil
+	}
+	return &id
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,82 @@
+package buyer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+type fakeEventRepo struct {
+	repository.BuyerEventRepository
+	rows        []*repository.BuyerEventRow
+	purgeBefore time.Time
+}
+
+func (f *fakeEventRepo) InsertBatch(_ context.Context, rows []*repository.BuyerEventRow) (int, error) {
+	f.rows = append(f.rows, rows...)
+	return len(rows), nil
+}
+
+func (f *fakeEventRepo) PurgeDedup(_ context.Context, before time.Time) (int64, error) {
+	f.purgeBefore = before
+	return 0, nil
+}
+
+func TestTrackEventsWindow(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		name       string
+		occurredAt time.Time
+		accepted   bool
+		serverTime bool
+	}{
+		{name: "no time", accepted: true, serverTime: true},
+		{name: "recent", occurredAt: now.Add(-time.Hour), accepted: true},
+		{name: "slightly ahead", occurredAt: now.Add(time.Minute), accepted: true},
+		{name: "too old", occurredAt: now.Add(-25 * time.Hour)},
+		{name: "too far ahead", occurredAt: now.Add(10 * time.Minute)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeEventRepo{}
+			s := &Service{eventRepo: repo}
+			accepted, rejected, err := s.TrackEvents(context.Background(), []*entity.BuyerEvent{
+				{EventID: "e1", Type: entity.BuyerEventPromotionView, PromotionID: 1, OccurredAt: tt.occurredAt},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.accepted {
+				if accepted != 0 || len(rejected) != 1 || rejected[0].Index != 0 {
+					t.Fatalf("accepted %d, rejected %+v; want the event rejected", accepted, rejected)
+				}
+				return
+			}
+			if accepted != 1 || len(rejected) != 0 {
+				t.Fatalf("accepted %d, rejected %+v", accepted, rejected)
+			}
+			got := repo.rows[0].OccurredAt
+			if tt.serverTime && got.Sub(now).Abs() > time.Minute {
+				t.Errorf("occurred_at = %v, want server time", got)
+			}
+			if !tt.serverTime && !got.Equal(tt.occurredAt) {
+				t.Errorf("occurred_at = %v, want %v", got, tt.occurredAt)
+			}
+		})
+	}
+}
+
+func TestPurgeEventDedup(t *testing.T) {
+	repo := &fakeEventRepo{}
+	s := &Service{eventRepo: repo}
+	if _, err := s.PurgeEventDedup(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// rows are kept at least as long as a replay of the event would be accepted
+	if age := time.Since(repo.purgeBefore); age < eventMaxAge {
+		t.Errorf("purges rows younger than the event window: %v", age)
+	}
+}
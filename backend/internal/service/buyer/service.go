@@ -6,11 +6,21 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"wildberries/internal/cache"
 	"wildberries/internal/entity"
 	"wildberries/internal/repository"
 )
 
+var ErrPromotionNotActive = errors.New("promotion is not active")
+
+// PromotionTarget describes the buyer context used for promotion targeting.
+type PromotionTarget struct {
+	Region   string
+	Platform string
+}
+
 // Service handles buyer business logic
 type Service struct {
 	productRepo   repository.ProductRepository
@@ -18,27 +28,45 @@ type Service struct {
 	slotRepo      repository.SlotRepository
 	segmentRepo   repository.SegmentRepository
 	pollRepo      repository.PollRepository
+	eventRepo     repository.BuyerEventRepository
+	cache         cache.Cache
+	cacheTTL      time.Duration
 }
 
-// New creates a new buyer service
+// New creates a new buyer service; storefront reads go through c with the given ttl
 func New(
 	productRepo repository.ProductRepository,
 	promotionRepo repository.PromotionRepository,
 	slotRepo repository.SlotRepository,
 	segmentRepo repository.SegmentRepository,
 	pollRepo repository.PollRepository,
+	eventRepo repository.BuyerEventRepository,
+	c cache.Cache,
+	cacheTTL time.Duration,
 ) *Service {
+	if c == nil {
+		c = cache.Noop{}
+	}
 	return &Service{
 		productRepo:   productRepo,
 		promotionRepo: promotionRepo,
 		slotRepo:      slotRepo,
 		segmentRepo:   segmentRepo,
 		pollRepo:      pollRepo,
+		eventRepo:     eventRepo,
+		cache:         c,
+		cacheTTL:      cacheTTL,
 	}
 }
 
 // GetCurrentPromotionSegments returns segments for a promotion (for buyer response)
 func (s *Service) GetCurrentPromotionSegments(ctx context.Context, promotionID int64) ([]*entity.Segment, error) {
+	return cache.GetOrLoad(ctx, s.cache, cache.SegmentsKey(promotionID), s.cacheTTL, func() ([]*entity.Segment, error) {
+		return s.loadPromotionSegments(ctx, promotionID)
+	})
+}
+
+func (s *Service) loadPromotionSegments(ctx context.Context, promotionID int64) ([]*entity.Segment, error) {
 	rows, err := s.segmentRepo.ByPromotionID(ctx, promotionID)
 	if err != nil {
 		return nil, err
@@ -59,118 +87,147 @@ func (s *Service) GetCurrentPromotionSegments(ctx context.Context, promotionID i
 	return out, nil
 }
 
-// GetCurrentPromotion gets the current active promotion
-func (s *Service) GetCurrentPromotion(ctx context.Context) (*entity.Promotion, error) {
-	row, err := s.promotionRepo.GetActive(ctx)
+// GetCurrentPromotion returns the promotion shown to a buyer: the given one if it is running
+// and targets the buyer, otherwise the highest-priority running promotion.
+func (s *Service) GetCurrentPromotion(ctx context.Context, promotionID int64, target PromotionTarget) (*entity.Promotion, error) {
+	promos, err := s.ListActivePromotions(ctx, target)
 	if err != nil {
 		return nil, err
 	}
-	if row == nil {
-		return nil, nil
+	if promotionID == 0 {
+		if len(promos) == 0 {
+			return nil, nil
+		}
+		return promos[0], nil
 	}
-	p := &entity.Promotion{
-		ID:                 row.ID,
-		Name:               row.Name,
-		Description:        row.Description,
-		Theme:              row.Theme,
-		DateFrom:           row.DateFrom,
-		DateTo:             row.DateTo,
-		Status:             entity.ParsePromotionStatus(row.Status),
-		IdentificationMode: entity.ParseIdentificationMode(row.IdentificationMode),
-		PricingModel:       entity.ParsePricingModel(row.PricingModel),
-		SlotCount:          row.SlotCount,
-		MinDiscount:        row.MinDiscount,
-		MaxDiscount:        row.MaxDiscount,
-		MinPrice:           row.MinPrice,
-		BidStep:            row.BidStep,
-	}
-	return p, nil
+	for _, p := range promos {
+		if p.ID == promotionID {
+			return p, nil
+		}
+	}
+	return nil, ErrPromotionNotActive
 }
 
-// GetSegmentProducts gets products for a segment (occupied slots); discount from WB or seller
+// ListActivePromotions returns running promotions targeted at the buyer, by descending priority.
+func (s *Service) ListActivePromotions(ctx context.Context, target PromotionTarget) ([]*entity.Promotion, error) {
+	key := cache.ActivePromotionsKey(target.Region, target.Platform)
+	return cache.GetOrLoad(ctx, s.cache, key, s.cacheTTL, func() ([]*entity.Promotion, error) {
+		return s.loadActivePromotions(ctx, target)
+	})
+}
+
+func (s *Service) loadActivePromotions(ctx context.Context, target PromotionTarget) ([]*entity.Promotion, error) {
+	rows, err := s.promotionRepo.ListActive(ctx, target.Region, target.Platform)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*entity.Promotion, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.Promotion{
+			ID:                 row.ID,
+			Name:               row.Name,
+			Description:        row.Description,
+			Theme:              row.Theme,
+			DateFrom:           row.DateFrom,
+			DateTo:             row.DateTo,
+			Status:             entity.ParsePromotionStatus(row.Status),
+			IdentificationMode: entity.ParseIdentificationMode(row.IdentificationMode),
+			PricingModel:       entity.ParsePricingModel(row.PricingModel),
+			SlotCount:          row.SlotCount,
+			MinDiscount:        row.MinDiscount,
+			MaxDiscount:        row.MaxDiscount,
+			MinPrice:           row.MinPrice,
+			BidStep:            row.BidStep,
+			Priority:           row.Priority,
+			TargetRegions:      row.TargetRegions,
+			TargetPlatforms:    row.TargetPlatforms,
+		})
+	}
+	return out, nil
+}
+
+// GetSegmentProducts gets products for a segment (occupied slots); discount from WB or seller.
+// Filters, sorting and pagination are applied in SQL; filters.Page/PerPage are normalized in place.
 func (s *Service) GetSegmentProducts(ctx context.Context, promotionID, segmentID int64, filters *ProductFilters) ([]*entity.ProductItem, int, bool, error) {
-	if _, err := s.segmentRepo.GetByPromoAndSegment(ctx, promotionID, segmentID); err != nil {
-		return nil, 0, false, err
+	if filters.Page <= 0 {
+		filters.Page = 1
+	}
+	if filters.PerPage <= 0 {
+		filters.PerPage = defaultProductsPerPage
+	}
+	if filters.PerPage > maxProductsPerPage {
+		filters.PerPage = maxProductsPerPage
 	}
 
-	slots, err := s.slotRepo.BySegmentID(ctx, segmentID, true)
+	query := fmt.Sprintf("%s|%t|%s|%d|%d", filters.Category, filters.OnlyDiscounts, filters.Sort, filters.Page, filters.PerPage)
+	key := cache.SegmentProductsKey(promotionID, segmentID, query)
+	page, err := cache.GetOrLoad(ctx, s.cache, key, s.cacheTTL, func() (*segmentProductsPage, error) {
+		return s.loadSegmentProducts(ctx, promotionID, segmentID, *filters)
+	})
 	if err != nil {
 		return nil, 0, false, err
 	}
+	return page.Items, page.Total, page.Completed, nil
+}
+
+type segmentProductsPage struct {
+	Items     []*entity.ProductItem
+	Total     int
+	Completed bool
+}
+
+func (s *Service) loadSegmentProducts(ctx context.Context, promotionID, segmentID int64, filters ProductFilters) (*segmentProductsPage, error) {
+	if _, err := s.segmentRepo.GetByPromoAndSegment(ctx, promotionID, segmentID); err != nil {
+		return nil, err
+	}
 	promoRow, err := s.promotionRepo.GetByID(ctx, promotionID)
 	if err != nil {
-		return nil, 0, false, err
-	}
-	completed := false
-	promoDiscount := 0
-	if promoRow != nil {
-		promoDiscount = promoRow.MaxDiscount
-		completed = entity.ParsePromotionStatus(promoRow.Status) == entity.PromotionStatusCompleted
-	}
-	if len(slots) == 0 {
-		return nil, 0, completed, nil
-	}
-	var productIDs []int64
-	for _, slot := range slots {
-		if slot.ProductID != nil && *slot.ProductID > 0 {
-			productIDs = append(productIDs, *slot.ProductID)
-		}
-	}
-	if len(productIDs) == 0 {
-		return nil, 0, completed, nil
+		return nil, err
 	}
-	productRows, err := s.productRepo.GetByIDs(ctx, productIDs, repository.ProductFilters{
+	completed := entity.ParsePromotionStatus(promoRow.Status) == entity.PromotionStatusCompleted
+
+	// WB-filled slot (seller_id nil) uses promotion.discount; seller-filled uses slot discount
+	rows, total, err := s.productRepo.ListBySegmentSlots(ctx, segmentID, promoRow.MaxDiscount, repository.ProductFilters{
 		Category:      filters.Category,
 		OnlyDiscounts: filters.OnlyDiscounts,
 		Sort:          filters.Sort,
+		Page:          filters.Page,
+		PerPage:       filters.PerPage,
 	})
 	if err != nil {
-		return nil, 0, completed, err
-	}
-	// WB-filled slot (seller_id nil) uses promotion.discount; seller-filled uses product.discount
-	productIDToWBDiscount := make(map[int64]bool)
-	for _, slot := range slots {
-		if slot.ProductID != nil && slot.SellerID == nil {
-			productIDToWBDiscount[*slot.ProductID] = true
-		}
-	}
-	items := make([]*entity.ProductItem, 0, len(productRows))
-	products := make(map[int64]*repository.ProductRow)
-	for _, r := range productRows {
-		products[r.ID] = r
+		return nil, err
 	}
-	for _, slot := range slots {
-		discount := slot.Discount
-		if productIDToWBDiscount[*slot.ProductID] && discount == 0 {
-			discount = int64(promoDiscount)
-		}
-		r := products[*slot.ProductID]
+
+	items := make([]*entity.ProductItem, 0, len(rows))
+	for _, r := range rows {
 		img := ""
 		if r.Image != nil {
 			img = *r.Image
 		}
-		currentPrice := r.Price
 		oldPrice := int64(0)
-		if discount > 0 {
-			oldPrice = r.Price
-			currentPrice = r.Price * int64(100-discount) / 100
+		if r.Discount > 0 {
+			oldPrice = r.BasePrice
 		}
 		items = append(items, &entity.ProductItem{
-			ID:           *slot.ProductID,
+			ID:           r.ProductID,
 			NmID:         r.NmID,
 			Name:         r.Name,
 			Image:        img,
-			Price:        currentPrice,
+			Price:        r.Price,
 			OldPrice:     oldPrice,
-			Discount:     int32(discount),
+			Discount:     int32(r.Discount),
 			CategoryName: r.CategoryName,
-			Position:     int64(slot.Position),
+			Position:     int64(r.Position),
 		})
 	}
-	total := len(items)
-	return items, total, completed, nil
+	return &segmentProductsPage{Items: items, Total: total, Completed: completed}, nil
 }
 
+const (
+	defaultProductsPerPage = 20
+	maxProductsPerPage     = 100
+)
+
 // ProductFilters represents filters for product queries
 type ProductFilters struct {
 	Category      string
@@ -207,13 +264,13 @@ func (s *Service) StartIdentification(ctx context.Context, promotionID int64) (*
 		return nil, errors.New("promotion not found")
 	}
 	if promo.IdentificationMode == "user_profile" {
-		segmentID, err := s.firstSegmentID(ctx, promotionID)
+		targets, err := s.loadSegmentTargets(ctx, promotionID)
 		if err != nil {
 			return nil, err
 		}
 		return &IdentificationStart{
 			Method:          "user_profile",
-			ResultSegmentID: segmentID,
+			ResultSegmentID: targets.first(),
 		}, nil
 	}
 
@@ -232,9 +289,12 @@ func (s *Service) AnswerIdentification(ctx context.Context, promotionID, questio
 	if err != nil {
 		return 0, 0, err
 	}
+	targets, err := s.loadSegmentTargets(ctx, promotionID)
+	if err != nil {
+		return 0, 0, err
+	}
 	if len(questions) == 0 {
-		segmentID, err := s.firstSegmentID(ctx, promotionID)
-		return 0, segmentID, err
+		return 0, targets.first(), nil
 	}
 
 	var idx = -1
@@ -258,25 +318,29 @@ func (s *Service) AnswerIdentification(ctx context.Context, promotionID, questio
 		return 0, 0, errors.New("question not found")
 	}
 
-	if nextQuestionID, resultSegmentID, resolved, err := s.resolveAnswerTreeTransition(ctx, promotionID, questions, idx, optionIdx); err != nil {
+	if nextQuestionID, resultSegmentID, resolved, err := s.resolveAnswerTreeTransition(ctx, promotionID, targets, questions, idx, optionIdx); err != nil {
 		return 0, 0, err
 	} else if resolved {
 		return nextQuestionID, resultSegmentID, nil
 	}
 
-	if idx < len(questions)-1 {
-		return questions[idx+1].ID, 0, nil
-	}
-	segmentID, err := s.firstSegmentID(ctx, promotionID)
-	if err != nil {
-		return 0, 0, err
+	nextQuestionID, resultSegmentID := fallbackTransition(targets, questions, idx)
+	return nextQuestionID, resultSegmentID, nil
+}
+
+// fallbackTransition is used when the answer tree has no edge for the chosen option:
+// go to the next question in order, or finish with the first segment after the last one.
+func fallbackTransition(targets *segmentTargets, questions []PollQuestion, questionIndex int) (nextQuestionID, resultSegmentID int64) {
+	if questionIndex < len(questions)-1 {
+		return questions[questionIndex+1].ID, 0
 	}
-	return 0, segmentID, nil
+	return 0, targets.first()
 }
 
 func (s *Service) resolveAnswerTreeTransition(
 	ctx context.Context,
 	promotionID int64,
+	targets *segmentTargets,
 	questions []PollQuestion,
 	questionIndex int,
 	optionIndex int,
@@ -289,8 +353,20 @@ func (s *Service) resolveAnswerTreeTransition(
 	if err != nil {
 		return 0, 0, false, err
 	}
+	nextQuestionID, resultSegmentID, resolved = resolveAnswerTreeEdge(targets, treeRows, questions, questionIndex, optionIndex)
+	return nextQuestionID, resultSegmentID, resolved, nil
+}
+
+// resolveAnswerTreeEdge resolves the "edge:q<i>:o<j>" transition against already loaded tree rows.
+func resolveAnswerTreeEdge(
+	targets *segmentTargets,
+	treeRows []*repository.PollAnswerTreeRow,
+	questions []PollQuestion,
+	questionIndex int,
+	optionIndex int,
+) (nextQuestionID int64, resultSegmentID int64, resolved bool) {
 	if len(treeRows) == 0 {
-		return 0, 0, false, nil
+		return 0, 0, false
 	}
 
 	edgeLabel := fmt.Sprintf("edge:q%d:o%d", questionIndex, optionIndex)
@@ -302,69 +378,92 @@ func (s *Service) resolveAnswerTreeTransition(
 		}
 	}
 	if targetValueRaw == "" {
-		return 0, 0, false, nil
+		return 0, 0, false
 	}
 
 	parts := strings.SplitN(targetValueRaw, ":", 2)
 	if len(parts) != 2 {
-		return 0, 0, false, nil
+		return 0, 0, false
 	}
 	targetType := strings.ToLower(strings.TrimSpace(parts[0]))
 	targetValue := strings.TrimSpace(parts[1])
 	if targetValue == "" {
-		return 0, 0, false, nil
+		return 0, 0, false
 	}
 
 	switch targetType {
 	case "question":
 		nextQuestionIndex, err := strconv.Atoi(targetValue)
 		if err != nil || nextQuestionIndex < 0 || nextQuestionIndex >= len(questions) {
-			return 0, 0, false, nil
+			return 0, 0, false
 		}
-		return questions[nextQuestionIndex].ID, 0, true, nil
+		return questions[nextQuestionIndex].ID, 0, true
 	case "segment":
-		segmentID, found, err := s.resolveSegmentTarget(ctx, promotionID, targetValue)
-		if err != nil {
-			return 0, 0, false, err
-		}
+		segmentID, found := targets.resolve(targetValue)
 		if !found {
-			return 0, 0, false, nil
+			return 0, 0, false
 		}
-		return 0, segmentID, true, nil
+		return 0, segmentID, true
 	default:
-		return 0, 0, false, nil
+		return 0, 0, false
 	}
 }
 
-func (s *Service) resolveSegmentTarget(ctx context.Context, promotionID int64, target string) (segmentID int64, found bool, err error) {
+// segmentTargets resolves answer tree "segment:<target>" values against segments of one
+// promotion loaded once: by segment ID, by 1-based (then 0-based) position, or by name.
+type segmentTargets struct {
+	order  []int64
+	ids    map[int64]struct{}
+	byName map[string]int64
+}
+
+func newSegmentTargets(segments []*repository.SegmentRow) *segmentTargets {
+	t := &segmentTargets{
+		order:  make([]int64, 0, len(segments)),
+		ids:    make(map[int64]struct{}, len(segments)),
+		byName: make(map[string]int64, len(segments)),
+	}
+	for _, segment := range segments {
+		t.order = append(t.order, segment.ID)
+		t.ids[segment.ID] = struct{}{}
+		name := strings.ToLower(strings.TrimSpace(segment.Name))
+		if _, exists := t.byName[name]; !exists {
+			t.byName[name] = segment.ID
+		}
+	}
+	return t
+}
+
+func (s *Service) loadSegmentTargets(ctx context.Context, promotionID int64) (*segmentTargets, error) {
 	segments, err := s.segmentRepo.ByPromotionID(ctx, promotionID)
 	if err != nil {
-		return 0, false, err
+		return nil, err
 	}
+	return newSegmentTargets(segments), nil
+}
 
+func (t *segmentTargets) resolve(target string) (segmentID int64, found bool) {
 	if parsedID, parseErr := strconv.ParseInt(target, 10, 64); parseErr == nil {
-		for _, segment := range segments {
-			if segment.ID == parsedID {
-				return parsedID, true, nil
-			}
+		if _, ok := t.ids[parsedID]; ok {
+			return parsedID, true
 		}
-
-		if parsedID > 0 && parsedID <= int64(len(segments)) {
-			return segments[parsedID-1].ID, true, nil
+		if parsedID > 0 && parsedID <= int64(len(t.order)) {
+			return t.order[parsedID-1], true
 		}
-		if parsedID >= 0 && parsedID < int64(len(segments)) {
-			return segments[parsedID].ID, true, nil
+		if parsedID >= 0 && parsedID < int64(len(t.order)) {
+			return t.order[parsedID], true
 		}
 	}
+	segmentID, found = t.byName[strings.ToLower(strings.TrimSpace(target))]
+	return segmentID, found
+}
 
-	normalizedTarget := strings.TrimSpace(target)
-	for _, segment := range segments {
-		if strings.EqualFold(strings.TrimSpace(segment.Name), normalizedTarget) {
-			return segment.ID, true, nil
-		}
+// first is the fallback segment, 0 when the promotion has none.
+func (t *segmentTargets) first() int64 {
+	if len(t.order) == 0 {
+		return 0
 	}
-
-	return 0, false, nil
+	return t.order[0]
 }
 
 func (s *Service) buildPollQuestions(ctx context.Context, promotionID int64) ([]PollQuestion, error) {
@@ -404,14 +503,3 @@ func (s *Service) buildPollQuestions(ctx context.Context, promotionID int64) ([]
 	}
 	return out, nil
 }
-
-func (s *Service) firstSegmentID(ctx context.Context, promotionID int64) (int64, error) {
-	segs, err := s.segmentRepo.ByPromotionID(ctx, promotionID)
-	if err != nil {
-		return 0, err
-	}
-	if len(segs) == 0 {
-		return 0, nil
-	}
-	return segs[0].ID, nil
-}
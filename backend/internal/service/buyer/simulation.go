@@ -0,0 +1,245 @@
+package buyer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"wildberries/internal/repository"
+)
+
+// maxSimulationPaths caps full enumeration so a wide quiz cannot blow up the admin request.
+const maxSimulationPaths = 1000
+
+var ErrInvalidSimulationChoice = errors.New("invalid simulation choice")
+
+// SimulationStep is one answered question on a simulated quiz path.
+type SimulationStep struct {
+	QuestionID   int64
+	QuestionText string
+	OptionID     int64
+	OptionText   string
+}
+
+// SimulationPath is a sequence of answers and the segment it leads to.
+// ResultSegmentID is 0 when the path stopped before a segment was resolved
+// (NextQuestionID is then the question the buyer would see next).
+// Probability is the chance of the path when every option of a question is equally likely.
+type SimulationPath struct {
+	Steps             []SimulationStep
+	ResultSegmentID   int64
+	ResultSegmentName string
+	NextQuestionID    int64
+	Loop              bool
+	Probability       float64
+}
+
+// SegmentCoverage is the number of simulated paths that end in a segment.
+type SegmentCoverage struct {
+	SegmentID int64
+	Name      string
+	Paths     int
+}
+
+type IdentificationSimulation struct {
+	Method    string
+	Paths     []*SimulationPath
+	Coverage  []*SegmentCoverage
+	Truncated bool
+}
+
+// SimulateIdentification replays the buyer quiz without publishing the promotion.
+// With optionIDs it walks the given choices in order; with no optionIDs it enumerates every path.
+func (s *Service) SimulateIdentification(ctx context.Context, promotionID int64, optionIDs []int64) (*IdentificationSimulation, error) {
+	promo, err := s.promotionRepo.GetByID(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := s.segmentRepo.ByPromotionID(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := newSegmentTargets(segments)
+
+	result := &IdentificationSimulation{Method: "questions"}
+	if promo.IdentificationMode == "user_profile" {
+		result.Method = "user_profile"
+		result.Paths = []*SimulationPath{{ResultSegmentID: targets.first(), Probability: 1}}
+		fillSimulationCoverage(result, segments)
+		return result, nil
+	}
+
+	questions, err := s.buildPollQuestions(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(questions) == 0 {
+		result.Paths = []*SimulationPath{{ResultSegmentID: targets.first(), Probability: 1}}
+		fillSimulationCoverage(result, segments)
+		return result, nil
+	}
+
+	var treeRows []*repository.PollAnswerTreeRow
+	if s.pollRepo != nil {
+		treeRows, err = s.pollRepo.AnswerTreeByPromotion(ctx, promotionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sim := &quizSimulator{
+		targets:   targets,
+		questions: questions,
+		treeRows:  treeRows,
+		indexByID: make(map[int64]int, len(questions)),
+	}
+	for i, q := range questions {
+		sim.indexByID[q.ID] = i
+	}
+
+	if len(optionIDs) > 0 {
+		path, err := sim.walk(optionIDs)
+		if err != nil {
+			return nil, err
+		}
+		result.Paths = []*SimulationPath{path}
+	} else {
+		sim.enumerate(0, nil, 1, map[int]bool{})
+		result.Paths = sim.paths
+		result.Truncated = sim.truncated
+	}
+	fillSimulationCoverage(result, segments)
+	return result, nil
+}
+
+type quizSimulator struct {
+	targets   *segmentTargets
+	questions []PollQuestion
+	treeRows  []*repository.PollAnswerTreeRow
+	indexByID map[int64]int
+	paths     []*SimulationPath
+	truncated bool
+}
+
+// next mirrors AnswerIdentification: answer tree edge first, then the in-order fallback.
+func (q *quizSimulator) next(questionIndex, optionIndex int) (int64, int64) {
+	nextQuestionID, segmentID, resolved := resolveAnswerTreeEdge(q.targets, q.treeRows, q.questions, questionIndex, optionIndex)
+	if resolved {
+		return nextQuestionID, segmentID
+	}
+	return fallbackTransition(q.targets, q.questions, questionIndex)
+}
+
+func (q *quizSimulator) walk(optionIDs []int64) (*SimulationPath, error) {
+	path := &SimulationPath{Probability: 1}
+	questionIndex := 0
+	for i, optionID := range optionIDs {
+		question := q.questions[questionIndex]
+		optionIndex := -1
+		for j, opt := range question.Options {
+			if opt.ID == optionID {
+				optionIndex = j
+				break
+			}
+		}
+		if optionIndex == -1 {
+			return nil, fmt.Errorf("option %d does not belong to question %d: %w", optionID, question.ID, ErrInvalidSimulationChoice)
+		}
+		path.Steps = append(path.Steps, newSimulationStep(question, optionIndex))
+		path.Probability /= float64(len(question.Options))
+
+		nextQuestionID, segmentID := q.next(questionIndex, optionIndex)
+		if segmentID > 0 || nextQuestionID == 0 {
+			if i < len(optionIDs)-1 {
+				return nil, fmt.Errorf("quiz finished after %d answers, got %d: %w", i+1, len(optionIDs), ErrInvalidSimulationChoice)
+			}
+			path.ResultSegmentID = segmentID
+			return path, nil
+		}
+		questionIndex = q.indexByID[nextQuestionID]
+		path.NextQuestionID = nextQuestionID
+	}
+	return path, nil
+}
+
+// enumerate walks every option of the question; probability is the chance of reaching it.
+func (q *quizSimulator) enumerate(questionIndex int, steps []SimulationStep, probability float64, visited map[int]bool) {
+	question := q.questions[questionIndex]
+	if len(question.Options) == 0 {
+		q.addPath(&SimulationPath{Steps: copySteps(steps), NextQuestionID: question.ID, Probability: probability})
+		return
+	}
+	probability /= float64(len(question.Options))
+
+	visited[questionIndex] = true
+	defer delete(visited, questionIndex)
+
+	for optionIndex := range question.Options {
+		if len(q.paths) >= maxSimulationPaths {
+			q.truncated = true
+			return
+		}
+		pathSteps := append(copySteps(steps), newSimulationStep(question, optionIndex))
+
+		nextQuestionID, segmentID := q.next(questionIndex, optionIndex)
+		if segmentID > 0 || nextQuestionID == 0 {
+			q.addPath(&SimulationPath{Steps: pathSteps, ResultSegmentID: segmentID, Probability: probability})
+			continue
+		}
+		nextIndex := q.indexByID[nextQuestionID]
+		if visited[nextIndex] {
+			q.addPath(&SimulationPath{Steps: pathSteps, NextQuestionID: nextQuestionID, Loop: true, Probability: probability})
+			continue
+		}
+		q.enumerate(nextIndex, pathSteps, probability, visited)
+	}
+}
+
+func (q *quizSimulator) addPath(path *SimulationPath) {
+	if len(q.paths) >= maxSimulationPaths {
+		q.truncated = true
+		return
+	}
+	q.paths = append(q.paths, path)
+}
+
+func newSimulationStep(question PollQuestion, optionIndex int) SimulationStep {
+	opt := question.Options[optionIndex]
+	return SimulationStep{
+		QuestionID:   question.ID,
+		QuestionText: question.Text,
+		OptionID:     opt.ID,
+		OptionText:   opt.Text,
+	}
+}
+
+func copySteps(steps []SimulationStep) []SimulationStep {
+	out := make([]SimulationStep, len(steps), len(steps)+1)
+	copy(out, steps)
+	return out
+}
+
+// fillSimulationCoverage names result segments and counts paths per segment,
+// keeping segments with zero paths so uncovered ones are visible to the admin.
+func fillSimulationCoverage(sim *IdentificationSimulation, segments []*repository.SegmentRow) {
+	counts := make(map[int64]int, len(segments))
+	for _, path := range sim.Paths {
+		if path.ResultSegmentID > 0 {
+			counts[path.ResultSegmentID]++
+		}
+	}
+	names := make(map[int64]string, len(segments))
+	sim.Coverage = make([]*SegmentCoverage, 0, len(segments))
+	for _, seg := range segments {
+		names[seg.ID] = seg.Name
+		sim.Coverage = append(sim.Coverage, &SegmentCoverage{
+			SegmentID: seg.ID,
+			Name:      seg.Name,
+			Paths:     counts[seg.ID],
+		})
+	}
+	for _, path := range sim.Paths {
+		path.ResultSegmentName = names[path.ResultSegmentID]
+	}
+}
@@ -0,0 +1,83 @@
+package buyer
+
+import (
+	"testing"
+
+	"wildberries/internal/repository"
+)
+
+func TestSegmentTargetsResolve(t *testing.T) {
+	targets := newSegmentTargets([]*repository.SegmentRow{
+		{ID: 101, Name: "Овен"},
+		{ID: 102, Name: "Телец"},
+		{ID: 103, Name: " Близнецы "},
+	})
+	tests := []struct {
+		target string
+		want   int64
+		found  bool
+	}{
+		{target: "102", want: 102, found: true},
+		{target: "1", want: 101, found: true},
+		{target: "3", want: 103, found: true},
+		{target: "0", want: 101, found: true},
+		{target: "телец", want: 102, found: true},
+		{target: "Близнецы", want: 103, found: true},
+		{target: "Рак", found: false},
+		{target: "7", found: false},
+	}
+	for _, tt := range tests {
+		got, found := targets.resolve(tt.target)
+		if got != tt.want || found != tt.found {
+			t.Errorf("resolve(%q) = %d, %v; want %d, %v", tt.target, got, found, tt.want, tt.found)
+		}
+	}
+	if first := targets.first(); first != 101 {
+		t.Errorf("first() = %d, want 101", first)
+	}
+	if first := newSegmentTargets(nil).first(); first != 0 {
+		t.Errorf("first() without segments = %d, want 0", first)
+	}
+}
+
+func TestQuizSimulatorEnumerate(t *testing.T) {
+	questions := []PollQuestion{
+		{ID: 1, Options: []PollOption{{ID: 11}, {ID: 12}}},
+		{ID: 2, Options: []PollOption{{ID: 21}, {ID: 22}}},
+	}
+	segments := []*repository.SegmentRow{{ID: 101, Name: "A"}, {ID: 102, Name: "B"}}
+	tree := []*repository.PollAnswerTreeRow{
+		{Label: "edge:q0:o0", Value: "segment:A"},
+		{Label: "edge:q0:o1", Value: "question:1"},
+		{Label: "edge:q1:o0", Value: "segment:segment-2"},
+		{Label: "edge:q1:o1", Value: "question:0"},
+	}
+	sim := &quizSimulator{
+		targets:   newSegmentTargets(segments),
+		questions: questions,
+		treeRows:  tree,
+		indexByID: map[int64]int{1: 0, 2: 1},
+	}
+	sim.enumerate(0, nil, 1, map[int]bool{})
+
+	// q1:o0 has an unknown target and falls back to the first segment; q1:o1 loops back
+	want := []struct {
+		steps       int
+		segment     int64
+		loop        bool
+		probability float64
+	}{
+		{steps: 1, segment: 101, probability: 0.5},
+		{steps: 2, segment: 101, probability: 0.25},
+		{steps: 2, loop: true, probability: 0.25},
+	}
+	if len(sim.paths) != len(want) {
+		t.Fatalf("got %d paths, want %d", len(sim.paths), len(want))
+	}
+	for i, w := range want {
+		p := sim.paths[i]
+		if len(p.Steps) != w.steps || p.ResultSegmentID != w.segment || p.Loop != w.loop || p.Probability != w.probability {
+			t.Errorf("path %d = %d steps, segment %d, loop %v, probability %v; want %+v", i, len(p.Steps), p.ResultSegmentID, p.Loop, p.Probability, w)
+		}
+	}
+}
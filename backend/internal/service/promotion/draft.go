@@ -0,0 +1,76 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+// CreatePromotionDraft stores a generated draft as a NOT_READY promotion with its segments,
+// poll and answer tree in one transaction. Returns the promotion id and segment ids in draft order.
+func (s *Service) CreatePromotionDraft(ctx context.Context, p *entity.Promotion, draft *entity.PromotionDraft) (int64, []int64, error) {
+	input := &repository.PromotionDraftInput{
+		Promotion: newPromotionRow(p),
+		Segments:  make([]*repository.SegmentRow, 0, len(draft.Segments)),
+		Questions: make([]repository.PollQuestionInput, 0, len(draft.Questions)),
+	}
+	for i, seg := range draft.Segments {
+		input.Segments = append(input.Segments, &repository.SegmentRow{
+			Name:         seg.Name,
+			CategoryName: strPtr(seg.CategoryName),
+			OrderIndex:   i,
+		})
+	}
+	for _, q := range draft.Questions {
+		item := repository.PollQuestionInput{Text: q.Text}
+		for _, opt := range q.Options {
+			item.Options = append(item.Options, struct{ Text, Value string }{Text: opt.Text, Value: opt.Value})
+		}
+		input.Questions = append(input.Questions, item)
+	}
+	tree, err := draftAnswerTree(draft)
+	if err != nil {
+		return 0, nil, err
+	}
+	input.AnswerTree = tree
+
+	return s.promotionRepo.CreateDraft(ctx, input)
+}
+
+// draftAnswerTree converts the generated tree to the stored format the admin UI also saves:
+// UUID node ids and segment targets by name instead of "segment-<n>" placeholders.
+// Names are resolved case-insensitively and numbers as ids or positions, so ambiguous
+// names are rejected with entity.ErrAmbiguousSegmentName.
+func draftAnswerTree(draft *entity.PromotionDraft) ([]repository.PollAnswerTreeInput, error) {
+	if err := entity.CheckSegmentNames(draft.Segments); err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(draft.AnswerTree))
+	for _, n := range draft.AnswerTree {
+		ids[n.NodeID] = uuid.NewString()
+	}
+	nodes := make([]repository.PollAnswerTreeInput, 0, len(draft.AnswerTree))
+	for _, n := range draft.AnswerTree {
+		value := n.Value
+		if target, ok := strings.CutPrefix(value, "segment:segment-"); ok {
+			idx, err := strconv.Atoi(target)
+			if err != nil || idx < 1 || idx > len(draft.Segments) {
+				return nil, fmt.Errorf("answer tree node %s targets unknown segment %q", n.NodeID, target)
+			}
+			value = "segment:" + draft.Segments[idx-1].Name
+		}
+		nodes = append(nodes, repository.PollAnswerTreeInput{
+			NodeID:       ids[n.NodeID],
+			ParentNodeID: ids[n.ParentNodeID],
+			Label:        n.Label,
+			Value:        value,
+		})
+	}
+	return nodes, nil
+}
@@ -0,0 +1,56 @@
+package promotion
+
+import (
+	"errors"
+	"testing"
+
+	"wildberries/internal/entity"
+)
+
+func TestDraftAnswerTree(t *testing.T) {
+	tree := []*entity.AnswerTreeNode{
+		{NodeID: "q0", Label: "edge:q0:o0", Value: "segment:segment-2"},
+		{NodeID: "q1", ParentNodeID: "q0", Label: "edge:q0:o1", Value: "question:1"},
+	}
+	tests := []struct {
+		name     string
+		segments []string
+		tree     []*entity.AnswerTreeNode
+		want     []string // values of the stored nodes
+		wantErr  error
+	}{
+		{name: "targets by name", segments: []string{"Овен", "Телец"}, tree: tree, want: []string{"segment:Телец", "question:1"}},
+		{name: "duplicate names", segments: []string{"Овен", " овен"}, tree: tree, wantErr: entity.ErrAmbiguousSegmentName},
+		{name: "numeric name", segments: []string{"Овен", "7"}, tree: tree, wantErr: entity.ErrAmbiguousSegmentName},
+		{name: "unknown segment", segments: []string{"Овен"}, tree: tree},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draft := &entity.PromotionDraft{AnswerTree: tt.tree}
+			for _, name := range tt.segments {
+				draft.Segments = append(draft.Segments, &entity.SegmentSuggestion{Name: name})
+			}
+			nodes, err := draftAnswerTree(draft)
+			if tt.want == nil {
+				if err == nil {
+					t.Fatal("want error")
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, want := range tt.want {
+				if nodes[i].Value != want {
+					t.Errorf("node %d value = %q, want %q", i, nodes[i].Value, want)
+				}
+			}
+			if nodes[1].ParentNodeID != nodes[0].NodeID || nodes[0].NodeID == "q0" {
+				t.Errorf("node ids not remapped: %+v", nodes)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package promotion
+
+import (
+	"context"
+	"time"
+
+	"wildberries/internal/entity"
+	"wildberries/internal/repository"
+)
+
+// ListSegmentTexts returns the text history of a segment, newest first
+func (s *Service) ListSegmentTexts(ctx context.Context, promotionID, segmentID int64) ([]*entity.SegmentText, error) {
+	if _, err := s.segmentRepo.GetByPromoAndSegment(ctx, promotionID, segmentID); err != nil {
+		return nil, err
+	}
+	rows, err := s.segmentRepo.TextVersions(ctx, segmentID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*entity.SegmentText, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, segmentTextFromRow(row))
+	}
+	return out, nil
+}
+
+// RevertSegmentText makes an earlier version current again. The history is append-only:
+// the reverted text is stored as a new version.
+func (s *Service) RevertSegmentText(ctx context.Context, promotionID, segmentID int64, version int) (*entity.SegmentText, error) {
+	if _, err := s.segmentRepo.GetByPromoAndSegment(ctx, promotionID, segmentID); err != nil {
+		return nil, err
+	}
+	prev, err := s.segmentRepo.GetTextVersion(ctx, segmentID, version)
+	if err != nil {
+		return nil, err
+	}
+	newVersion, err := s.segmentRepo.UpdateText(ctx, segmentID, prev.Text, entity.SegmentTextSourceRevert)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.SegmentText{
+		SegmentID: segmentID,
+		Version:   newVersion,
+		Text:      prev.Text,
+		Source:    entity.SegmentTextSourceRevert,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func segmentTextFromRow(row *repository.SegmentTextVersionRow) *entity.SegmentText {
+	return &entity.SegmentText{
+		SegmentID: row.SegmentID,
+		Version:   row.Version,
+		Text:      row.Text,
+		Source:    row.Source,
+		CreatedAt: row.CreatedAt,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"wildberries/internal/cache"
 	"wildberries/internal/entity"
 	"wildberries/internal/repository"
 
@@ -24,6 +25,7 @@ type Service struct {
 	auctionRepo    repository.AuctionRepository
 	betRepo        repository.BetRepository
 	pollRepo       repository.PollRepository
+	cache          cache.Cache
 }
 
 var ErrSlotSegmentMismatch = errors.New("slot does not belong to segment")
@@ -62,7 +64,11 @@ func New(
 	auctionRepo repository.AuctionRepository,
 	betRepo repository.BetRepository,
 	pollRepo repository.PollRepository,
+	c cache.Cache,
 ) *Service {
+	if c == nil {
+		c = cache.Noop{}
+	}
 	return &Service{
 		promotionRepo:  promotionRepo,
 		segmentRepo:    segmentRepo,
@@ -72,6 +78,7 @@ func New(
 		auctionRepo:    auctionRepo,
 		betRepo:        betRepo,
 		pollRepo:       pollRepo,
+		cache:          c,
 	}
 }
 
@@ -94,6 +101,9 @@ func rowToPromotion(row *repository.PromotionRow) (*entity.Promotion, error) {
 		MaxDiscount:        row.MaxDiscount,
 		MinPrice:           row.MinPrice,
 		BidStep:            row.BidStep,
+		Priority:           row.Priority,
+		TargetRegions:      row.TargetRegions,
+		TargetPlatforms:    row.TargetPlatforms,
 	}
 	if len(row.StopFactors) > 0 {
 		var factors []string
@@ -194,6 +204,7 @@ func (s *Service) ResetAuctionState(ctx context.Context, promotionID int64) erro
 		}
 	}
 
+	cache.InvalidatePromotion(ctx, s.cache, promotionID)
 	return nil
 }
 
@@ -206,7 +217,12 @@ func ptrStr(s *string) string {
 
 // CreatePromotion creates a new promotion
 func (s *Service) CreatePromotion(ctx context.Context, p *entity.Promotion) (int64, error) {
-	row := &repository.PromotionRow{
+	return s.promotionRepo.Create(ctx, newPromotionRow(p))
+}
+
+// newPromotionRow maps a promotion being created; it always starts as NOT_READY.
+func newPromotionRow(p *entity.Promotion) *repository.PromotionRow {
+	return &repository.PromotionRow{
 		Name:               p.Name,
 		Description:        p.Description,
 		Theme:              p.Theme,
@@ -222,8 +238,10 @@ func (s *Service) CreatePromotion(ctx context.Context, p *entity.Promotion) (int
 		BidStep:            p.BidStep,
 		StopFactors:        mustJSON(p.StopFactors),
 		FixedPrices:        mustJSON(p.FixedPrices),
+		Priority:           p.Priority,
+		TargetRegions:      p.TargetRegions,
+		TargetPlatforms:    p.TargetPlatforms,
 	}
-	return s.promotionRepo.Create(ctx, row)
 }
 
 func mustJSON(v interface{}) []byte {
@@ -253,18 +271,33 @@ func (s *Service) UpdatePromotion(ctx context.Context, p *entity.Promotion) erro
 		BidStep:            p.BidStep,
 		StopFactors:        mustJSON(p.StopFactors),
 		FixedPrices:        mustJSON(p.FixedPrices),
+		Priority:           p.Priority,
+		TargetRegions:      p.TargetRegions,
+		TargetPlatforms:    p.TargetPlatforms,
+	}
+	if err := s.promotionRepo.Update(ctx, row); err != nil {
+		return err
 	}
-	return s.promotionRepo.Update(ctx, row)
+	cache.InvalidatePromotion(ctx, s.cache, p.ID)
+	return nil
 }
 
 // DeletePromotion soft-deletes a promotion
 func (s *Service) DeletePromotion(ctx context.Context, id int64) error {
-	return s.promotionRepo.SoftDelete(ctx, id)
+	if err := s.promotionRepo.SoftDelete(ctx, id); err != nil {
+		return err
+	}
+	cache.InvalidatePromotion(ctx, s.cache, id)
+	return nil
 }
 
 // SetFixedPrices sets fixed prices for positions 1..slot_count
 func (s *Service) SetFixedPrices(ctx context.Context, promotionID int64, prices map[int32]int64) error {
-	return s.promotionRepo.SetFixedPrices(ctx, promotionID, mustJSON(prices))
+	if err := s.promotionRepo.SetFixedPrices(ctx, promotionID, mustJSON(prices)); err != nil {
+		return err
+	}
+	cache.InvalidatePromotion(ctx, s.cache, promotionID)
+	return nil
 }
 
 // SetAuctionParams sets auction parameters (min_price, bid_step) for a promotion
@@ -273,6 +306,7 @@ func (s *Service) SetAuctionParams(ctx context.Context, promotionID int64, minPr
 	if err != nil {
 		return err
 	}
+	cache.InvalidatePromotion(ctx, s.cache, promotionID)
 	// Update auction table if it exists
 	_, _, _, _, _, err = s.auctionRepo.GetByPromotionID(ctx, promotionID)
 	if err != nil {
@@ -301,6 +335,14 @@ func newChangeStatusConflictError(msg string) error {
 
 // ChangeStatus validates status transitions and materializes slots/auction when going READY_TO_START.
 func (s *Service) ChangeStatus(ctx context.Context, promotionID int64, status entity.PromotionStatus) error {
+	if err := s.changeStatus(ctx, promotionID, status); err != nil {
+		return err
+	}
+	cache.InvalidatePromotion(ctx, s.cache, promotionID)
+	return nil
+}
+
+func (s *Service) changeStatus(ctx context.Context, promotionID int64, status entity.PromotionStatus) error {
 	promo, err := s.GetPromotion(ctx, promotionID)
 	if err != nil {
 		return err
@@ -533,7 +575,11 @@ func (s *Service) SetSlotProduct(ctx context.Context, segmentID, slotID, product
 	}
 
 	var sid *int64 // nil = WB curation
-	return s.slotRepo.SetProduct(ctx, slotID, sid, productID, "occupied")
+	if err := s.slotRepo.SetProduct(ctx, slotID, sid, productID, "occupied"); err != nil {
+		return err
+	}
+	cache.InvalidatePromotion(ctx, s.cache, slot.PromotionID)
+	return nil
 }
 
 func (s *Service) ensureSlotsForPromotion(ctx context.Context, promo *entity.Promotion) error {
@@ -591,7 +637,12 @@ func (s *Service) CreateSegment(ctx context.Context, promotionID int64, name, ca
 		CategoryName: strPtr(categoryName),
 		OrderIndex:   int(orderIndex),
 	}
-	return s.segmentRepo.Create(ctx, row)
+	id, err := s.segmentRepo.Create(ctx, row)
+	if err != nil {
+		return 0, err
+	}
+	cache.InvalidatePromotion(ctx, s.cache, promotionID)
+	return id, nil
 }
 
 func strPtr(s string) *string {
@@ -616,17 +667,36 @@ func (s *Service) UpdateSegment(ctx context.Context, promotionID, segmentID int6
 	if orderIndex != nil {
 		seg.OrderIndex = int(*orderIndex)
 	}
-	return s.segmentRepo.Update(ctx, seg)
+	if err := s.segmentRepo.Update(ctx, seg); err != nil {
+		return err
+	}
+	cache.InvalidatePromotion(ctx, s.cache, promotionID)
+	return nil
 }
 
-// DeleteSegment deletes a segment
+// DeleteSegment deletes a segment; deleting a missing segment is a no-op
 func (s *Service) DeleteSegment(ctx context.Context, segmentID int64) error {
-	return s.segmentRepo.Delete(ctx, segmentID)
+	seg, err := s.segmentRepo.GetByID(ctx, segmentID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil // already deleted
+	}
+	if err != nil {
+		return err
+	}
+	if err := s.segmentRepo.Delete(ctx, segmentID); err != nil {
+		return err
+	}
+	cache.InvalidatePromotion(ctx, s.cache, seg.PromotionID)
+	return nil
 }
 
 // ShuffleSegmentCategories shuffles category names across segments
 func (s *Service) ShuffleSegmentCategories(ctx context.Context, promotionID int64) error {
-	return s.segmentRepo.ShuffleCategories(ctx, promotionID)
+	if err := s.segmentRepo.ShuffleCategories(ctx, promotionID); err != nil {
+		return err
+	}
+	cache.InvalidatePromotion(ctx, s.cache, promotionID)
+	return nil
 }
 
 // GetModerationApplications returns applications for moderation
@@ -657,13 +727,25 @@ func (s *Service) GetModerationApplications(ctx context.Context, promotionID int
 
 // ApproveModeration approves an application and sets slot to occupied
 func (s *Service) ApproveModeration(ctx context.Context, applicationID int64, moderatorID *int64) error {
-	return s.moderationRepo.ResolveApplication(ctx, applicationID, "approved", moderatorID)
+	return s.resolveApplication(ctx, applicationID, "approved", moderatorID)
 }
 
 // RejectModeration rejects an application and frees the slot
 func (s *Service) RejectModeration(ctx context.Context, applicationID int64, reason string, moderatorID *int64) error {
 	_ = reason // reason is accepted by API but not persisted in MVP schema
-	return s.moderationRepo.ResolveApplication(ctx, applicationID, "rejected", moderatorID)
+	return s.resolveApplication(ctx, applicationID, "rejected", moderatorID)
+}
+
+func (s *Service) resolveApplication(ctx context.Context, applicationID int64, status string, moderatorID *int64) error {
+	app, err := s.moderationRepo.GetByID(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+	if err := s.moderationRepo.ResolveApplication(ctx, applicationID, status, moderatorID); err != nil {
+		return err
+	}
+	cache.InvalidatePromotion(ctx, s.cache, app.PromotionID)
+	return nil
 }
 
 type PromotionPoll struct {
@@ -0,0 +1,205 @@
+package seller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"wildberries/internal/repository"
+)
+
+var (
+	ErrNotAuction      = errors.New("promotion is not an auction")
+	ErrInvalidPosition = errors.New("position is out of range")
+)
+
+// Источник исторических цен закрытия
+const (
+	HistorySourceCategory = "category"
+	HistorySourceMarket   = "market"
+	HistorySourceNone     = "none"
+)
+
+// BidRecommendation — оценка ставки, нужной для позиции в аукционе сегмента
+type BidRecommendation struct {
+	PromotionID       int64
+	SegmentID         int64
+	Position          int
+	RecommendedBid    int64
+	MinAllowedBid     int64
+	PositionBid       int64 // ставка, которая сейчас держит позицию
+	BidStep           int64
+	CompetingOffers   int
+	HistoricalMedian  int64
+	HistoricalMax     int64
+	HistoricalSamples int64
+	HistorySource     string
+	TimeLeft          string
+	TimeLeftShare     float64 // доля оставшегося времени аукциона, 0..1
+	AuctionFinished   bool
+}
+
+// RecommendBid estimates the bid needed to hold the given position (1-based) when the auction closes.
+// The current bid for the position is pulled towards the historical clearing price
+// in proportion to the time left: early in the auction history dominates.
+func (s *Service) RecommendBid(ctx context.Context, promotionID, segmentID int64, position int) (*BidRecommendation, error) {
+	if position <= 0 {
+		position = 1
+	}
+	seg, err := s.segmentRepo.GetByPromoAndSegment(ctx, promotionID, segmentID)
+	if err != nil {
+		return nil, err
+	}
+	pricingType, err := s.getPromotionPricingType(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	if pricingType != "auction" {
+		return nil, ErrNotAuction
+	}
+	auctionID, minPrice, bidStep, dateFrom, dateTo, err := s.auctionRepo.GetByPromotionID(ctx, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	if auctionID == 0 {
+		return nil, ErrNotAuction
+	}
+
+	slots, err := s.slotRepo.BySegmentID(ctx, segmentID, false)
+	if err != nil {
+		return nil, err
+	}
+	auctionSlots := 0
+	for _, slot := range slots {
+		if strings.ToLower(slot.PricingType) == "auction" {
+			auctionSlots++
+		}
+	}
+	if position > auctionSlots {
+		return nil, fmt.Errorf("%w: segment has %d auction slots", ErrInvalidPosition, auctionSlots)
+	}
+
+	rec := &BidRecommendation{
+		PromotionID:   promotionID,
+		SegmentID:     segmentID,
+		Position:      position,
+		BidStep:       bidStep,
+		TimeLeft:      formatTimeLeft(dateTo),
+		HistorySource: HistorySourceNone,
+	}
+	if auctionEnded(dateTo) {
+		rec.AuctionFinished = true
+		return rec, nil
+	}
+
+	activeBets, err := s.listActiveBetsBySegment(ctx, promotionID, segmentID, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	offers := bestOffers(activeBets)
+	rec.CompetingOffers = len(offers)
+	var topBid int64
+	if len(offers) > 0 {
+		topBid = offers[0].Bet
+	}
+	if len(offers) >= position {
+		rec.PositionBid = offers[position-1].Bet
+	}
+	rec.MinAllowedBid = nextAuctionBidMin(minPrice, bidStep, topBid)
+
+	category := ""
+	if seg.CategoryName != nil {
+		category = strings.TrimSpace(*seg.CategoryName)
+	}
+	history, source, err := s.clearingPrices(ctx, position, category, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	rec.HistorySource = source
+	rec.HistoricalSamples = history.Samples
+	rec.HistoricalMedian = history.Median
+	rec.HistoricalMax = history.Max
+	rec.TimeLeftShare = auctionTimeLeftShare(dateFrom, dateTo, time.Now())
+
+	rec.RecommendedBid = recommendedBid(minPrice, bidStep, rec.PositionBid, topBid, history, rec.TimeLeftShare)
+	return rec, nil
+}
+
+// recommendedBid outbids whoever holds the position now and moves the estimate towards the
+// historical median by the share of auction time left; never below the next allowed bid.
+func recommendedBid(minPrice, bidStep, positionBid, topBid int64, history *repository.ClearingPriceRow, timeLeftShare float64) int64 {
+	// чтобы удержать позицию, нужно перебить того, кто её сейчас держит
+	estimate := nextAuctionBidMin(minPrice, bidStep, positionBid)
+	if history.Samples > 0 && history.Median > estimate {
+		estimate += int64(math.Round(timeLeftShare * float64(history.Median-estimate)))
+	}
+	estimate = max(estimate, nextAuctionBidMin(minPrice, bidStep, topBid))
+	stepBase := minPrice
+	if topBid > 0 {
+		stepBase = topBid
+	}
+	return roundUpToBidStep(estimate, stepBase, bidStep)
+}
+
+// clearingPrices prefers segments of the same category and falls back to the whole market.
+func (s *Service) clearingPrices(ctx context.Context, position int, category string, promotionID int64) (*repository.ClearingPriceRow, string, error) {
+	if category != "" {
+		row, err := s.auctionRepo.ClearingPrices(ctx, position, category, promotionID)
+		if err != nil {
+			return nil, "", err
+		}
+		if row.Samples > 0 {
+			return row, HistorySourceCategory, nil
+		}
+	}
+	row, err := s.auctionRepo.ClearingPrices(ctx, position, "", promotionID)
+	if err != nil {
+		return nil, "", err
+	}
+	if row.Samples > 0 {
+		return row, HistorySourceMarket, nil
+	}
+	return row, HistorySourceNone, nil
+}
+
+// bestOffers keeps the highest bid per seller:product, the same way the auction is finalized,
+// ordered by bid; among equal bids the earlier one in bets goes first.
+func bestOffers(bets []*repository.BetRow) []*repository.BetRow {
+	sorted := slices.Clone(bets)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Bet > sorted[j].Bet })
+	out := make([]*repository.BetRow, 0, len(sorted))
+	seen := make(map[string]struct{}, len(sorted))
+	for _, bet := range sorted {
+		key := fmt.Sprintf("%d:%d", bet.SellerID, bet.ProductID)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, bet)
+	}
+	return out
+}
+
+func auctionTimeLeftShare(dateFrom, dateTo string, now time.Time) float64 {
+	from, fromErr := parseAuctionTime(dateFrom)
+	to, toErr := parseAuctionTime(dateTo)
+	if fromErr != nil || toErr != nil || !to.After(from) {
+		return 0
+	}
+	share := float64(to.Sub(now)) / float64(to.Sub(from))
+	return math.Max(0, math.Min(1, share))
+}
+
+// roundUpToBidStep aligns amount to the grid MakeBet accepts: base + k*step.
+func roundUpToBidStep(amount, base, step int64) int64 {
+	if step <= 0 || amount <= base {
+		return amount
+	}
+	k := (amount - base + step - 1) / step
+	return base + k*step
+}
@@ -0,0 +1,104 @@
+package seller
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"wildberries/internal/repository"
+)
+
+func TestRoundUpToBidStep(t *testing.T) {
+	tests := []struct {
+		amount, base, step, want int64
+	}{
+		{amount: 1000, base: 1000, step: 100, want: 1000},
+		{amount: 1001, base: 1000, step: 100, want: 1100},
+		{amount: 1100, base: 1000, step: 100, want: 1100},
+		{amount: 1250, base: 1050, step: 100, want: 1250},
+		{amount: 1251, base: 1050, step: 100, want: 1350},
+		{amount: 900, base: 1000, step: 100, want: 900},
+		{amount: 1234, base: 1000, step: 0, want: 1234},
+	}
+	for _, tt := range tests {
+		if got := roundUpToBidStep(tt.amount, tt.base, tt.step); got != tt.want {
+			t.Errorf("roundUpToBidStep(%d, %d, %d) = %d, want %d", tt.amount, tt.base, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestAuctionTimeLeftShare(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(10 * time.Hour)
+	tests := []struct {
+		name     string
+		from, to string
+		now      time.Time
+		want     float64
+	}{
+		{name: "start", from: from.Format(time.RFC3339), to: to.Format(time.RFC3339), now: from, want: 1},
+		{name: "quarter done", from: from.Format(time.RFC3339), to: to.Format(time.RFC3339), now: from.Add(150 * time.Minute), want: 0.75},
+		{name: "postgres text", from: "2026-10-01 00:00:00+00", to: "2026-10-01 10:00:00+00", now: from.Add(5 * time.Hour), want: 0.5},
+		{name: "before start", from: from.Format(time.RFC3339), to: to.Format(time.RFC3339), now: from.Add(-time.Hour), want: 1},
+		{name: "finished", from: from.Format(time.RFC3339), to: to.Format(time.RFC3339), now: to.Add(time.Hour), want: 0},
+		{name: "empty period", from: to.Format(time.RFC3339), to: from.Format(time.RFC3339), now: from, want: 0},
+		{name: "bad date", from: "yesterday", to: to.Format(time.RFC3339), now: from, want: 0},
+	}
+	for _, tt := range tests {
+		if got := auctionTimeLeftShare(tt.from, tt.to, tt.now); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: share = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRecommendedBid(t *testing.T) {
+	noHistory := &repository.ClearingPriceRow{}
+	history := &repository.ClearingPriceRow{Samples: 5, Median: 2000, Max: 3000}
+	tests := []struct {
+		name        string
+		positionBid int64
+		topBid      int64
+		history     *repository.ClearingPriceRow
+		share       float64
+		want        int64
+	}{
+		{name: "no bids, no history", history: noHistory, share: 1, want: 1000},
+		{name: "outbid the position", positionBid: 1200, topBid: 1500, history: noHistory, share: 1, want: 1600},
+		{name: "early auction follows history", positionBid: 1200, topBid: 1200, history: history, share: 1, want: 2000},
+		// 1300 + 0.5*(2000-1300) = 1650, aligned to 1200 + k*100
+		{name: "half way blends", positionBid: 1200, topBid: 1200, history: history, share: 0.5, want: 1700},
+		{name: "auction end ignores history", positionBid: 1200, topBid: 1200, history: history, share: 0, want: 1300},
+		{name: "history below current bids", positionBid: 2500, topBid: 2500, history: history, share: 1, want: 2600},
+		{name: "free position still above top bid", topBid: 1500, history: noHistory, share: 1, want: 1600},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := recommendedBid(1000, 100, tt.positionBid, tt.topBid, tt.history, tt.share); got != tt.want {
+				t.Errorf("recommendedBid = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBestOffers(t *testing.T) {
+	bets := []*repository.BetRow{
+		{ID: 1, SellerID: 1, ProductID: 10, Bet: 100},
+		{ID: 2, SellerID: 2, ProductID: 20, Bet: 300},
+		{ID: 3, SellerID: 1, ProductID: 10, Bet: 250},
+		{ID: 4, SellerID: 1, ProductID: 11, Bet: 300},
+		{ID: 5, SellerID: 2, ProductID: 20, Bet: 200},
+	}
+	got := bestOffers(bets)
+	wantIDs := []int64{2, 4, 3}
+	if len(got) != len(wantIDs) {
+		t.Fatalf("got %d offers, want %d", len(got), len(wantIDs))
+	}
+	for i, id := range wantIDs {
+		if got[i].ID != id {
+			t.Errorf("offer %d = bet %d, want %d", i, got[i].ID, id)
+		}
+	}
+	if bets[0].ID != 1 {
+		t.Error("input order changed")
+	}
+}
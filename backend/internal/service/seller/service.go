@@ -25,6 +25,13 @@ type Service struct {
 	promotionRepo  repository.PromotionRepository
 	moderationRepo repository.ModerationRepository
 	viewCountRepo  repository.PromotionViewCountRepository
+	statsRepo      repository.SellerStatisticsRepository
+
+	populationEstimator populationEstimator
+}
+
+type populationEstimator interface {
+	EstimateSegmentPopulation(ctx context.Context, promotionID int64) (map[int64]*entity.SegmentPopulation, error)
 }
 
 // New creates a new seller service
@@ -37,6 +44,8 @@ func New(
 	promotionRepo repository.PromotionRepository,
 	moderationRepo repository.ModerationRepository,
 	viewCountRepo repository.PromotionViewCountRepository,
+	statsRepo repository.SellerStatisticsRepository,
+	populationEstimator populationEstimator,
 ) *Service {
 	return &Service{
 		productRepo:    productRepo,
@@ -47,6 +56,9 @@ func New(
 		promotionRepo:  promotionRepo,
 		moderationRepo: moderationRepo,
 		viewCountRepo:  viewCountRepo,
+		statsRepo:      statsRepo,
+
+		populationEstimator: populationEstimator,
 	}
 }
 
@@ -110,12 +122,14 @@ func (s *Service) GetSellerActions(ctx context.Context, sellerID int64) ([]*enti
 }
 
 type ActionSegmentSummary struct {
-	ID          int64
-	Name        string
-	Category    string
-	Population  int64
-	BookedSlots int64
-	TotalSlots  int64
+	ID               int64
+	Name             string
+	Category         string
+	Population       int64
+	PopulationShare  float64
+	PopulationSource string
+	BookedSlots      int64
+	TotalSlots       int64
 }
 
 func (s *Service) GetActionSegments(ctx context.Context, actionID int64) ([]*ActionSegmentSummary, error) {
@@ -147,6 +161,13 @@ func (s *Service) GetActionSegments(ctx context.Context, actionID int64) ([]*Act
 			a.booked++
 		}
 	}
+	var populations map[int64]*entity.SegmentPopulation
+	if s.populationEstimator != nil {
+		populations, err = s.populationEstimator.EstimateSegmentPopulation(ctx, actionID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	out := make([]*ActionSegmentSummary, 0, len(segs))
 	for _, seg := range segs {
 		a := aggBySeg[seg.ID]
@@ -157,14 +178,19 @@ func (s *Service) GetActionSegments(ctx context.Context, actionID int64) ([]*Act
 		if seg.CategoryName != nil {
 			category = *seg.CategoryName
 		}
-		out = append(out, &ActionSegmentSummary{
+		summary := &ActionSegmentSummary{
 			ID:          seg.ID,
 			Name:        seg.Name,
 			Category:    category,
-			Population:  0,
 			BookedSlots: a.booked,
 			TotalSlots:  a.total,
-		})
+		}
+		if p := populations[seg.ID]; p != nil {
+			summary.Population = p.Population
+			summary.PopulationShare = p.Share
+			summary.PopulationSource = p.Source
+		}
+		out = append(out, summary)
 	}
 	return out, nil
 }
@@ -758,50 +784,96 @@ func nextAuctionBidMin(minPrice, bidStep, currentBid int64) int64 {
 	return currentBid
 }
 
-// GetSellerStatistics returns statistics for seller dashboard
-func (s *Service) GetSellerStatistics(ctx context.Context) (*entity.SellerStatistics, error) {
-	promotions, err := s.promotionRepo.ListAll(ctx)
+// GetSellerStatistics returns statistics for seller dashboard.
+// Market-wide counters are filled always, seller-scoped ones only for sellerID != 0.
+func (s *Service) GetSellerStatistics(ctx context.Context, sellerID int64) (*entity.SellerStatistics, error) {
+	market, err := s.statsRepo.MarketTotals(ctx)
 	if err != nil {
 		return nil, err
 	}
-
-	// Count active promotions (RUNNING or READY_TO_START)
-	var activePromotions int64
-	for _, p := range promotions {
-		if p.Status == "RUNNING" || p.Status == "READY_TO_START" {
-			activePromotions++
-		}
+	stats := &entity.SellerStatistics{
+		ActivePromotions: market.ActivePromotions,
+		FreeSlots:        market.FreeSlots,
+		OccupiedSlots:    market.OccupiedSlots,
+		TotalViews:       market.TotalViews,
+	}
+	if sellerID == 0 {
+		return stats, nil
 	}
 
-	// Count all slots across all promotions
-	var freeSlots, occupiedSlots int64
+	rows, err := s.statsRepo.BySeller(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	stats.Promotions = make([]*entity.SellerPromotionStatistics, 0, len(rows))
+	var participated int64
+	for _, row := range rows {
+		stats.WonSlots += row.WonSlots
+		stats.ModerationSlots += row.ModerationSlots
+		stats.CommittedAmount += row.CommittedAmount
+		stats.ActiveBets += row.ActiveBets
+		stats.ActiveBetsAmount += row.ActiveBetsAmount
+		stats.ProductImpressions += row.Impressions
+		stats.ProductClicks += row.Clicks
+		participated += row.ParticipatedSlots
+		stats.Promotions = append(stats.Promotions, &entity.SellerPromotionStatistics{
+			PromotionID:        row.PromotionID,
+			PromotionName:      row.PromotionName,
+			PromotionStatus:    row.PromotionStatus,
+			WonSlots:           row.WonSlots,
+			ModerationSlots:    row.ModerationSlots,
+			CommittedAmount:    row.CommittedAmount,
+			ActiveBets:         row.ActiveBets,
+			ActiveBetsAmount:   row.ActiveBetsAmount,
+			WinRate:            winRate(row.WonSlots, row.ParticipatedSlots),
+			ProductImpressions: row.Impressions,
+			ProductClicks:      row.Clicks,
+		})
+	}
+	stats.WinRate = winRate(stats.WonSlots, participated)
+	return stats, nil
+}
 
-	for _, p := range promotions {
-		slots, err := s.slotRepo.ByPromotionID(ctx, p.ID)
-		if err != nil {
-			continue
-		}
-		for _, slot := range slots {
-			if slot.Status == "available" {
-				freeSlots++
-			} else if slot.Status == "occupied" {
-				occupiedSlots++
-			}
-		}
+// winRate is the share of slots the seller competed for (bids or applications) that they hold.
+func winRate(won, participated int64) float64 {
+	if participated == 0 {
+		return 0
 	}
+	return float64(won) / float64(participated)
+}
 
-	// Total views
-	totalViews, err := s.viewCountRepo.GetTotalViews(ctx)
+// GetSlotPerformance returns impressions, clicks, CTR and cost per click for slots the seller occupies.
+// promotionID = 0 covers all promotions.
+func (s *Service) GetSlotPerformance(ctx context.Context, sellerID, promotionID int64) ([]*entity.SlotPerformance, error) {
+	rows, err := s.statsRepo.SlotPerformance(ctx, sellerID, promotionID)
 	if err != nil {
-		totalViews = 0
+		return nil, err
 	}
-
-	return &entity.SellerStatistics{
-		ActivePromotions: activePromotions,
-		FreeSlots:       freeSlots,
-		OccupiedSlots:   occupiedSlots,
-		TotalViews:      totalViews,
-	}, nil
+	out := make([]*entity.SlotPerformance, 0, len(rows))
+	for _, row := range rows {
+		item := &entity.SlotPerformance{
+			PromotionID:   row.PromotionID,
+			PromotionName: row.PromotionName,
+			SegmentID:     row.SegmentID,
+			SegmentName:   row.SegmentName,
+			SlotID:        row.SlotID,
+			Position:      row.Position,
+			PricingType:   row.PricingType,
+			ProductID:     row.ProductID,
+			ProductName:   row.ProductName,
+			Price:         row.Price,
+			Impressions:   row.Impressions,
+			Clicks:        row.Clicks,
+		}
+		if row.Impressions > 0 {
+			item.CTR = float64(row.Clicks) / float64(row.Impressions)
+		}
+		if row.Clicks > 0 {
+			item.CostPerClick = float64(row.Price) / float64(row.Clicks)
+		}
+		out = append(out, item)
+	}
+	return out, nil
 }
 
 // IncrementPromotionView increments the view count for a promotion
@@ -0,0 +1,108 @@
+package seller
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"wildberries/internal/repository"
+)
+
+type fakeStatsRepo struct {
+	market      *repository.MarketTotalsRow
+	bySeller    []*repository.SellerPromotionStatsRow
+	performance []*repository.SlotPerformanceRow
+	sellerCalls int
+}
+
+func (f *fakeStatsRepo) MarketTotals(context.Context) (*repository.MarketTotalsRow, error) {
+	return f.market, nil
+}
+
+func (f *fakeStatsRepo) BySeller(context.Context, int64) ([]*repository.SellerPromotionStatsRow, error) {
+	f.sellerCalls++
+	return f.bySeller, nil
+}
+
+func (f *fakeStatsRepo) SlotPerformance(context.Context, int64, int64) ([]*repository.SlotPerformanceRow, error) {
+	return f.performance, nil
+}
+
+func TestGetSellerStatistics(t *testing.T) {
+	repo := &fakeStatsRepo{
+		market: &repository.MarketTotalsRow{ActivePromotions: 2, FreeSlots: 30, OccupiedSlots: 10, TotalViews: 500},
+		bySeller: []*repository.SellerPromotionStatsRow{
+			{PromotionID: 2, WonSlots: 2, ModerationSlots: 1, CommittedAmount: 300, ActiveBets: 1, ActiveBetsAmount: 50, ParticipatedSlots: 4, Impressions: 100, Clicks: 5},
+			{PromotionID: 1, WonSlots: 1, ParticipatedSlots: 1, Impressions: 20, Clicks: 1},
+		},
+	}
+	s := &Service{statsRepo: repo}
+
+	t.Run("market only", func(t *testing.T) {
+		stats, err := s.GetSellerStatistics(context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.OccupiedSlots != 10 || stats.FreeSlots != 30 || stats.WonSlots != 0 || stats.Promotions != nil {
+			t.Errorf("stats = %+v", stats)
+		}
+		if repo.sellerCalls != 0 {
+			t.Error("seller rows loaded without a seller")
+		}
+	})
+
+	t.Run("seller", func(t *testing.T) {
+		stats, err := s.GetSellerStatistics(context.Background(), 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// market totals keep their meaning with a seller
+		if stats.OccupiedSlots != 10 || stats.FreeSlots != 30 || stats.ActivePromotions != 2 || stats.TotalViews != 500 {
+			t.Errorf("market totals changed: %+v", stats)
+		}
+		if stats.WonSlots != 3 || stats.ModerationSlots != 1 || stats.CommittedAmount != 300 ||
+			stats.ActiveBets != 1 || stats.ProductImpressions != 120 || stats.ProductClicks != 6 {
+			t.Errorf("seller totals = %+v", stats)
+		}
+		if math.Abs(stats.WinRate-0.6) > 1e-9 {
+			t.Errorf("win rate = %v, want 0.6", stats.WinRate)
+		}
+		if len(stats.Promotions) != 2 || stats.Promotions[0].WinRate != 0.5 || stats.Promotions[1].WinRate != 1 {
+			t.Errorf("promotions = %+v", stats.Promotions)
+		}
+	})
+}
+
+func TestGetSlotPerformance(t *testing.T) {
+	s := &Service{statsRepo: &fakeStatsRepo{performance: []*repository.SlotPerformanceRow{
+		{SlotID: 1, Price: 1000, Impressions: 200, Clicks: 10},
+		{SlotID: 2, Price: 500},
+	}}}
+	items, err := s.GetSlotPerformance(context.Background(), 7, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if items[0].CTR != 0.05 || items[0].CostPerClick != 100 {
+		t.Errorf("slot 1 CTR %v, cost per click %v", items[0].CTR, items[0].CostPerClick)
+	}
+	if items[1].CTR != 0 || items[1].CostPerClick != 0 {
+		t.Errorf("slot without traffic: CTR %v, cost per click %v", items[1].CTR, items[1].CostPerClick)
+	}
+}
+
+func TestWinRate(t *testing.T) {
+	tests := []struct {
+		won, participated int64
+		want              float64
+	}{
+		{0, 0, 0},
+		{0, 3, 0},
+		{1, 4, 0.25},
+		{2, 2, 1},
+	}
+	for _, tt := range tests {
+		if got := winRate(tt.won, tt.participated); got != tt.want {
+			t.Errorf("winRate(%d, %d) = %v, want %v", tt.won, tt.participated, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"wildberries/internal/logging"
+	"wildberries/internal/service/ai"
+	"wildberries/internal/tracing"
+)
+
+// TestTraceAcrossLayers follows one request the way production wires it: HTTP edge (otelhttp),
+// gateway call to gRPC (otelgrpc client and server), a database query (pgx tracer) and an AI
+// provider call inside the handler. All spans must share one trace and logs must carry its id.
+func TestTraceAcrossLayers(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	var logs bytes.Buffer
+	logger := logging.NewLogger(&logs, "info", "json")
+
+	llm := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"content":"{\"ok\":true}"}}]}`))
+	}))
+	defer llm.Close()
+	generator := ai.NewOpenAIClient(ai.Config{OpenAIAPIBaseURL: llm.URL, OpenAIModel: "test-model"})
+
+	// the interceptor stands in for a handler that reads the database and calls the AI provider
+	queryTracer := tracing.NewQueryTracer()
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			queryCtx := queryTracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: "SELECT 1"})
+			queryTracer.TraceQueryEnd(queryCtx, nil, pgx.TraceQueryEndData{})
+			if _, err := generator.GenerateJSON(ctx, "prompt"); err != nil {
+				return nil, err
+			}
+			logger.InfoContext(ctx, "handled")
+			return handler(ctx, req)
+		}),
+	)
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = grpcServer.Serve(lis) }()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	api := httptest.NewServer(otelhttp.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := healthpb.NewHealthClient(conn).Check(r.Context(), &healthpb.HealthCheckRequest{}); err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+		}
+	}), "http"))
+	defer api.Close()
+
+	resp, err := http.Get(api.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+
+	spans := exporter.GetSpans()
+	find := func(name string, kind trace.SpanKind) tracetest.SpanStub {
+		t.Helper()
+		for _, span := range spans {
+			if span.Name == name && span.SpanKind == kind {
+				return span
+			}
+		}
+		t.Fatalf("no %s span %q among %d spans", kind, name, len(spans))
+		return tracetest.SpanStub{}
+	}
+	httpSpan := find("http", trace.SpanKindServer)
+	clientSpan := find("grpc.health.v1.Health/Check", trace.SpanKindClient)
+	serverSpan := find("grpc.health.v1.Health/Check", trace.SpanKindServer)
+	dbSpan := find("db SELECT", trace.SpanKindClient)
+	providerSpan := find("openai.GenerateJSON", trace.SpanKindClient)
+
+	links := []struct {
+		name          string
+		child, parent tracetest.SpanStub
+	}{
+		{"grpc client under http", clientSpan, httpSpan},
+		{"grpc server under grpc client", serverSpan, clientSpan},
+		{"db under grpc server", dbSpan, serverSpan},
+		{"provider under grpc server", providerSpan, serverSpan},
+	}
+	traceID := httpSpan.SpanContext.TraceID()
+	for _, l := range links {
+		if l.child.SpanContext.TraceID() != traceID {
+			t.Errorf("%s: trace %s, want %s", l.name, l.child.SpanContext.TraceID(), traceID)
+		}
+		if l.child.Parent.SpanID() != l.parent.SpanContext.SpanID() {
+			t.Errorf("%s: parent %s, want %s", l.name, l.child.Parent.SpanID(), l.parent.SpanContext.SpanID())
+		}
+	}
+
+	if !strings.Contains(logs.String(), `"trace_id":"`+traceID.String()+`"`) {
+		t.Errorf("log does not carry trace_id %s: %s", traceID, logs.String())
+	}
+}
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.promotion
+    ADD COLUMN priority int NOT NULL DEFAULT 0;
+ALTER TABLE public.promotion
+    ADD COLUMN target_regions text[] NOT NULL DEFAULT '{}';
+ALTER TABLE public.promotion
+    ADD COLUMN target_platforms text[] NOT NULL DEFAULT '{}';
+CREATE INDEX IF NOT EXISTS idx_promotion_running_priority ON "public"."promotion" ("priority" DESC, "id")
+    WHERE "status" = 'RUNNING' AND "deleted_at" IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_promotion_running_priority;
+ALTER TABLE public.promotion
+    DROP COLUMN target_platforms;
+ALTER TABLE public.promotion
+    DROP COLUMN target_regions;
+ALTER TABLE public.promotion
+    DROP COLUMN priority;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- buyer_event: append-only лента событий покупателя, партиции по дням (occurred_at).
+-- Дневные партиции создаёт приложение заранее; default ловит события вне окна.
+CREATE TABLE IF NOT EXISTS "public"."buyer_event" (
+    "id" bigserial NOT NULL,
+    "event_id" text NOT NULL,
+    "event_type" text NOT NULL,
+    "promotion_id" bigint NOT NULL,
+    "segment_id" bigint,
+    "question_id" bigint,
+    "option_id" bigint,
+    "product_id" bigint,
+    "session_id" text NOT NULL DEFAULT '',
+    "properties" jsonb NOT NULL DEFAULT '{}',
+    "occurred_at" timestamptz NOT NULL,
+    "received_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("id", "occurred_at")
+) PARTITION BY RANGE ("occurred_at");
+CREATE UNIQUE INDEX IF NOT EXISTS idx_buyer_event_dedup ON "public"."buyer_event" ("event_id", "occurred_at");
+CREATE INDEX IF NOT EXISTS idx_buyer_event_promotion ON "public"."buyer_event" ("promotion_id", "event_type", "occurred_at");
+CREATE TABLE IF NOT EXISTS "public"."buyer_event_default" PARTITION OF "public"."buyer_event" DEFAULT;
+-- buyer_event_dedup: принятые event_id. Ключ buyer_event (event_id, occurred_at) не ловит повтор,
+-- если occurred_at подставил сервер — у повтора будет другое время. Строки старше окна приёма
+-- событий удаляет приложение: повтор с таким occurred_at всё равно будет отклонён.
+CREATE TABLE IF NOT EXISTS "public"."buyer_event_dedup" (
+    "event_id" text NOT NULL PRIMARY KEY,
+    "occurred_at" timestamptz NOT NULL,
+    "received_at" timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_buyer_event_dedup_occurred ON "public"."buyer_event_dedup" ("occurred_at");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."buyer_event_dedup";
+DROP TABLE IF EXISTS "public"."buyer_event";
+-- +goose StatementEnd
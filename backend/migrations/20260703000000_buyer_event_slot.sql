@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- slot_id: слот витрины, в котором покупатель увидел товар (для отчёта селлера по слотам).
+ALTER TABLE "public"."buyer_event" ADD COLUMN IF NOT EXISTS "slot_id" bigint;
+CREATE INDEX IF NOT EXISTS idx_buyer_event_slot ON "public"."buyer_event" ("slot_id", "event_type") WHERE "slot_id" IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS "public"."idx_buyer_event_slot";
+ALTER TABLE "public"."buyer_event" DROP COLUMN IF EXISTS "slot_id";
+-- +goose StatementEnd
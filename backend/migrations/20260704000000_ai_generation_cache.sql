@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ai_generation_cache: провалидированные ответы AI провайдеров; ключ — провайдер, модель и sha256 промпта.
+-- Хранится только raw_json: при чтении ответ заново разбирается текущим валидатором.
+CREATE TABLE IF NOT EXISTS "public"."ai_generation_cache" (
+    "provider" text NOT NULL,
+    "model" text NOT NULL,
+    "prompt_hash" text NOT NULL,
+    "kind" text NOT NULL,
+    "raw_json" text NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "expires_at" timestamptz NOT NULL,
+    PRIMARY KEY ("provider", "model", "prompt_hash")
+);
+
+CREATE INDEX IF NOT EXISTS idx_ai_generation_cache_expires ON "public"."ai_generation_cache" ("expires_at");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."ai_generation_cache";
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ai_generation_log: каждый вызов AI провайдера — операция, модель, задержка, токены, стоимость и результат валидации.
+-- status: ok | invalid (ответ не прошёл валидацию) | failed (ошибка провайдера) | cached (ответ из ai_generation_cache)
+CREATE TABLE IF NOT EXISTS "public"."ai_generation_log" (
+    "id" bigserial PRIMARY KEY,
+    "operation" text NOT NULL,
+    "provider" text NOT NULL,
+    "model" text NOT NULL,
+    "status" text NOT NULL CHECK ("status" IN ('ok', 'invalid', 'failed', 'cached')),
+    "latency_ms" bigint NOT NULL DEFAULT 0,
+    "prompt_tokens" bigint NOT NULL DEFAULT 0,
+    "completion_tokens" bigint NOT NULL DEFAULT 0,
+    "total_tokens" bigint NOT NULL DEFAULT 0,
+    "cost_usd" numeric(14, 6) NOT NULL DEFAULT 0,
+    "prompt" text NOT NULL,
+    "response" text NOT NULL DEFAULT '',
+    "error" text NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ai_generation_log_created ON "public"."ai_generation_log" ("created_at");
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."ai_generation_log";
+-- +goose StatementEnd
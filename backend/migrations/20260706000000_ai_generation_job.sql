@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ai_generation_job: асинхронные генерации AI. Воркеры забирают задачи через FOR UPDATE SKIP LOCKED;
+-- running задача с истёкшей арендой (locked_until) брошена упавшим процессом и забирается снова.
+-- status: queued | running | done | failed; request и result — параметры и результат генерации по kind
+CREATE TABLE IF NOT EXISTS "public"."ai_generation_job" (
+    "id" text PRIMARY KEY,
+    "kind" text NOT NULL,
+    "status" text NOT NULL DEFAULT 'queued' CHECK ("status" IN ('queued', 'running', 'done', 'failed')),
+    "request" jsonb NOT NULL,
+    "result" jsonb,
+    "error" text NOT NULL DEFAULT '',
+    "attempts" integer NOT NULL DEFAULT 0,
+    "locked_until" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    "started_at" timestamptz,
+    "finished_at" timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_ai_generation_job_pending ON "public"."ai_generation_job" ("created_at")
+    WHERE "status" IN ('queued', 'running');
+CREATE INDEX IF NOT EXISTS idx_ai_generation_job_finished ON "public"."ai_generation_job" ("finished_at")
+    WHERE "finished_at" IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."ai_generation_job";
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- segment_text_version: история текстов сегмента; segment.text — текущая версия.
+-- source: initial (текст, сохранённый до ведения истории) | ai | revert (откат к прошлой версии)
+CREATE TABLE IF NOT EXISTS "public"."segment_text_version" (
+    "segment_id" bigint NOT NULL REFERENCES "public"."segment" ("id") ON DELETE CASCADE,
+    "version" integer NOT NULL,
+    "text" text NOT NULL,
+    "source" text NOT NULL CHECK ("source" IN ('initial', 'ai', 'revert')),
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("segment_id", "version")
+);
+
+INSERT INTO "public"."segment_text_version" ("segment_id", "version", "text", "source", "created_at")
+SELECT "id", 1, "text", 'initial', "updated_at"
+FROM "public"."segment"
+WHERE "text" IS NOT NULL AND "text" <> ''
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."segment_text_version";
+-- +goose StatementEnd
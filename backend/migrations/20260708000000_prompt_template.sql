@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- prompt_template: версии промптов AI (text/template). Версия 0 — встроенный промпт из бинарника, в таблице не хранится.
+CREATE TABLE IF NOT EXISTS "public"."prompt_template" (
+    "name" text NOT NULL,
+    "version" integer NOT NULL CHECK ("version" > 0),
+    "body" text NOT NULL,
+    "comment" text NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("name", "version")
+);
+
+-- prompt_template_active: версия, которой пользуются генерации; нет строки — встроенный промпт.
+-- Откат = активация прошлой версии.
+CREATE TABLE IF NOT EXISTS "public"."prompt_template_active" (
+    "name" text PRIMARY KEY,
+    "version" integer NOT NULL,
+    "updated_at" timestamptz NOT NULL DEFAULT now(),
+    FOREIGN KEY ("name", "version") REFERENCES "public"."prompt_template" ("name", "version")
+);
+
+-- promotion_prompt_pin: версия промпта, закреплённая за акцией; важнее активной. version = 0 — встроенный промпт.
+CREATE TABLE IF NOT EXISTS "public"."promotion_prompt_pin" (
+    "promotion_id" bigint NOT NULL REFERENCES "public"."promotion" ("id") ON DELETE CASCADE,
+    "name" text NOT NULL,
+    "version" integer NOT NULL CHECK ("version" >= 0),
+    "created_at" timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY ("promotion_id", "name")
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "public"."promotion_prompt_pin";
+DROP TABLE IF EXISTS "public"."prompt_template_active";
+DROP TABLE IF EXISTS "public"."prompt_template";
+-- +goose StatementEnd
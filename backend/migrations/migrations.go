@@ -0,0 +1,31 @@
+// Package migrations embeds goose migrations so the server knows the schema version it expects.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// LatestVersion returns the version of the newest migration (the numeric file name prefix).
+func LatestVersion() int64 {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return 0
+	}
+	var latest int64
+	for _, e := range entries {
+		prefix, _, ok := strings.Cut(e.Name(), "_")
+		if !ok {
+			continue
+		}
+		if v, err := strconv.ParseInt(prefix, 10, 64); err == nil && v > latest {
+			latest = v
+		}
+	}
+	return latest
+}
@@ -39,6 +39,9 @@ type CreatePromotionRequest struct {
 	MinDiscount        int32                  `protobuf:"varint,9,opt,name=min_discount,json=minDiscount,proto3" json:"min_discount,omitempty"`
 	StopFactors        []string               `protobuf:"bytes,10,rep,name=stop_factors,json=stopFactors,proto3" json:"stop_factors,omitempty"`
 	MaxDiscount        int32                  `protobuf:"varint,11,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	Priority           int32                  `protobuf:"varint,12,opt,name=priority,proto3" json:"priority,omitempty"`                                     // выше — показывается покупателю раньше
+	TargetRegions      []string               `protobuf:"bytes,13,rep,name=target_regions,json=targetRegions,proto3" json:"target_regions,omitempty"`       // пусто = все регионы
+	TargetPlatforms    []string               `protobuf:"bytes,14,rep,name=target_platforms,json=targetPlatforms,proto3" json:"target_platforms,omitempty"` // пусто = все платформы
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -150,6 +153,27 @@ func (x *CreatePromotionRequest) GetMaxDiscount() int32 {
 	return 0
 }
 
+func (x *CreatePromotionRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetTargetRegions() []string {
+	if x != nil {
+		return x.TargetRegions
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetTargetPlatforms() []string {
+	if x != nil {
+		return x.TargetPlatforms
+	}
+	return nil
+}
+
 type CreatePromotionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -202,6 +226,215 @@ func (x *CreatePromotionResponse) GetStatus() string {
 	return ""
 }
 
+// POST /admin/promotions/draft
+type GeneratePromotionDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Theme         string                 `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
+	SegmentCount  int32                  `protobuf:"varint,2,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count,omitempty"` // 0 — по умолчанию
+	DateFrom      string                 `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`              // RFC3339
+	DateTo        string                 `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	PricingModel  string                 `protobuf:"bytes,5,opt,name=pricing_model,json=pricingModel,proto3" json:"pricing_model,omitempty"` // auction | fixed
+	SlotCount     int32                  `protobuf:"varint,6,opt,name=slot_count,json=slotCount,proto3" json:"slot_count,omitempty"`
+	MinDiscount   int32                  `protobuf:"varint,7,opt,name=min_discount,json=minDiscount,proto3" json:"min_discount,omitempty"`
+	MaxDiscount   int32                  `protobuf:"varint,8,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	Async         bool                   `protobuf:"varint,9,opt,name=async,proto3" json:"async,omitempty"` // вернуть job_id сразу, акция и результат — GET /ai/jobs/{id}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePromotionDraftRequest) Reset() {
+	*x = GeneratePromotionDraftRequest{}
+	mi := &file_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePromotionDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePromotionDraftRequest) ProtoMessage() {}
+
+func (x *GeneratePromotionDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePromotionDraftRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePromotionDraftRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GeneratePromotionDraftRequest) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *GeneratePromotionDraftRequest) GetSegmentCount() int32 {
+	if x != nil {
+		return x.SegmentCount
+	}
+	return 0
+}
+
+func (x *GeneratePromotionDraftRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GeneratePromotionDraftRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GeneratePromotionDraftRequest) GetPricingModel() string {
+	if x != nil {
+		return x.PricingModel
+	}
+	return ""
+}
+
+func (x *GeneratePromotionDraftRequest) GetSlotCount() int32 {
+	if x != nil {
+		return x.SlotCount
+	}
+	return 0
+}
+
+func (x *GeneratePromotionDraftRequest) GetMinDiscount() int32 {
+	if x != nil {
+		return x.MinDiscount
+	}
+	return 0
+}
+
+func (x *GeneratePromotionDraftRequest) GetMaxDiscount() int32 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *GeneratePromotionDraftRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type GeneratePromotionDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // NOT_READY
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Theme         string                 `protobuf:"bytes,5,opt,name=theme,proto3" json:"theme,omitempty"`
+	Segments      []*SegmentWithOrder    `protobuf:"bytes,6,rep,name=segments,proto3" json:"segments,omitempty"`
+	Poll          *PromotionPoll         `protobuf:"bytes,7,opt,name=poll,proto3" json:"poll,omitempty"`
+	JobId         string                 `protobuf:"bytes,8,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // для async запроса; остальные поля пусты
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePromotionDraftResponse) Reset() {
+	*x = GeneratePromotionDraftResponse{}
+	mi := &file_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePromotionDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePromotionDraftResponse) ProtoMessage() {}
+
+func (x *GeneratePromotionDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePromotionDraftResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePromotionDraftResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GeneratePromotionDraftResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GeneratePromotionDraftResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GeneratePromotionDraftResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GeneratePromotionDraftResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GeneratePromotionDraftResponse) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *GeneratePromotionDraftResponse) GetSegments() []*SegmentWithOrder {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *GeneratePromotionDraftResponse) GetPoll() *PromotionPoll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *GeneratePromotionDraftResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 // GET /admin/promotions/
 type GetPromotionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -211,7 +444,7 @@ type GetPromotionRequest struct {
 
 func (x *GetPromotionRequest) Reset() {
 	*x = GetPromotionRequest{}
-	mi := &file_admin_proto_msgTypes[2]
+	mi := &file_admin_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +456,7 @@ func (x *GetPromotionRequest) String() string {
 func (*GetPromotionRequest) ProtoMessage() {}
 
 func (x *GetPromotionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[2]
+	mi := &file_admin_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +469,7 @@ func (x *GetPromotionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPromotionRequest.ProtoReflect.Descriptor instead.
 func (*GetPromotionRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{2}
+	return file_admin_proto_rawDescGZIP(), []int{4}
 }
 
 type GetPromotionResponse struct {
@@ -248,7 +481,7 @@ type GetPromotionResponse struct {
 
 func (x *GetPromotionResponse) Reset() {
 	*x = GetPromotionResponse{}
-	mi := &file_admin_proto_msgTypes[3]
+	mi := &file_admin_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -260,7 +493,7 @@ func (x *GetPromotionResponse) String() string {
 func (*GetPromotionResponse) ProtoMessage() {}
 
 func (x *GetPromotionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[3]
+	mi := &file_admin_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,7 +506,7 @@ func (x *GetPromotionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPromotionResponse.ProtoReflect.Descriptor instead.
 func (*GetPromotionResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{3}
+	return file_admin_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetPromotionResponse) GetPromotions() []*SinglePromotion {
@@ -303,13 +536,16 @@ type SinglePromotion struct {
 	MaxDiscount        int32                  `protobuf:"varint,16,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
 	BookedSlotsPrice   *int64                 `protobuf:"varint,17,opt,name=booked_slots_price,json=bookedSlotsPrice,proto3,oneof" json:"booked_slots_price,omitempty"`
 	AuctionSlotsPrice  *int64                 `protobuf:"varint,18,opt,name=auction_slots_price,json=auctionSlotsPrice,proto3,oneof" json:"auction_slots_price,omitempty"`
+	Priority           int32                  `protobuf:"varint,19,opt,name=priority,proto3" json:"priority,omitempty"`
+	TargetRegions      []string               `protobuf:"bytes,20,rep,name=target_regions,json=targetRegions,proto3" json:"target_regions,omitempty"`
+	TargetPlatforms    []string               `protobuf:"bytes,21,rep,name=target_platforms,json=targetPlatforms,proto3" json:"target_platforms,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *SinglePromotion) Reset() {
 	*x = SinglePromotion{}
-	mi := &file_admin_proto_msgTypes[4]
+	mi := &file_admin_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -321,7 +557,7 @@ func (x *SinglePromotion) String() string {
 func (*SinglePromotion) ProtoMessage() {}
 
 func (x *SinglePromotion) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[4]
+	mi := &file_admin_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +570,7 @@ func (x *SinglePromotion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SinglePromotion.ProtoReflect.Descriptor instead.
 func (*SinglePromotion) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{4}
+	return file_admin_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SinglePromotion) GetId() int64 {
@@ -463,19 +699,43 @@ func (x *SinglePromotion) GetAuctionSlotsPrice() int64 {
 	return 0
 }
 
+func (x *SinglePromotion) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SinglePromotion) GetTargetRegions() []string {
+	if x != nil {
+		return x.TargetRegions
+	}
+	return nil
+}
+
+func (x *SinglePromotion) GetTargetPlatforms() []string {
+	if x != nil {
+		return x.TargetPlatforms
+	}
+	return nil
+}
+
 type SegmentWithOrder struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CategoryName  string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	OrderIndex    int32                  `protobuf:"varint,4,opt,name=order_index,json=orderIndex,proto3" json:"order_index,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryName     string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	OrderIndex       int32                  `protobuf:"varint,4,opt,name=order_index,json=orderIndex,proto3" json:"order_index,omitempty"`
+	Population       int64                  `protobuf:"varint,5,opt,name=population,proto3" json:"population,omitempty"`                                    // оценка числа покупателей сегмента
+	PopulationShare  float64                `protobuf:"fixed64,6,opt,name=population_share,json=populationShare,proto3" json:"population_share,omitempty"`  // 0..1
+	PopulationSource string                 `protobuf:"bytes,7,opt,name=population_source,json=populationSource,proto3" json:"population_source,omitempty"` // observed | historical | simulation
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *SegmentWithOrder) Reset() {
 	*x = SegmentWithOrder{}
-	mi := &file_admin_proto_msgTypes[5]
+	mi := &file_admin_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -487,7 +747,7 @@ func (x *SegmentWithOrder) String() string {
 func (*SegmentWithOrder) ProtoMessage() {}
 
 func (x *SegmentWithOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[5]
+	mi := &file_admin_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +760,7 @@ func (x *SegmentWithOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SegmentWithOrder.ProtoReflect.Descriptor instead.
 func (*SegmentWithOrder) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{5}
+	return file_admin_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SegmentWithOrder) GetId() int64 {
@@ -531,6 +791,27 @@ func (x *SegmentWithOrder) GetOrderIndex() int32 {
 	return 0
 }
 
+func (x *SegmentWithOrder) GetPopulation() int64 {
+	if x != nil {
+		return x.Population
+	}
+	return 0
+}
+
+func (x *SegmentWithOrder) GetPopulationShare() float64 {
+	if x != nil {
+		return x.PopulationShare
+	}
+	return 0
+}
+
+func (x *SegmentWithOrder) GetPopulationSource() string {
+	if x != nil {
+		return x.PopulationSource
+	}
+	return ""
+}
+
 type PromotionPoll struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Questions     []*PollQuestionAdmin   `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
@@ -541,7 +822,7 @@ type PromotionPoll struct {
 
 func (x *PromotionPoll) Reset() {
 	*x = PromotionPoll{}
-	mi := &file_admin_proto_msgTypes[6]
+	mi := &file_admin_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -553,7 +834,7 @@ func (x *PromotionPoll) String() string {
 func (*PromotionPoll) ProtoMessage() {}
 
 func (x *PromotionPoll) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[6]
+	mi := &file_admin_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,7 +847,7 @@ func (x *PromotionPoll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PromotionPoll.ProtoReflect.Descriptor instead.
 func (*PromotionPoll) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{6}
+	return file_admin_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PromotionPoll) GetQuestions() []*PollQuestionAdmin {
@@ -594,7 +875,7 @@ type PollQuestionAdmin struct {
 
 func (x *PollQuestionAdmin) Reset() {
 	*x = PollQuestionAdmin{}
-	mi := &file_admin_proto_msgTypes[7]
+	mi := &file_admin_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +887,7 @@ func (x *PollQuestionAdmin) String() string {
 func (*PollQuestionAdmin) ProtoMessage() {}
 
 func (x *PollQuestionAdmin) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[7]
+	mi := &file_admin_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +900,7 @@ func (x *PollQuestionAdmin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PollQuestionAdmin.ProtoReflect.Descriptor instead.
 func (*PollQuestionAdmin) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{7}
+	return file_admin_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PollQuestionAdmin) GetId() int64 {
@@ -654,7 +935,7 @@ type PollOptionAdmin struct {
 
 func (x *PollOptionAdmin) Reset() {
 	*x = PollOptionAdmin{}
-	mi := &file_admin_proto_msgTypes[8]
+	mi := &file_admin_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +947,7 @@ func (x *PollOptionAdmin) String() string {
 func (*PollOptionAdmin) ProtoMessage() {}
 
 func (x *PollOptionAdmin) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[8]
+	mi := &file_admin_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +960,7 @@ func (x *PollOptionAdmin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PollOptionAdmin.ProtoReflect.Descriptor instead.
 func (*PollOptionAdmin) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{8}
+	return file_admin_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PollOptionAdmin) GetId() int64 {
@@ -715,7 +996,7 @@ type AnswerTreeNode struct {
 
 func (x *AnswerTreeNode) Reset() {
 	*x = AnswerTreeNode{}
-	mi := &file_admin_proto_msgTypes[9]
+	mi := &file_admin_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -727,7 +1008,7 @@ func (x *AnswerTreeNode) String() string {
 func (*AnswerTreeNode) ProtoMessage() {}
 
 func (x *AnswerTreeNode) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[9]
+	mi := &file_admin_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +1021,7 @@ func (x *AnswerTreeNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnswerTreeNode.ProtoReflect.Descriptor instead.
 func (*AnswerTreeNode) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{9}
+	return file_admin_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AnswerTreeNode) GetNodeId() string {
@@ -786,13 +1067,17 @@ type UpdatePromotionRequest struct {
 	MinDiscount        *int32                 `protobuf:"varint,10,opt,name=min_discount,json=minDiscount,proto3,oneof" json:"min_discount,omitempty"`
 	StopFactors        []string               `protobuf:"bytes,11,rep,name=stop_factors,json=stopFactors,proto3" json:"stop_factors,omitempty"`
 	MaxDiscount        *int32                 `protobuf:"varint,12,opt,name=max_discount,json=maxDiscount,proto3,oneof" json:"max_discount,omitempty"`
+	Priority           *int32                 `protobuf:"varint,13,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	TargetRegions      []string               `protobuf:"bytes,14,rep,name=target_regions,json=targetRegions,proto3" json:"target_regions,omitempty"`
+	TargetPlatforms    []string               `protobuf:"bytes,15,rep,name=target_platforms,json=targetPlatforms,proto3" json:"target_platforms,omitempty"`
+	ClearTargeting     bool                   `protobuf:"varint,16,opt,name=clear_targeting,json=clearTargeting,proto3" json:"clear_targeting,omitempty"` // сбросить регионы и платформы
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdatePromotionRequest) Reset() {
 	*x = UpdatePromotionRequest{}
-	mi := &file_admin_proto_msgTypes[10]
+	mi := &file_admin_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -804,7 +1089,7 @@ func (x *UpdatePromotionRequest) String() string {
 func (*UpdatePromotionRequest) ProtoMessage() {}
 
 func (x *UpdatePromotionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[10]
+	mi := &file_admin_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +1102,7 @@ func (x *UpdatePromotionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePromotionRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePromotionRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{10}
+	return file_admin_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdatePromotionRequest) GetId() int64 {
@@ -904,6 +1189,34 @@ func (x *UpdatePromotionRequest) GetMaxDiscount() int32 {
 	return 0
 }
 
+func (x *UpdatePromotionRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *UpdatePromotionRequest) GetTargetRegions() []string {
+	if x != nil {
+		return x.TargetRegions
+	}
+	return nil
+}
+
+func (x *UpdatePromotionRequest) GetTargetPlatforms() []string {
+	if x != nil {
+		return x.TargetPlatforms
+	}
+	return nil
+}
+
+func (x *UpdatePromotionRequest) GetClearTargeting() bool {
+	if x != nil {
+		return x.ClearTargeting
+	}
+	return false
+}
+
 type UpdatePromotionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -912,7 +1225,7 @@ type UpdatePromotionResponse struct {
 
 func (x *UpdatePromotionResponse) Reset() {
 	*x = UpdatePromotionResponse{}
-	mi := &file_admin_proto_msgTypes[11]
+	mi := &file_admin_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -924,7 +1237,7 @@ func (x *UpdatePromotionResponse) String() string {
 func (*UpdatePromotionResponse) ProtoMessage() {}
 
 func (x *UpdatePromotionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[11]
+	mi := &file_admin_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,7 +1250,7 @@ func (x *UpdatePromotionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePromotionResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePromotionResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{11}
+	return file_admin_proto_rawDescGZIP(), []int{13}
 }
 
 // DELETE /admin/promotions/{id}
@@ -950,7 +1263,7 @@ type DeletePromotionRequest struct {
 
 func (x *DeletePromotionRequest) Reset() {
 	*x = DeletePromotionRequest{}
-	mi := &file_admin_proto_msgTypes[12]
+	mi := &file_admin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -962,7 +1275,7 @@ func (x *DeletePromotionRequest) String() string {
 func (*DeletePromotionRequest) ProtoMessage() {}
 
 func (x *DeletePromotionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[12]
+	mi := &file_admin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1288,7 @@ func (x *DeletePromotionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePromotionRequest.ProtoReflect.Descriptor instead.
 func (*DeletePromotionRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{12}
+	return file_admin_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeletePromotionRequest) GetId() int64 {
@@ -993,7 +1306,7 @@ type DeletePromotionResponse struct {
 
 func (x *DeletePromotionResponse) Reset() {
 	*x = DeletePromotionResponse{}
-	mi := &file_admin_proto_msgTypes[13]
+	mi := &file_admin_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1005,7 +1318,7 @@ func (x *DeletePromotionResponse) String() string {
 func (*DeletePromotionResponse) ProtoMessage() {}
 
 func (x *DeletePromotionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[13]
+	mi := &file_admin_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1331,7 @@ func (x *DeletePromotionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePromotionResponse.ProtoReflect.Descriptor instead.
 func (*DeletePromotionResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{13}
+	return file_admin_proto_rawDescGZIP(), []int{15}
 }
 
 // PUT /admin/promotions/{id}/fixed-prices
@@ -1032,7 +1345,7 @@ type SetFixedPricesRequest struct {
 
 func (x *SetFixedPricesRequest) Reset() {
 	*x = SetFixedPricesRequest{}
-	mi := &file_admin_proto_msgTypes[14]
+	mi := &file_admin_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1044,7 +1357,7 @@ func (x *SetFixedPricesRequest) String() string {
 func (*SetFixedPricesRequest) ProtoMessage() {}
 
 func (x *SetFixedPricesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[14]
+	mi := &file_admin_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,7 +1370,7 @@ func (x *SetFixedPricesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetFixedPricesRequest.ProtoReflect.Descriptor instead.
 func (*SetFixedPricesRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{14}
+	return file_admin_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SetFixedPricesRequest) GetPromotionId() int64 {
@@ -1084,7 +1397,7 @@ type FixedPriceEntry struct {
 
 func (x *FixedPriceEntry) Reset() {
 	*x = FixedPriceEntry{}
-	mi := &file_admin_proto_msgTypes[15]
+	mi := &file_admin_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1096,7 +1409,7 @@ func (x *FixedPriceEntry) String() string {
 func (*FixedPriceEntry) ProtoMessage() {}
 
 func (x *FixedPriceEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[15]
+	mi := &file_admin_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,7 +1422,7 @@ func (x *FixedPriceEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixedPriceEntry.ProtoReflect.Descriptor instead.
 func (*FixedPriceEntry) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{15}
+	return file_admin_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *FixedPriceEntry) GetPosition() int32 {
@@ -1134,7 +1447,7 @@ type SetFixedPricesResponse struct {
 
 func (x *SetFixedPricesResponse) Reset() {
 	*x = SetFixedPricesResponse{}
-	mi := &file_admin_proto_msgTypes[16]
+	mi := &file_admin_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1146,7 +1459,7 @@ func (x *SetFixedPricesResponse) String() string {
 func (*SetFixedPricesResponse) ProtoMessage() {}
 
 func (x *SetFixedPricesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[16]
+	mi := &file_admin_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +1472,7 @@ func (x *SetFixedPricesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetFixedPricesResponse.ProtoReflect.Descriptor instead.
 func (*SetFixedPricesResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{16}
+	return file_admin_proto_rawDescGZIP(), []int{18}
 }
 
 // PUT /admin/promotions/{id}/status
@@ -1173,7 +1486,7 @@ type ChangeStatusRequest struct {
 
 func (x *ChangeStatusRequest) Reset() {
 	*x = ChangeStatusRequest{}
-	mi := &file_admin_proto_msgTypes[17]
+	mi := &file_admin_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1185,7 +1498,7 @@ func (x *ChangeStatusRequest) String() string {
 func (*ChangeStatusRequest) ProtoMessage() {}
 
 func (x *ChangeStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[17]
+	mi := &file_admin_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,7 +1511,7 @@ func (x *ChangeStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeStatusRequest.ProtoReflect.Descriptor instead.
 func (*ChangeStatusRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{17}
+	return file_admin_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ChangeStatusRequest) GetPromotionId() int64 {
@@ -1223,7 +1536,7 @@ type ChangeStatusResponse struct {
 
 func (x *ChangeStatusResponse) Reset() {
 	*x = ChangeStatusResponse{}
-	mi := &file_admin_proto_msgTypes[18]
+	mi := &file_admin_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1235,7 +1548,7 @@ func (x *ChangeStatusResponse) String() string {
 func (*ChangeStatusResponse) ProtoMessage() {}
 
 func (x *ChangeStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[18]
+	mi := &file_admin_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1561,7 @@ func (x *ChangeStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeStatusResponse.ProtoReflect.Descriptor instead.
 func (*ChangeStatusResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{18}
+	return file_admin_proto_rawDescGZIP(), []int{20}
 }
 
 // PUT /admin/promotions/{id}/auction-params
@@ -1263,7 +1576,7 @@ type SetAuctionParamsRequest struct {
 
 func (x *SetAuctionParamsRequest) Reset() {
 	*x = SetAuctionParamsRequest{}
-	mi := &file_admin_proto_msgTypes[19]
+	mi := &file_admin_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1275,7 +1588,7 @@ func (x *SetAuctionParamsRequest) String() string {
 func (*SetAuctionParamsRequest) ProtoMessage() {}
 
 func (x *SetAuctionParamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[19]
+	mi := &file_admin_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,7 +1601,7 @@ func (x *SetAuctionParamsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAuctionParamsRequest.ProtoReflect.Descriptor instead.
 func (*SetAuctionParamsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{19}
+	return file_admin_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SetAuctionParamsRequest) GetPromotionId() int64 {
@@ -1320,7 +1633,7 @@ type SetAuctionParamsResponse struct {
 
 func (x *SetAuctionParamsResponse) Reset() {
 	*x = SetAuctionParamsResponse{}
-	mi := &file_admin_proto_msgTypes[20]
+	mi := &file_admin_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1332,7 +1645,7 @@ func (x *SetAuctionParamsResponse) String() string {
 func (*SetAuctionParamsResponse) ProtoMessage() {}
 
 func (x *SetAuctionParamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[20]
+	mi := &file_admin_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,7 +1658,7 @@ func (x *SetAuctionParamsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAuctionParamsResponse.ProtoReflect.Descriptor instead.
 func (*SetAuctionParamsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{20}
+	return file_admin_proto_rawDescGZIP(), []int{22}
 }
 
 // POST /horoscope/products — ручная установка товара в слот
@@ -1360,7 +1673,7 @@ type SetSlotProductRequest struct {
 
 func (x *SetSlotProductRequest) Reset() {
 	*x = SetSlotProductRequest{}
-	mi := &file_admin_proto_msgTypes[21]
+	mi := &file_admin_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1372,7 +1685,7 @@ func (x *SetSlotProductRequest) String() string {
 func (*SetSlotProductRequest) ProtoMessage() {}
 
 func (x *SetSlotProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[21]
+	mi := &file_admin_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,7 +1698,7 @@ func (x *SetSlotProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSlotProductRequest.ProtoReflect.Descriptor instead.
 func (*SetSlotProductRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{21}
+	return file_admin_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SetSlotProductRequest) GetSegmentId() int64 {
@@ -1417,7 +1730,7 @@ type SetSlotProductResponse struct {
 
 func (x *SetSlotProductResponse) Reset() {
 	*x = SetSlotProductResponse{}
-	mi := &file_admin_proto_msgTypes[22]
+	mi := &file_admin_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1429,7 +1742,7 @@ func (x *SetSlotProductResponse) String() string {
 func (*SetSlotProductResponse) ProtoMessage() {}
 
 func (x *SetSlotProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[22]
+	mi := &file_admin_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1442,7 +1755,7 @@ func (x *SetSlotProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSlotProductResponse.ProtoReflect.Descriptor instead.
 func (*SetSlotProductResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{22}
+	return file_admin_proto_rawDescGZIP(), []int{24}
 }
 
 // --- Segment Admin ---
@@ -1458,7 +1771,7 @@ type GenerateSegmentsRequest struct {
 
 func (x *GenerateSegmentsRequest) Reset() {
 	*x = GenerateSegmentsRequest{}
-	mi := &file_admin_proto_msgTypes[23]
+	mi := &file_admin_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1470,7 +1783,7 @@ func (x *GenerateSegmentsRequest) String() string {
 func (*GenerateSegmentsRequest) ProtoMessage() {}
 
 func (x *GenerateSegmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[23]
+	mi := &file_admin_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1483,7 +1796,7 @@ func (x *GenerateSegmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateSegmentsRequest.ProtoReflect.Descriptor instead.
 func (*GenerateSegmentsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{23}
+	return file_admin_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GenerateSegmentsRequest) GetPromotionId() int64 {
@@ -1516,7 +1829,7 @@ type GenerateSegmentsResponse struct {
 
 func (x *GenerateSegmentsResponse) Reset() {
 	*x = GenerateSegmentsResponse{}
-	mi := &file_admin_proto_msgTypes[24]
+	mi := &file_admin_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1528,7 +1841,7 @@ func (x *GenerateSegmentsResponse) String() string {
 func (*GenerateSegmentsResponse) ProtoMessage() {}
 
 func (x *GenerateSegmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[24]
+	mi := &file_admin_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1541,7 +1854,7 @@ func (x *GenerateSegmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateSegmentsResponse.ProtoReflect.Descriptor instead.
 func (*GenerateSegmentsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{24}
+	return file_admin_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GenerateSegmentsResponse) GetSegments() []*common.Segment {
@@ -1564,7 +1877,7 @@ type CreateSegmentRequest struct {
 
 func (x *CreateSegmentRequest) Reset() {
 	*x = CreateSegmentRequest{}
-	mi := &file_admin_proto_msgTypes[25]
+	mi := &file_admin_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1576,7 +1889,7 @@ func (x *CreateSegmentRequest) String() string {
 func (*CreateSegmentRequest) ProtoMessage() {}
 
 func (x *CreateSegmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[25]
+	mi := &file_admin_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1589,7 +1902,7 @@ func (x *CreateSegmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSegmentRequest.ProtoReflect.Descriptor instead.
 func (*CreateSegmentRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{25}
+	return file_admin_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CreateSegmentRequest) GetPromotionId() int64 {
@@ -1631,7 +1944,7 @@ type CreateSegmentResponse struct {
 
 func (x *CreateSegmentResponse) Reset() {
 	*x = CreateSegmentResponse{}
-	mi := &file_admin_proto_msgTypes[26]
+	mi := &file_admin_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1643,7 +1956,7 @@ func (x *CreateSegmentResponse) String() string {
 func (*CreateSegmentResponse) ProtoMessage() {}
 
 func (x *CreateSegmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[26]
+	mi := &file_admin_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1656,7 +1969,7 @@ func (x *CreateSegmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSegmentResponse.ProtoReflect.Descriptor instead.
 func (*CreateSegmentResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{26}
+	return file_admin_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateSegmentResponse) GetId() int64 {
@@ -1694,7 +2007,7 @@ type UpdateSegmentRequest struct {
 
 func (x *UpdateSegmentRequest) Reset() {
 	*x = UpdateSegmentRequest{}
-	mi := &file_admin_proto_msgTypes[27]
+	mi := &file_admin_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1706,7 +2019,7 @@ func (x *UpdateSegmentRequest) String() string {
 func (*UpdateSegmentRequest) ProtoMessage() {}
 
 func (x *UpdateSegmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[27]
+	mi := &file_admin_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,7 +2032,7 @@ func (x *UpdateSegmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSegmentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSegmentRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{27}
+	return file_admin_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateSegmentRequest) GetPromotionId() int64 {
@@ -1765,7 +2078,7 @@ type UpdateSegmentResponse struct {
 
 func (x *UpdateSegmentResponse) Reset() {
 	*x = UpdateSegmentResponse{}
-	mi := &file_admin_proto_msgTypes[28]
+	mi := &file_admin_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1777,7 +2090,7 @@ func (x *UpdateSegmentResponse) String() string {
 func (*UpdateSegmentResponse) ProtoMessage() {}
 
 func (x *UpdateSegmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[28]
+	mi := &file_admin_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1790,7 +2103,7 @@ func (x *UpdateSegmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSegmentResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSegmentResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{28}
+	return file_admin_proto_rawDescGZIP(), []int{30}
 }
 
 // DELETE /admin/promotions/{id}/segments/{segmentId}
@@ -1804,7 +2117,7 @@ type DeleteSegmentRequest struct {
 
 func (x *DeleteSegmentRequest) Reset() {
 	*x = DeleteSegmentRequest{}
-	mi := &file_admin_proto_msgTypes[29]
+	mi := &file_admin_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1816,7 +2129,7 @@ func (x *DeleteSegmentRequest) String() string {
 func (*DeleteSegmentRequest) ProtoMessage() {}
 
 func (x *DeleteSegmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[29]
+	mi := &file_admin_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,7 +2142,7 @@ func (x *DeleteSegmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSegmentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSegmentRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{29}
+	return file_admin_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DeleteSegmentRequest) GetPromotionId() int64 {
@@ -1854,7 +2167,7 @@ type DeleteSegmentResponse struct {
 
 func (x *DeleteSegmentResponse) Reset() {
 	*x = DeleteSegmentResponse{}
-	mi := &file_admin_proto_msgTypes[30]
+	mi := &file_admin_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1866,7 +2179,7 @@ func (x *DeleteSegmentResponse) String() string {
 func (*DeleteSegmentResponse) ProtoMessage() {}
 
 func (x *DeleteSegmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[30]
+	mi := &file_admin_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1879,7 +2192,7 @@ func (x *DeleteSegmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSegmentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSegmentResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{30}
+	return file_admin_proto_rawDescGZIP(), []int{32}
 }
 
 // POST /admin/promotions/{id}/segments/shuffle-categories
@@ -1892,7 +2205,7 @@ type ShuffleSegmentCategoriesRequest struct {
 
 func (x *ShuffleSegmentCategoriesRequest) Reset() {
 	*x = ShuffleSegmentCategoriesRequest{}
-	mi := &file_admin_proto_msgTypes[31]
+	mi := &file_admin_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1904,7 +2217,7 @@ func (x *ShuffleSegmentCategoriesRequest) String() string {
 func (*ShuffleSegmentCategoriesRequest) ProtoMessage() {}
 
 func (x *ShuffleSegmentCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[31]
+	mi := &file_admin_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,7 +2230,7 @@ func (x *ShuffleSegmentCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShuffleSegmentCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*ShuffleSegmentCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{31}
+	return file_admin_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ShuffleSegmentCategoriesRequest) GetPromotionId() int64 {
@@ -1935,7 +2248,7 @@ type ShuffleSegmentCategoriesResponse struct {
 
 func (x *ShuffleSegmentCategoriesResponse) Reset() {
 	*x = ShuffleSegmentCategoriesResponse{}
-	mi := &file_admin_proto_msgTypes[32]
+	mi := &file_admin_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1947,7 +2260,7 @@ func (x *ShuffleSegmentCategoriesResponse) String() string {
 func (*ShuffleSegmentCategoriesResponse) ProtoMessage() {}
 
 func (x *ShuffleSegmentCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[32]
+	mi := &file_admin_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1960,34 +2273,33 @@ func (x *ShuffleSegmentCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShuffleSegmentCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*ShuffleSegmentCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{32}
+	return file_admin_proto_rawDescGZIP(), []int{34}
 }
 
-// --- Poll Admin ---
-// POST /admin/promotions/{id}/poll/generate
-type GeneratePollRequest struct {
+// GET /admin/promotions/{id}/segments/{segmentId}/texts
+type ListSegmentTextsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "questions" | "answer_tree"
+	SegmentId     int64                  `protobuf:"varint,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GeneratePollRequest) Reset() {
-	*x = GeneratePollRequest{}
-	mi := &file_admin_proto_msgTypes[33]
+func (x *ListSegmentTextsRequest) Reset() {
+	*x = ListSegmentTextsRequest{}
+	mi := &file_admin_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GeneratePollRequest) String() string {
+func (x *ListSegmentTextsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneratePollRequest) ProtoMessage() {}
+func (*ListSegmentTextsRequest) ProtoMessage() {}
 
-func (x *GeneratePollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[33]
+func (x *ListSegmentTextsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,48 +2310,50 @@ func (x *GeneratePollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeneratePollRequest.ProtoReflect.Descriptor instead.
-func (*GeneratePollRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListSegmentTextsRequest.ProtoReflect.Descriptor instead.
+func (*ListSegmentTextsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GeneratePollRequest) GetPromotionId() int64 {
+func (x *ListSegmentTextsRequest) GetPromotionId() int64 {
 	if x != nil {
 		return x.PromotionId
 	}
 	return 0
 }
 
-func (x *GeneratePollRequest) GetType() string {
+func (x *ListSegmentTextsRequest) GetSegmentId() int64 {
 	if x != nil {
-		return x.Type
+		return x.SegmentId
 	}
-	return ""
+	return 0
 }
 
-type GeneratePollResponse struct {
+type SegmentTextVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Questions     []*PollQuestionAdmin   `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
-	AnswerTree    []*AnswerTreeNode      `protobuf:"bytes,2,rep,name=answer_tree,json=answerTree,proto3" json:"answer_tree,omitempty"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                        // initial | ai | revert
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GeneratePollResponse) Reset() {
-	*x = GeneratePollResponse{}
-	mi := &file_admin_proto_msgTypes[34]
+func (x *SegmentTextVersion) Reset() {
+	*x = SegmentTextVersion{}
+	mi := &file_admin_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GeneratePollResponse) String() string {
+func (x *SegmentTextVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneratePollResponse) ProtoMessage() {}
+func (*SegmentTextVersion) ProtoMessage() {}
 
-func (x *GeneratePollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[34]
+func (x *SegmentTextVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2050,49 +2364,61 @@ func (x *GeneratePollResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeneratePollResponse.ProtoReflect.Descriptor instead.
-func (*GeneratePollResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SegmentTextVersion.ProtoReflect.Descriptor instead.
+func (*SegmentTextVersion) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GeneratePollResponse) GetQuestions() []*PollQuestionAdmin {
+func (x *SegmentTextVersion) GetVersion() int32 {
 	if x != nil {
-		return x.Questions
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *GeneratePollResponse) GetAnswerTree() []*AnswerTreeNode {
+func (x *SegmentTextVersion) GetText() string {
 	if x != nil {
-		return x.AnswerTree
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-// POST /admin/promotions/{id}/poll/questions
-type SetPollQuestionsRequest struct {
+func (x *SegmentTextVersion) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SegmentTextVersion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListSegmentTextsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
-	Questions     []*SetQuestionInput    `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+	Versions      []*SegmentTextVersion  `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` // новые первыми; первая — текущий текст
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPollQuestionsRequest) Reset() {
-	*x = SetPollQuestionsRequest{}
-	mi := &file_admin_proto_msgTypes[35]
+func (x *ListSegmentTextsResponse) Reset() {
+	*x = ListSegmentTextsResponse{}
+	mi := &file_admin_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPollQuestionsRequest) String() string {
+func (x *ListSegmentTextsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPollQuestionsRequest) ProtoMessage() {}
+func (*ListSegmentTextsResponse) ProtoMessage() {}
 
-func (x *SetPollQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[35]
+func (x *ListSegmentTextsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2103,48 +2429,43 @@ func (x *SetPollQuestionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPollQuestionsRequest.ProtoReflect.Descriptor instead.
-func (*SetPollQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *SetPollQuestionsRequest) GetPromotionId() int64 {
-	if x != nil {
-		return x.PromotionId
-	}
-	return 0
+// Deprecated: Use ListSegmentTextsResponse.ProtoReflect.Descriptor instead.
+func (*ListSegmentTextsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SetPollQuestionsRequest) GetQuestions() []*SetQuestionInput {
+func (x *ListSegmentTextsResponse) GetVersions() []*SegmentTextVersion {
 	if x != nil {
-		return x.Questions
+		return x.Versions
 	}
 	return nil
 }
 
-type SetQuestionInput struct {
+// POST /admin/promotions/{id}/segments/{segmentId}/texts/{version}/revert
+type RevertSegmentTextRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Options       []*SetOptionInput      `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	SegmentId     int64                  `protobuf:"varint,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetQuestionInput) Reset() {
-	*x = SetQuestionInput{}
-	mi := &file_admin_proto_msgTypes[36]
+func (x *RevertSegmentTextRequest) Reset() {
+	*x = RevertSegmentTextRequest{}
+	mi := &file_admin_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetQuestionInput) String() string {
+func (x *RevertSegmentTextRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetQuestionInput) ProtoMessage() {}
+func (*RevertSegmentTextRequest) ProtoMessage() {}
 
-func (x *SetQuestionInput) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[36]
+func (x *RevertSegmentTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2155,48 +2476,101 @@ func (x *SetQuestionInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetQuestionInput.ProtoReflect.Descriptor instead.
-func (*SetQuestionInput) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use RevertSegmentTextRequest.ProtoReflect.Descriptor instead.
+func (*RevertSegmentTextRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SetQuestionInput) GetText() string {
+func (x *RevertSegmentTextRequest) GetPromotionId() int64 {
 	if x != nil {
-		return x.Text
+		return x.PromotionId
 	}
-	return ""
+	return 0
 }
 
-func (x *SetQuestionInput) GetOptions() []*SetOptionInput {
+func (x *RevertSegmentTextRequest) GetSegmentId() int64 {
 	if x != nil {
-		return x.Options
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *RevertSegmentTextRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RevertSegmentTextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Current       *SegmentTextVersion    `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertSegmentTextResponse) Reset() {
+	*x = RevertSegmentTextResponse{}
+	mi := &file_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertSegmentTextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertSegmentTextResponse) ProtoMessage() {}
+
+func (x *RevertSegmentTextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertSegmentTextResponse.ProtoReflect.Descriptor instead.
+func (*RevertSegmentTextResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RevertSegmentTextResponse) GetCurrent() *SegmentTextVersion {
+	if x != nil {
+		return x.Current
 	}
 	return nil
 }
 
-type SetOptionInput struct {
+// --- Poll Admin ---
+// POST /admin/promotions/{id}/poll/generate
+type GeneratePollRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "questions" | "answer_tree"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetOptionInput) Reset() {
-	*x = SetOptionInput{}
-	mi := &file_admin_proto_msgTypes[37]
+func (x *GeneratePollRequest) Reset() {
+	*x = GeneratePollRequest{}
+	mi := &file_admin_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetOptionInput) String() string {
+func (x *GeneratePollRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetOptionInput) ProtoMessage() {}
+func (*GeneratePollRequest) ProtoMessage() {}
 
-func (x *SetOptionInput) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[37]
+func (x *GeneratePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2207,46 +2581,48 @@ func (x *SetOptionInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetOptionInput.ProtoReflect.Descriptor instead.
-func (*SetOptionInput) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GeneratePollRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePollRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SetOptionInput) GetText() string {
+func (x *GeneratePollRequest) GetPromotionId() int64 {
 	if x != nil {
-		return x.Text
+		return x.PromotionId
 	}
-	return ""
+	return 0
 }
 
-func (x *SetOptionInput) GetValue() string {
+func (x *GeneratePollRequest) GetType() string {
 	if x != nil {
-		return x.Value
+		return x.Type
 	}
 	return ""
 }
 
-type SetPollQuestionsResponse struct {
+type GeneratePollResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Questions     []*PollQuestionAdmin   `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	AnswerTree    []*AnswerTreeNode      `protobuf:"bytes,2,rep,name=answer_tree,json=answerTree,proto3" json:"answer_tree,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPollQuestionsResponse) Reset() {
-	*x = SetPollQuestionsResponse{}
-	mi := &file_admin_proto_msgTypes[38]
+func (x *GeneratePollResponse) Reset() {
+	*x = GeneratePollResponse{}
+	mi := &file_admin_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPollQuestionsResponse) String() string {
+func (x *GeneratePollResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPollQuestionsResponse) ProtoMessage() {}
+func (*GeneratePollResponse) ProtoMessage() {}
 
-func (x *SetPollQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[38]
+func (x *GeneratePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2257,35 +2633,49 @@ func (x *SetPollQuestionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPollQuestionsResponse.ProtoReflect.Descriptor instead.
-func (*SetPollQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GeneratePollResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePollResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{41}
 }
 
-// POST /admin/promotions/{id}/poll/answer-tree
-type SetAnswerTreeRequest struct {
+func (x *GeneratePollResponse) GetQuestions() []*PollQuestionAdmin {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *GeneratePollResponse) GetAnswerTree() []*AnswerTreeNode {
+	if x != nil {
+		return x.AnswerTree
+	}
+	return nil
+}
+
+// POST /admin/promotions/{id}/poll/questions
+type SetPollQuestionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
-	Nodes         []*AnswerTreeNode      `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Questions     []*SetQuestionInput    `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetAnswerTreeRequest) Reset() {
-	*x = SetAnswerTreeRequest{}
-	mi := &file_admin_proto_msgTypes[39]
+func (x *SetPollQuestionsRequest) Reset() {
+	*x = SetPollQuestionsRequest{}
+	mi := &file_admin_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAnswerTreeRequest) String() string {
+func (x *SetPollQuestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAnswerTreeRequest) ProtoMessage() {}
+func (*SetPollQuestionsRequest) ProtoMessage() {}
 
-func (x *SetAnswerTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[39]
+func (x *SetPollQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2296,46 +2686,2891 @@ func (x *SetAnswerTreeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAnswerTreeRequest.ProtoReflect.Descriptor instead.
-func (*SetAnswerTreeRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use SetPollQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*SetPollQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SetAnswerTreeRequest) GetPromotionId() int64 {
+func (x *SetPollQuestionsRequest) GetPromotionId() int64 {
 	if x != nil {
 		return x.PromotionId
 	}
 	return 0
 }
 
-func (x *SetAnswerTreeRequest) GetNodes() []*AnswerTreeNode {
+func (x *SetPollQuestionsRequest) GetQuestions() []*SetQuestionInput {
 	if x != nil {
-		return x.Nodes
+		return x.Questions
 	}
 	return nil
 }
 
-type SetAnswerTreeResponse struct {
+type SetQuestionInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Options       []*SetOptionInput      `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetAnswerTreeResponse) Reset() {
-	*x = SetAnswerTreeResponse{}
-	mi := &file_admin_proto_msgTypes[40]
+func (x *SetQuestionInput) Reset() {
+	*x = SetQuestionInput{}
+	mi := &file_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuestionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuestionInput) ProtoMessage() {}
+
+func (x *SetQuestionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQuestionInput.ProtoReflect.Descriptor instead.
+func (*SetQuestionInput) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetQuestionInput) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SetQuestionInput) GetOptions() []*SetOptionInput {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SetOptionInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOptionInput) Reset() {
+	*x = SetOptionInput{}
+	mi := &file_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOptionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOptionInput) ProtoMessage() {}
+
+func (x *SetOptionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOptionInput.ProtoReflect.Descriptor instead.
+func (*SetOptionInput) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetOptionInput) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SetOptionInput) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SetPollQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPollQuestionsResponse) Reset() {
+	*x = SetPollQuestionsResponse{}
+	mi := &file_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPollQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPollQuestionsResponse) ProtoMessage() {}
+
+func (x *SetPollQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPollQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*SetPollQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{45}
+}
+
+// POST /admin/promotions/{id}/poll/answer-tree
+type SetAnswerTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Nodes         []*AnswerTreeNode      `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnswerTreeRequest) Reset() {
+	*x = SetAnswerTreeRequest{}
+	mi := &file_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnswerTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnswerTreeRequest) ProtoMessage() {}
+
+func (x *SetAnswerTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnswerTreeRequest.ProtoReflect.Descriptor instead.
+func (*SetAnswerTreeRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetAnswerTreeRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *SetAnswerTreeRequest) GetNodes() []*AnswerTreeNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type SetAnswerTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnswerTreeResponse) Reset() {
+	*x = SetAnswerTreeResponse{}
+	mi := &file_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnswerTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnswerTreeResponse) ProtoMessage() {}
+
+func (x *SetAnswerTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnswerTreeResponse.ProtoReflect.Descriptor instead.
+func (*SetAnswerTreeResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{47}
+}
+
+// POST /admin/promotions/{id}/poll/simulate
+type SimulateIdentificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	OptionIds     []int64                `protobuf:"varint,2,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"` // optional: empty = enumerate all paths
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateIdentificationRequest) Reset() {
+	*x = SimulateIdentificationRequest{}
+	mi := &file_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateIdentificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateIdentificationRequest) ProtoMessage() {}
+
+func (x *SimulateIdentificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateIdentificationRequest.ProtoReflect.Descriptor instead.
+func (*SimulateIdentificationRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SimulateIdentificationRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *SimulateIdentificationRequest) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+type SimulationStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    int64                  `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionText  string                 `protobuf:"bytes,2,opt,name=question_text,json=questionText,proto3" json:"question_text,omitempty"`
+	OptionId      int64                  `protobuf:"varint,3,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	OptionText    string                 `protobuf:"bytes,4,opt,name=option_text,json=optionText,proto3" json:"option_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationStep) Reset() {
+	*x = SimulationStep{}
+	mi := &file_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationStep) ProtoMessage() {}
+
+func (x *SimulationStep) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationStep.ProtoReflect.Descriptor instead.
+func (*SimulationStep) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SimulationStep) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *SimulationStep) GetQuestionText() string {
+	if x != nil {
+		return x.QuestionText
+	}
+	return ""
+}
+
+func (x *SimulationStep) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *SimulationStep) GetOptionText() string {
+	if x != nil {
+		return x.OptionText
+	}
+	return ""
+}
+
+type SimulationPath struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Steps             []*SimulationStep      `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	ResultSegmentId   int64                  `protobuf:"varint,2,opt,name=result_segment_id,json=resultSegmentId,proto3" json:"result_segment_id,omitempty"` // 0 if the path did not reach a segment
+	ResultSegmentName string                 `protobuf:"bytes,3,opt,name=result_segment_name,json=resultSegmentName,proto3" json:"result_segment_name,omitempty"`
+	NextQuestionId    int64                  `protobuf:"varint,4,opt,name=next_question_id,json=nextQuestionId,proto3" json:"next_question_id,omitempty"`
+	Loop              bool                   `protobuf:"varint,5,opt,name=loop,proto3" json:"loop,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SimulationPath) Reset() {
+	*x = SimulationPath{}
+	mi := &file_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationPath) ProtoMessage() {}
+
+func (x *SimulationPath) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationPath.ProtoReflect.Descriptor instead.
+func (*SimulationPath) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SimulationPath) GetSteps() []*SimulationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *SimulationPath) GetResultSegmentId() int64 {
+	if x != nil {
+		return x.ResultSegmentId
+	}
+	return 0
+}
+
+func (x *SimulationPath) GetResultSegmentName() string {
+	if x != nil {
+		return x.ResultSegmentName
+	}
+	return ""
+}
+
+func (x *SimulationPath) GetNextQuestionId() int64 {
+	if x != nil {
+		return x.NextQuestionId
+	}
+	return 0
+}
+
+func (x *SimulationPath) GetLoop() bool {
+	if x != nil {
+		return x.Loop
+	}
+	return false
+}
+
+type SegmentCoverage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SegmentId     int64                  `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Paths         int32                  `protobuf:"varint,3,opt,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentCoverage) Reset() {
+	*x = SegmentCoverage{}
+	mi := &file_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentCoverage) ProtoMessage() {}
+
+func (x *SegmentCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentCoverage.ProtoReflect.Descriptor instead.
+func (*SegmentCoverage) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SegmentCoverage) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *SegmentCoverage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SegmentCoverage) GetPaths() int32 {
+	if x != nil {
+		return x.Paths
+	}
+	return 0
+}
+
+type SimulateIdentificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"` // questions | user_profile
+	Paths         []*SimulationPath      `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	Coverage      []*SegmentCoverage     `protobuf:"bytes,3,rep,name=coverage,proto3" json:"coverage,omitempty"`
+	Truncated     bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateIdentificationResponse) Reset() {
+	*x = SimulateIdentificationResponse{}
+	mi := &file_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateIdentificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateIdentificationResponse) ProtoMessage() {}
+
+func (x *SimulateIdentificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateIdentificationResponse.ProtoReflect.Descriptor instead.
+func (*SimulateIdentificationResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SimulateIdentificationResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SimulateIdentificationResponse) GetPaths() []*SimulationPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *SimulateIdentificationResponse) GetCoverage() []*SegmentCoverage {
+	if x != nil {
+		return x.Coverage
+	}
+	return nil
+}
+
+func (x *SimulateIdentificationResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// --- Moderation ---
+// GET /admin/promotions/{id}/moderation/applications
+type GetModerationApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // optional: pending | approved | rejected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationApplicationsRequest) Reset() {
+	*x = GetModerationApplicationsRequest{}
+	mi := &file_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationApplicationsRequest) ProtoMessage() {}
+
+func (x *GetModerationApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetModerationApplicationsRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *GetModerationApplicationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ModerationApplication struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SellerId      int64                  `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	SegmentId     int64                  `protobuf:"varint,3,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	SlotId        int64                  `protobuf:"varint,4,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,5,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	Discount      int32                  `protobuf:"varint,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	StopFactors   []string               `protobuf:"bytes,8,rep,name=stop_factors,json=stopFactors,proto3" json:"stop_factors,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Image         string                 `protobuf:"bytes,10,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationApplication) Reset() {
+	*x = ModerationApplication{}
+	mi := &file_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationApplication) ProtoMessage() {}
+
+func (x *ModerationApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationApplication.ProtoReflect.Descriptor instead.
+func (*ModerationApplication) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ModerationApplication) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerationApplication) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *ModerationApplication) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *ModerationApplication) GetSlotId() int64 {
+	if x != nil {
+		return x.SlotId
+	}
+	return 0
+}
+
+func (x *ModerationApplication) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ModerationApplication) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ModerationApplication) GetDiscount() int32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *ModerationApplication) GetStopFactors() []string {
+	if x != nil {
+		return x.StopFactors
+	}
+	return nil
+}
+
+func (x *ModerationApplication) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerationApplication) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type GetModerationApplicationsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Applications  []*ModerationApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationApplicationsResponse) Reset() {
+	*x = GetModerationApplicationsResponse{}
+	mi := &file_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationApplicationsResponse) ProtoMessage() {}
+
+func (x *GetModerationApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*GetModerationApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetModerationApplicationsResponse) GetApplications() []*ModerationApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+// POST /admin/moderation/{applicationId}/approve
+type ApproveModerationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId int64                  `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveModerationRequest) Reset() {
+	*x = ApproveModerationRequest{}
+	mi := &file_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveModerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveModerationRequest) ProtoMessage() {}
+
+func (x *ApproveModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveModerationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveModerationRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ApproveModerationRequest) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+type ApproveModerationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveModerationResponse) Reset() {
+	*x = ApproveModerationResponse{}
+	mi := &file_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveModerationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveModerationResponse) ProtoMessage() {}
+
+func (x *ApproveModerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveModerationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveModerationResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{57}
+}
+
+// POST /admin/moderation/{applicationId}/reject
+type RejectModerationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId int64                  `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectModerationRequest) Reset() {
+	*x = RejectModerationRequest{}
+	mi := &file_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectModerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectModerationRequest) ProtoMessage() {}
+
+func (x *RejectModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectModerationRequest.ProtoReflect.Descriptor instead.
+func (*RejectModerationRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RejectModerationRequest) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *RejectModerationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectModerationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectModerationResponse) Reset() {
+	*x = RejectModerationResponse{}
+	mi := &file_admin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectModerationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectModerationResponse) ProtoMessage() {}
+
+func (x *RejectModerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectModerationResponse.ProtoReflect.Descriptor instead.
+func (*RejectModerationResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{59}
+}
+
+// --- Analytics ---
+// GET /admin/promotions/{id}/analytics/funnel
+type GetPromotionFunnelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // RFC3339, по умолчанию — 30 дней назад
+	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // RFC3339, по умолчанию — сейчас
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromotionFunnelRequest) Reset() {
+	*x = GetPromotionFunnelRequest{}
+	mi := &file_admin_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromotionFunnelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromotionFunnelRequest) ProtoMessage() {}
+
+func (x *GetPromotionFunnelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromotionFunnelRequest.ProtoReflect.Descriptor instead.
+func (*GetPromotionFunnelRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetPromotionFunnelRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *GetPromotionFunnelRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetPromotionFunnelRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+type FunnelStages struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Views           int64                  `protobuf:"varint,1,opt,name=views,proto3" json:"views,omitempty"`
+	QuizStarts      int64                  `protobuf:"varint,2,opt,name=quiz_starts,json=quizStarts,proto3" json:"quiz_starts,omitempty"`
+	Completions     int64                  `protobuf:"varint,3,opt,name=completions,proto3" json:"completions,omitempty"` // начали опрос и получили сегмент
+	SegmentResolved int64                  `protobuf:"varint,4,opt,name=segment_resolved,json=segmentResolved,proto3" json:"segment_resolved,omitempty"`
+	ProductClicks   int64                  `protobuf:"varint,5,opt,name=product_clicks,json=productClicks,proto3" json:"product_clicks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FunnelStages) Reset() {
+	*x = FunnelStages{}
+	mi := &file_admin_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FunnelStages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunnelStages) ProtoMessage() {}
+
+func (x *FunnelStages) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunnelStages.ProtoReflect.Descriptor instead.
+func (*FunnelStages) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FunnelStages) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetQuizStarts() int64 {
+	if x != nil {
+		return x.QuizStarts
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetCompletions() int64 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetSegmentResolved() int64 {
+	if x != nil {
+		return x.SegmentResolved
+	}
+	return 0
+}
+
+func (x *FunnelStages) GetProductClicks() int64 {
+	if x != nil {
+		return x.ProductClicks
+	}
+	return 0
+}
+
+type SegmentFunnel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SegmentId     int64                  `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	SegmentName   string                 `protobuf:"bytes,2,opt,name=segment_name,json=segmentName,proto3" json:"segment_name,omitempty"`
+	Stages        *FunnelStages          `protobuf:"bytes,3,opt,name=stages,proto3" json:"stages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentFunnel) Reset() {
+	*x = SegmentFunnel{}
+	mi := &file_admin_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentFunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentFunnel) ProtoMessage() {}
+
+func (x *SegmentFunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentFunnel.ProtoReflect.Descriptor instead.
+func (*SegmentFunnel) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SegmentFunnel) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *SegmentFunnel) GetSegmentName() string {
+	if x != nil {
+		return x.SegmentName
+	}
+	return ""
+}
+
+func (x *SegmentFunnel) GetStages() *FunnelStages {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+type GetPromotionFunnelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	Total         *FunnelStages          `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Segments      []*SegmentFunnel       `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromotionFunnelResponse) Reset() {
+	*x = GetPromotionFunnelResponse{}
+	mi := &file_admin_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromotionFunnelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromotionFunnelResponse) ProtoMessage() {}
+
+func (x *GetPromotionFunnelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromotionFunnelResponse.ProtoReflect.Descriptor instead.
+func (*GetPromotionFunnelResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetPromotionFunnelResponse) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *GetPromotionFunnelResponse) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetPromotionFunnelResponse) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetPromotionFunnelResponse) GetTotal() *FunnelStages {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetPromotionFunnelResponse) GetSegments() []*SegmentFunnel {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// GET /admin/promotions/{id}/analytics/segments
+type GetSegmentDistributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentDistributionRequest) Reset() {
+	*x = GetSegmentDistributionRequest{}
+	mi := &file_admin_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentDistributionRequest) ProtoMessage() {}
+
+func (x *GetSegmentDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentDistributionRequest.ProtoReflect.Descriptor instead.
+func (*GetSegmentDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetSegmentDistributionRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *GetSegmentDistributionRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetSegmentDistributionRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+type SegmentShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SegmentId     int64                  `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	SegmentName   string                 `protobuf:"bytes,2,opt,name=segment_name,json=segmentName,proto3" json:"segment_name,omitempty"`
+	Sessions      int64                  `protobuf:"varint,3,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	Share         float64                `protobuf:"fixed64,4,opt,name=share,proto3" json:"share,omitempty"` // 0..1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentShare) Reset() {
+	*x = SegmentShare{}
+	mi := &file_admin_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentShare) ProtoMessage() {}
+
+func (x *SegmentShare) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentShare.ProtoReflect.Descriptor instead.
+func (*SegmentShare) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SegmentShare) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *SegmentShare) GetSegmentName() string {
+	if x != nil {
+		return x.SegmentName
+	}
+	return ""
+}
+
+func (x *SegmentShare) GetSessions() int64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *SegmentShare) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+type GetSegmentDistributionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Segments      []*SegmentShare        `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSegmentDistributionResponse) Reset() {
+	*x = GetSegmentDistributionResponse{}
+	mi := &file_admin_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSegmentDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSegmentDistributionResponse) ProtoMessage() {}
+
+func (x *GetSegmentDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSegmentDistributionResponse.ProtoReflect.Descriptor instead.
+func (*GetSegmentDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetSegmentDistributionResponse) GetSegments() []*SegmentShare {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// GET /admin/promotions/{id}/analytics/timeseries
+type GetEventTimeSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	Bucket        string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`                           // hour | day (по умолчанию day)
+	EventTypes    []string               `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // пусто — все типы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventTimeSeriesRequest) Reset() {
+	*x = GetEventTimeSeriesRequest{}
+	mi := &file_admin_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetEventTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetEventTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetEventTimeSeriesRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *GetEventTimeSeriesRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetEventTimeSeriesRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetEventTimeSeriesRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *GetEventTimeSeriesRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type TimeSeriesPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart   string                 `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"` // RFC3339
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeSeriesPoint) Reset() {
+	*x = TimeSeriesPoint{}
+	mi := &file_admin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSeriesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSeriesPoint) ProtoMessage() {}
+
+func (x *TimeSeriesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSeriesPoint.ProtoReflect.Descriptor instead.
+func (*TimeSeriesPoint) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *TimeSeriesPoint) GetBucketStart() string {
+	if x != nil {
+		return x.BucketStart
+	}
+	return ""
+}
+
+func (x *TimeSeriesPoint) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *TimeSeriesPoint) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetEventTimeSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*TimeSeriesPoint     `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventTimeSeriesResponse) Reset() {
+	*x = GetEventTimeSeriesResponse{}
+	mi := &file_admin_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetEventTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetEventTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetEventTimeSeriesResponse) GetPoints() []*TimeSeriesPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// GET /admin/analytics/promotions/compare
+type ComparePromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionIds  []int64                `protobuf:"varint,1,rep,packed,name=promotion_ids,json=promotionIds,proto3" json:"promotion_ids,omitempty"` // только COMPLETED; пусто — последние завершённые
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparePromotionsRequest) Reset() {
+	*x = ComparePromotionsRequest{}
+	mi := &file_admin_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparePromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePromotionsRequest) ProtoMessage() {}
+
+func (x *ComparePromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ComparePromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ComparePromotionsRequest) GetPromotionIds() []int64 {
+	if x != nil {
+		return x.PromotionIds
+	}
+	return nil
+}
+
+type PromotionCohort struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId          int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Theme                string                 `protobuf:"bytes,3,opt,name=theme,proto3" json:"theme,omitempty"`
+	IdentificationMode   string                 `protobuf:"bytes,4,opt,name=identification_mode,json=identificationMode,proto3" json:"identification_mode,omitempty"`
+	PricingModel         string                 `protobuf:"bytes,5,opt,name=pricing_model,json=pricingModel,proto3" json:"pricing_model,omitempty"`
+	DateFrom             string                 `protobuf:"bytes,6,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo               string                 `protobuf:"bytes,7,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	TotalSlots           int64                  `protobuf:"varint,8,opt,name=total_slots,json=totalSlots,proto3" json:"total_slots,omitempty"`
+	FilledSlots          int64                  `protobuf:"varint,9,opt,name=filled_slots,json=filledSlots,proto3" json:"filled_slots,omitempty"`
+	FillRate             float64                `protobuf:"fixed64,10,opt,name=fill_rate,json=fillRate,proto3" json:"fill_rate,omitempty"`                           // 0..1
+	AvgClearingPrice     float64                `protobuf:"fixed64,11,opt,name=avg_clearing_price,json=avgClearingPrice,proto3" json:"avg_clearing_price,omitempty"` // средняя оплаченная цена занятого слота
+	Bets                 int64                  `protobuf:"varint,12,opt,name=bets,proto3" json:"bets,omitempty"`
+	Bidders              int64                  `protobuf:"varint,13,opt,name=bidders,proto3" json:"bidders,omitempty"`
+	ApprovedApplications int64                  `protobuf:"varint,14,opt,name=approved_applications,json=approvedApplications,proto3" json:"approved_applications,omitempty"`
+	RejectedApplications int64                  `protobuf:"varint,15,opt,name=rejected_applications,json=rejectedApplications,proto3" json:"rejected_applications,omitempty"`
+	RejectionRate        float64                `protobuf:"fixed64,16,opt,name=rejection_rate,json=rejectionRate,proto3" json:"rejection_rate,omitempty"` // rejected / (approved + rejected)
+	ViewSessions         int64                  `protobuf:"varint,17,opt,name=view_sessions,json=viewSessions,proto3" json:"view_sessions,omitempty"`
+	ResolvedSessions     int64                  `protobuf:"varint,18,opt,name=resolved_sessions,json=resolvedSessions,proto3" json:"resolved_sessions,omitempty"`
+	ClickSessions        int64                  `protobuf:"varint,19,opt,name=click_sessions,json=clickSessions,proto3" json:"click_sessions,omitempty"`
+	QuizCompletionRate   float64                `protobuf:"fixed64,20,opt,name=quiz_completion_rate,json=quizCompletionRate,proto3" json:"quiz_completion_rate,omitempty"` // resolved / view sessions
+	BuyerConversion      float64                `protobuf:"fixed64,21,opt,name=buyer_conversion,json=buyerConversion,proto3" json:"buyer_conversion,omitempty"`            // click / view sessions
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PromotionCohort) Reset() {
+	*x = PromotionCohort{}
+	mi := &file_admin_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionCohort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionCohort) ProtoMessage() {}
+
+func (x *PromotionCohort) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionCohort.ProtoReflect.Descriptor instead.
+func (*PromotionCohort) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PromotionCohort) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromotionCohort) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *PromotionCohort) GetIdentificationMode() string {
+	if x != nil {
+		return x.IdentificationMode
+	}
+	return ""
+}
+
+func (x *PromotionCohort) GetPricingModel() string {
+	if x != nil {
+		return x.PricingModel
+	}
+	return ""
+}
+
+func (x *PromotionCohort) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *PromotionCohort) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *PromotionCohort) GetTotalSlots() int64 {
+	if x != nil {
+		return x.TotalSlots
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetFilledSlots() int64 {
+	if x != nil {
+		return x.FilledSlots
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetFillRate() float64 {
+	if x != nil {
+		return x.FillRate
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetAvgClearingPrice() float64 {
+	if x != nil {
+		return x.AvgClearingPrice
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetBets() int64 {
+	if x != nil {
+		return x.Bets
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetBidders() int64 {
+	if x != nil {
+		return x.Bidders
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetApprovedApplications() int64 {
+	if x != nil {
+		return x.ApprovedApplications
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetRejectedApplications() int64 {
+	if x != nil {
+		return x.RejectedApplications
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetRejectionRate() float64 {
+	if x != nil {
+		return x.RejectionRate
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetViewSessions() int64 {
+	if x != nil {
+		return x.ViewSessions
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetResolvedSessions() int64 {
+	if x != nil {
+		return x.ResolvedSessions
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetClickSessions() int64 {
+	if x != nil {
+		return x.ClickSessions
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetQuizCompletionRate() float64 {
+	if x != nil {
+		return x.QuizCompletionRate
+	}
+	return 0
+}
+
+func (x *PromotionCohort) GetBuyerConversion() float64 {
+	if x != nil {
+		return x.BuyerConversion
+	}
+	return 0
+}
+
+type ComparePromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*PromotionCohort     `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparePromotionsResponse) Reset() {
+	*x = ComparePromotionsResponse{}
+	mi := &file_admin_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparePromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparePromotionsResponse) ProtoMessage() {}
+
+func (x *ComparePromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparePromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ComparePromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ComparePromotionsResponse) GetPromotions() []*PromotionCohort {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+// --- AI audit ---
+// GET /admin/ai/generations
+type ListAIGenerationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"` // themes | segments | questions | answer_tree | text
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                     // ok | invalid | failed | cached
+	DateFrom      string                 `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // RFC3339
+	DateTo        string                 `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // RFC3339
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`                        // с 1
+	PerPage       int32                  `protobuf:"varint,7,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`   // по умолчанию 50, максимум 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIGenerationsRequest) Reset() {
+	*x = ListAIGenerationsRequest{}
+	mi := &file_admin_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIGenerationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIGenerationsRequest) ProtoMessage() {}
+
+func (x *ListAIGenerationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIGenerationsRequest.ProtoReflect.Descriptor instead.
+func (*ListAIGenerationsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListAIGenerationsRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ListAIGenerationsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListAIGenerationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListAIGenerationsRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ListAIGenerationsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *ListAIGenerationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAIGenerationsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type AIGeneration struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operation        string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Provider         string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model            string                 `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	LatencyMs        int64                  `protobuf:"varint,6,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	PromptTokens     int64                  `protobuf:"varint,7,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                  `protobuf:"varint,8,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	TotalTokens      int64                  `protobuf:"varint,9,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	CostUsd          float64                `protobuf:"fixed64,10,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	Prompt           string                 `protobuf:"bytes,11,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Response         string                 `protobuf:"bytes,12,opt,name=response,proto3" json:"response,omitempty"`
+	Error            string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AIGeneration) Reset() {
+	*x = AIGeneration{}
+	mi := &file_admin_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIGeneration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIGeneration) ProtoMessage() {}
+
+func (x *AIGeneration) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIGeneration.ProtoReflect.Descriptor instead.
+func (*AIGeneration) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AIGeneration) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AIGeneration) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AIGeneration) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *AIGeneration) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AIGeneration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AIGeneration) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *AIGeneration) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *AIGeneration) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *AIGeneration) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *AIGeneration) GetCostUsd() float64 {
+	if x != nil {
+		return x.CostUsd
+	}
+	return 0
+}
+
+func (x *AIGeneration) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *AIGeneration) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *AIGeneration) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AIGeneration) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListAIGenerationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AIGeneration        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAIGenerationsResponse) Reset() {
+	*x = ListAIGenerationsResponse{}
+	mi := &file_admin_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAIGenerationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAIGenerationsResponse) ProtoMessage() {}
+
+func (x *ListAIGenerationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAIGenerationsResponse.ProtoReflect.Descriptor instead.
+func (*ListAIGenerationsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListAIGenerationsResponse) GetItems() []*AIGeneration {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAIGenerationsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListAIGenerationsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAIGenerationsResponse) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+// GET /admin/ai/usage
+type GetAIUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DateFrom      string                 `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // RFC3339, по умолчанию — 30 дней назад
+	DateTo        string                 `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // RFC3339, по умолчанию — сейчас
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAIUsageRequest) Reset() {
+	*x = GetAIUsageRequest{}
+	mi := &file_admin_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAIUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIUsageRequest) ProtoMessage() {}
+
+func (x *GetAIUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAIUsageRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetAIUsageRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetAIUsageRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+type AIUsageDay struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Day              string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"` // YYYY-MM-DD, UTC
+	Provider         string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model            string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Calls            int64                  `protobuf:"varint,4,opt,name=calls,proto3" json:"calls,omitempty"`
+	FailedCalls      int64                  `protobuf:"varint,5,opt,name=failed_calls,json=failedCalls,proto3" json:"failed_calls,omitempty"` // ошибки провайдера и невалидные ответы
+	CachedCalls      int64                  `protobuf:"varint,6,opt,name=cached_calls,json=cachedCalls,proto3" json:"cached_calls,omitempty"`
+	PromptTokens     int64                  `protobuf:"varint,7,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int64                  `protobuf:"varint,8,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	TotalTokens      int64                  `protobuf:"varint,9,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	CostUsd          float64                `protobuf:"fixed64,10,opt,name=cost_usd,json=costUsd,proto3" json:"cost_usd,omitempty"`
+	AvgLatencyMs     float64                `protobuf:"fixed64,11,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AIUsageDay) Reset() {
+	*x = AIUsageDay{}
+	mi := &file_admin_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AIUsageDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIUsageDay) ProtoMessage() {}
+
+func (x *AIUsageDay) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIUsageDay.ProtoReflect.Descriptor instead.
+func (*AIUsageDay) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AIUsageDay) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *AIUsageDay) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *AIUsageDay) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AIUsageDay) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *AIUsageDay) GetFailedCalls() int64 {
+	if x != nil {
+		return x.FailedCalls
+	}
+	return 0
+}
+
+func (x *AIUsageDay) GetCachedCalls() int64 {
+	if x != nil {
+		return x.CachedCalls
+	}
+	return 0
+}
+
+func (x *AIUsageDay) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *AIUsageDay) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *AIUsageDay) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *AIUsageDay) GetCostUsd() float64 {
+	if x != nil {
+		return x.CostUsd
+	}
+	return 0
+}
+
+func (x *AIUsageDay) GetAvgLatencyMs() float64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+type GetAIUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*AIUsageDay          `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	TotalTokens   int64                  `protobuf:"varint,2,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	TotalCostUsd  float64                `protobuf:"fixed64,3,opt,name=total_cost_usd,json=totalCostUsd,proto3" json:"total_cost_usd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAIUsageResponse) Reset() {
+	*x = GetAIUsageResponse{}
+	mi := &file_admin_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAIUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIUsageResponse) ProtoMessage() {}
+
+func (x *GetAIUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetAIUsageResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetAIUsageResponse) GetDays() []*AIUsageDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetAIUsageResponse) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *GetAIUsageResponse) GetTotalCostUsd() float64 {
+	if x != nil {
+		return x.TotalCostUsd
+	}
+	return 0
+}
+
+// --- Prompt templates ---
+// GET /admin/ai/prompts
+type ListPromptTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptTemplatesRequest) Reset() {
+	*x = ListPromptTemplatesRequest{}
+	mi := &file_admin_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptTemplatesRequest) ProtoMessage() {}
+
+func (x *ListPromptTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{79}
+}
+
+type PromptTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                         // themes | segments | questions | answer_tree | text
+	Variables     []string               `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`                               // переменные шаблона: {{.context}}, {{.limit}}...
+	ActiveVersion int32                  `protobuf:"varint,3,opt,name=active_version,json=activeVersion,proto3" json:"active_version,omitempty"` // 0 — встроенный промпт
+	LatestVersion int32                  `protobuf:"varint,4,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromptTemplate) Reset() {
+	*x = PromptTemplate{}
+	mi := &file_admin_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromptTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptTemplate) ProtoMessage() {}
+
+func (x *PromptTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptTemplate.ProtoReflect.Descriptor instead.
+func (*PromptTemplate) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PromptTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptTemplate) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *PromptTemplate) GetActiveVersion() int32 {
+	if x != nil {
+		return x.ActiveVersion
+	}
+	return 0
+}
+
+func (x *PromptTemplate) GetLatestVersion() int32 {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return 0
+}
+
+type ListPromptTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*PromptTemplate      `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptTemplatesResponse) Reset() {
+	*x = ListPromptTemplatesResponse{}
+	mi := &file_admin_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptTemplatesResponse) ProtoMessage() {}
+
+func (x *ListPromptTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListPromptTemplatesResponse) GetTemplates() []*PromptTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type PromptTemplateVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 0 — встроенный промпт
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`        // text/template
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339; пусто для встроенного промпта
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromptTemplateVersion) Reset() {
+	*x = PromptTemplateVersion{}
+	mi := &file_admin_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromptTemplateVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptTemplateVersion) ProtoMessage() {}
+
+func (x *PromptTemplateVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptTemplateVersion.ProtoReflect.Descriptor instead.
+func (*PromptTemplateVersion) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PromptTemplateVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptTemplateVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PromptTemplateVersion) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *PromptTemplateVersion) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *PromptTemplateVersion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *PromptTemplateVersion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// GET /admin/ai/prompts/{name}/versions
+type ListPromptTemplateVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptTemplateVersionsRequest) Reset() {
+	*x = ListPromptTemplateVersionsRequest{}
+	mi := &file_admin_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptTemplateVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptTemplateVersionsRequest) ProtoMessage() {}
+
+func (x *ListPromptTemplateVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptTemplateVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptTemplateVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListPromptTemplateVersionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListPromptTemplateVersionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Versions      []*PromptTemplateVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"` // новые первыми, встроенный последним
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptTemplateVersionsResponse) Reset() {
+	*x = ListPromptTemplateVersionsResponse{}
+	mi := &file_admin_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptTemplateVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptTemplateVersionsResponse) ProtoMessage() {}
+
+func (x *ListPromptTemplateVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptTemplateVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptTemplateVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListPromptTemplateVersionsResponse) GetVersions() []*PromptTemplateVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// POST /admin/ai/prompts/{name}/versions
+type CreatePromptTemplateVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Activate      bool                   `protobuf:"varint,4,opt,name=activate,proto3" json:"activate,omitempty"` // сразу сделать активной
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromptTemplateVersionRequest) Reset() {
+	*x = CreatePromptTemplateVersionRequest{}
+	mi := &file_admin_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromptTemplateVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptTemplateVersionRequest) ProtoMessage() {}
+
+func (x *CreatePromptTemplateVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptTemplateVersionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromptTemplateVersionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreatePromptTemplateVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePromptTemplateVersionRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreatePromptTemplateVersionRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreatePromptTemplateVersionRequest) GetActivate() bool {
+	if x != nil {
+		return x.Activate
+	}
+	return false
+}
+
+type CreatePromptTemplateVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *PromptTemplateVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromptTemplateVersionResponse) Reset() {
+	*x = CreatePromptTemplateVersionResponse{}
+	mi := &file_admin_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromptTemplateVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptTemplateVersionResponse) ProtoMessage() {}
+
+func (x *CreatePromptTemplateVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptTemplateVersionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromptTemplateVersionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreatePromptTemplateVersionResponse) GetVersion() *PromptTemplateVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+// POST /admin/ai/prompts/{name}/versions/{version}/activate
+type ActivatePromptTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 0 — откат на встроенный промпт
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivatePromptTemplateRequest) Reset() {
+	*x = ActivatePromptTemplateRequest{}
+	mi := &file_admin_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAnswerTreeResponse) String() string {
+func (x *ActivatePromptTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAnswerTreeResponse) ProtoMessage() {}
+func (*ActivatePromptTemplateRequest) ProtoMessage() {}
 
-func (x *SetAnswerTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[40]
+func (x *ActivatePromptTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2346,36 +5581,47 @@ func (x *SetAnswerTreeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAnswerTreeResponse.ProtoReflect.Descriptor instead.
-func (*SetAnswerTreeResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ActivatePromptTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ActivatePromptTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{87}
 }
 
-// --- Moderation ---
-// GET /admin/promotions/{id}/moderation/applications
-type GetModerationApplicationsRequest struct {
+func (x *ActivatePromptTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivatePromptTemplateRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ActivatePromptTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // optional: pending | approved | rejected
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModerationApplicationsRequest) Reset() {
-	*x = GetModerationApplicationsRequest{}
-	mi := &file_admin_proto_msgTypes[41]
+func (x *ActivatePromptTemplateResponse) Reset() {
+	*x = ActivatePromptTemplateResponse{}
+	mi := &file_admin_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModerationApplicationsRequest) String() string {
+func (x *ActivatePromptTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModerationApplicationsRequest) ProtoMessage() {}
+func (*ActivatePromptTemplateResponse) ProtoMessage() {}
 
-func (x *GetModerationApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[41]
+func (x *ActivatePromptTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2386,56 +5632,42 @@ func (x *GetModerationApplicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModerationApplicationsRequest.ProtoReflect.Descriptor instead.
-func (*GetModerationApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *GetModerationApplicationsRequest) GetPromotionId() int64 {
-	if x != nil {
-		return x.PromotionId
-	}
-	return 0
+// Deprecated: Use ActivatePromptTemplateResponse.ProtoReflect.Descriptor instead.
+func (*ActivatePromptTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetModerationApplicationsRequest) GetStatus() string {
+func (x *ActivatePromptTemplateResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Status
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type ModerationApplication struct {
+type PromotionPromptPin struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SellerId      int64                  `protobuf:"varint,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
-	SegmentId     int64                  `protobuf:"varint,3,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
-	SlotId        int64                  `protobuf:"varint,4,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,5,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
-	Discount      int32                  `protobuf:"varint,7,opt,name=discount,proto3" json:"discount,omitempty"`
-	StopFactors   []string               `protobuf:"bytes,8,rep,name=stop_factors,json=stopFactors,proto3" json:"stop_factors,omitempty"`
-	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	Image         string                 `protobuf:"bytes,10,opt,name=image,proto3" json:"image,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModerationApplication) Reset() {
-	*x = ModerationApplication{}
-	mi := &file_admin_proto_msgTypes[42]
+func (x *PromotionPromptPin) Reset() {
+	*x = PromotionPromptPin{}
+	mi := &file_admin_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModerationApplication) String() string {
+func (x *PromotionPromptPin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModerationApplication) ProtoMessage() {}
+func (*PromotionPromptPin) ProtoMessage() {}
 
-func (x *ModerationApplication) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[42]
+func (x *PromotionPromptPin) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2446,103 +5678,99 @@ func (x *ModerationApplication) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModerationApplication.ProtoReflect.Descriptor instead.
-func (*ModerationApplication) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use PromotionPromptPin.ProtoReflect.Descriptor instead.
+func (*PromotionPromptPin) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *ModerationApplication) GetId() int64 {
+func (x *PromotionPromptPin) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ModerationApplication) GetSellerId() int64 {
+func (x *PromotionPromptPin) GetVersion() int32 {
 	if x != nil {
-		return x.SellerId
+		return x.Version
 	}
 	return 0
 }
 
-func (x *ModerationApplication) GetSegmentId() int64 {
+func (x *PromotionPromptPin) GetCreatedAt() string {
 	if x != nil {
-		return x.SegmentId
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *ModerationApplication) GetSlotId() int64 {
-	if x != nil {
-		return x.SlotId
-	}
-	return 0
+// GET /admin/promotions/{promotion_id}/prompts
+type ListPromotionPromptPinsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModerationApplication) GetProductName() string {
-	if x != nil {
-		return x.ProductName
-	}
-	return ""
+func (x *ListPromotionPromptPinsRequest) Reset() {
+	*x = ListPromotionPromptPinsRequest{}
+	mi := &file_admin_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModerationApplication) GetPrice() int64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+func (x *ListPromotionPromptPinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ModerationApplication) GetDiscount() int32 {
-	if x != nil {
-		return x.Discount
-	}
-	return 0
-}
+func (*ListPromotionPromptPinsRequest) ProtoMessage() {}
 
-func (x *ModerationApplication) GetStopFactors() []string {
+func (x *ListPromotionPromptPinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[90]
 	if x != nil {
-		return x.StopFactors
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ModerationApplication) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use ListPromotionPromptPinsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionPromptPinsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *ModerationApplication) GetImage() string {
+func (x *ListPromotionPromptPinsRequest) GetPromotionId() int64 {
 	if x != nil {
-		return x.Image
+		return x.PromotionId
 	}
-	return ""
+	return 0
 }
 
-type GetModerationApplicationsResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Applications  []*ModerationApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+type ListPromotionPromptPinsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pins          []*PromotionPromptPin  `protobuf:"bytes,1,rep,name=pins,proto3" json:"pins,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModerationApplicationsResponse) Reset() {
-	*x = GetModerationApplicationsResponse{}
-	mi := &file_admin_proto_msgTypes[43]
+func (x *ListPromotionPromptPinsResponse) Reset() {
+	*x = ListPromotionPromptPinsResponse{}
+	mi := &file_admin_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModerationApplicationsResponse) String() string {
+func (x *ListPromotionPromptPinsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModerationApplicationsResponse) ProtoMessage() {}
+func (*ListPromotionPromptPinsResponse) ProtoMessage() {}
 
-func (x *GetModerationApplicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[43]
+func (x *ListPromotionPromptPinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2553,41 +5781,43 @@ func (x *GetModerationApplicationsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModerationApplicationsResponse.ProtoReflect.Descriptor instead.
-func (*GetModerationApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListPromotionPromptPinsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionPromptPinsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetModerationApplicationsResponse) GetApplications() []*ModerationApplication {
+func (x *ListPromotionPromptPinsResponse) GetPins() []*PromotionPromptPin {
 	if x != nil {
-		return x.Applications
+		return x.Pins
 	}
 	return nil
 }
 
-// POST /admin/moderation/{applicationId}/approve
-type ApproveModerationRequest struct {
+// PUT /admin/promotions/{promotion_id}/prompts/{name}
+type PinPromotionPromptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId int64                  `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // 0 — встроенный промпт
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveModerationRequest) Reset() {
-	*x = ApproveModerationRequest{}
-	mi := &file_admin_proto_msgTypes[44]
+func (x *PinPromotionPromptRequest) Reset() {
+	*x = PinPromotionPromptRequest{}
+	mi := &file_admin_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveModerationRequest) String() string {
+func (x *PinPromotionPromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveModerationRequest) ProtoMessage() {}
+func (*PinPromotionPromptRequest) ProtoMessage() {}
 
-func (x *ApproveModerationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[44]
+func (x *PinPromotionPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2598,39 +5828,54 @@ func (x *ApproveModerationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveModerationRequest.ProtoReflect.Descriptor instead.
-func (*ApproveModerationRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use PinPromotionPromptRequest.ProtoReflect.Descriptor instead.
+func (*PinPromotionPromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ApproveModerationRequest) GetApplicationId() int64 {
+func (x *PinPromotionPromptRequest) GetPromotionId() int64 {
 	if x != nil {
-		return x.ApplicationId
+		return x.PromotionId
 	}
 	return 0
 }
 
-type ApproveModerationResponse struct {
+func (x *PinPromotionPromptRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PinPromotionPromptRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PinPromotionPromptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveModerationResponse) Reset() {
-	*x = ApproveModerationResponse{}
-	mi := &file_admin_proto_msgTypes[45]
+func (x *PinPromotionPromptResponse) Reset() {
+	*x = PinPromotionPromptResponse{}
+	mi := &file_admin_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveModerationResponse) String() string {
+func (x *PinPromotionPromptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveModerationResponse) ProtoMessage() {}
+func (*PinPromotionPromptResponse) ProtoMessage() {}
 
-func (x *ApproveModerationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[45]
+func (x *PinPromotionPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,35 +5886,42 @@ func (x *ApproveModerationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveModerationResponse.ProtoReflect.Descriptor instead.
-func (*ApproveModerationResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use PinPromotionPromptResponse.ProtoReflect.Descriptor instead.
+func (*PinPromotionPromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{93}
 }
 
-// POST /admin/moderation/{applicationId}/reject
-type RejectModerationRequest struct {
+func (x *PinPromotionPromptResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// DELETE /admin/promotions/{promotion_id}/prompts/{name}
+type UnpinPromotionPromptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId int64                  `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RejectModerationRequest) Reset() {
-	*x = RejectModerationRequest{}
-	mi := &file_admin_proto_msgTypes[46]
+func (x *UnpinPromotionPromptRequest) Reset() {
+	*x = UnpinPromotionPromptRequest{}
+	mi := &file_admin_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectModerationRequest) String() string {
+func (x *UnpinPromotionPromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectModerationRequest) ProtoMessage() {}
+func (*UnpinPromotionPromptRequest) ProtoMessage() {}
 
-func (x *RejectModerationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[46]
+func (x *UnpinPromotionPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2680,46 +5932,47 @@ func (x *RejectModerationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectModerationRequest.ProtoReflect.Descriptor instead.
-func (*RejectModerationRequest) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use UnpinPromotionPromptRequest.ProtoReflect.Descriptor instead.
+func (*UnpinPromotionPromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *RejectModerationRequest) GetApplicationId() int64 {
+func (x *UnpinPromotionPromptRequest) GetPromotionId() int64 {
 	if x != nil {
-		return x.ApplicationId
+		return x.PromotionId
 	}
 	return 0
 }
 
-func (x *RejectModerationRequest) GetReason() string {
+func (x *UnpinPromotionPromptRequest) GetName() string {
 	if x != nil {
-		return x.Reason
+		return x.Name
 	}
 	return ""
 }
 
-type RejectModerationResponse struct {
+type UnpinPromotionPromptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RejectModerationResponse) Reset() {
-	*x = RejectModerationResponse{}
-	mi := &file_admin_proto_msgTypes[47]
+func (x *UnpinPromotionPromptResponse) Reset() {
+	*x = UnpinPromotionPromptResponse{}
+	mi := &file_admin_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectModerationResponse) String() string {
+func (x *UnpinPromotionPromptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectModerationResponse) ProtoMessage() {}
+func (*UnpinPromotionPromptResponse) ProtoMessage() {}
 
-func (x *RejectModerationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_proto_msgTypes[47]
+func (x *UnpinPromotionPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2730,16 +5983,23 @@ func (x *RejectModerationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectModerationResponse.ProtoReflect.Descriptor instead.
-func (*RejectModerationResponse) Descriptor() ([]byte, []int) {
-	return file_admin_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use UnpinPromotionPromptResponse.ProtoReflect.Descriptor instead.
+func (*UnpinPromotionPromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UnpinPromotionPromptResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
 var File_admin_proto protoreflect.FileDescriptor
 
 const file_admin_proto_rawDesc = "" +
 	"\n" +
-	"\vadmin.proto\x12\x11wildberries.admin\x1a\fcommon.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf8\x02\n" +
+	"\vadmin.proto\x12\x11wildberries.admin\x1a\fcommon.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe6\x03\n" +
 	"\x16CreatePromotionRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
@@ -2753,15 +6013,38 @@ const file_admin_proto_rawDesc = "" +
 	"\fmin_discount\x18\t \x01(\x05R\vminDiscount\x12!\n" +
 	"\fstop_factors\x18\n" +
 	" \x03(\tR\vstopFactors\x12!\n" +
-	"\fmax_discount\x18\v \x01(\x05R\vmaxDiscount\"A\n" +
+	"\fmax_discount\x18\v \x01(\x05R\vmaxDiscount\x12\x1a\n" +
+	"\bpriority\x18\f \x01(\x05R\bpriority\x12%\n" +
+	"\x0etarget_regions\x18\r \x03(\tR\rtargetRegions\x12)\n" +
+	"\x10target_platforms\x18\x0e \x03(\tR\x0ftargetPlatforms\"A\n" +
 	"\x17CreatePromotionResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"\x15\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xb0\x02\n" +
+	"\x1dGeneratePromotionDraftRequest\x12\x14\n" +
+	"\x05theme\x18\x01 \x01(\tR\x05theme\x12#\n" +
+	"\rsegment_count\x18\x02 \x01(\x05R\fsegmentCount\x12\x1b\n" +
+	"\tdate_from\x18\x03 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x04 \x01(\tR\x06dateTo\x12#\n" +
+	"\rpricing_model\x18\x05 \x01(\tR\fpricingModel\x12\x1d\n" +
+	"\n" +
+	"slot_count\x18\x06 \x01(\x05R\tslotCount\x12!\n" +
+	"\fmin_discount\x18\a \x01(\x05R\vminDiscount\x12!\n" +
+	"\fmax_discount\x18\b \x01(\x05R\vmaxDiscount\x12\x14\n" +
+	"\x05async\x18\t \x01(\bR\x05async\"\xa2\x02\n" +
+	"\x1eGeneratePromotionDraftResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05theme\x18\x05 \x01(\tR\x05theme\x12?\n" +
+	"\bsegments\x18\x06 \x03(\v2#.wildberries.admin.SegmentWithOrderR\bsegments\x124\n" +
+	"\x04poll\x18\a \x01(\v2 .wildberries.admin.PromotionPollR\x04poll\x12\x15\n" +
+	"\x06job_id\x18\b \x01(\tR\x05jobId\"\x15\n" +
 	"\x13GetPromotionRequest\"Z\n" +
 	"\x14GetPromotionResponse\x12B\n" +
 	"\n" +
 	"promotions\x18\x01 \x03(\v2\".wildberries.admin.SinglePromotionR\n" +
-	"promotions\"\xbf\x06\n" +
+	"promotions\"\xad\a\n" +
 	"\x0fSinglePromotion\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -2782,18 +6065,26 @@ const file_admin_proto_rawDesc = "" +
 	"\x04poll\x18\x0f \x01(\v2 .wildberries.admin.PromotionPollR\x04poll\x12!\n" +
 	"\fmax_discount\x18\x10 \x01(\x05R\vmaxDiscount\x121\n" +
 	"\x12booked_slots_price\x18\x11 \x01(\x03H\x00R\x10bookedSlotsPrice\x88\x01\x01\x123\n" +
-	"\x13auction_slots_price\x18\x12 \x01(\x03H\x01R\x11auctionSlotsPrice\x88\x01\x01\x1a>\n" +
+	"\x13auction_slots_price\x18\x12 \x01(\x03H\x01R\x11auctionSlotsPrice\x88\x01\x01\x12\x1a\n" +
+	"\bpriority\x18\x13 \x01(\x05R\bpriority\x12%\n" +
+	"\x0etarget_regions\x18\x14 \x03(\tR\rtargetRegions\x12)\n" +
+	"\x10target_platforms\x18\x15 \x03(\tR\x0ftargetPlatforms\x1a>\n" +
 	"\x10FixedPricesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\x15\n" +
 	"\x13_booked_slots_priceB\x16\n" +
-	"\x14_auction_slots_price\"|\n" +
+	"\x14_auction_slots_price\"\xf4\x01\n" +
 	"\x10SegmentWithOrder\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
 	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12\x1f\n" +
 	"\vorder_index\x18\x04 \x01(\x05R\n" +
-	"orderIndex\"\x97\x01\n" +
+	"orderIndex\x12\x1e\n" +
+	"\n" +
+	"population\x18\x05 \x01(\x03R\n" +
+	"population\x12)\n" +
+	"\x10population_share\x18\x06 \x01(\x01R\x0fpopulationShare\x12+\n" +
+	"\x11population_source\x18\a \x01(\tR\x10populationSource\"\x97\x01\n" +
 	"\rPromotionPoll\x12B\n" +
 	"\tquestions\x18\x01 \x03(\v2$.wildberries.admin.PollQuestionAdminR\tquestions\x12B\n" +
 	"\vanswer_tree\x18\x02 \x03(\v2!.wildberries.admin.AnswerTreeNodeR\n" +
@@ -2810,7 +6101,7 @@ const file_admin_proto_rawDesc = "" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12$\n" +
 	"\x0eparent_node_id\x18\x02 \x01(\tR\fparentNodeId\x12\x14\n" +
 	"\x05label\x18\x03 \x01(\tR\x05label\x12\x14\n" +
-	"\x05value\x18\x04 \x01(\tR\x05value\"\xd2\x04\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"\xfb\x05\n" +
 	"\x16UpdatePromotionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
@@ -2825,7 +6116,12 @@ const file_admin_proto_rawDesc = "" +
 	"\fmin_discount\x18\n" +
 	" \x01(\x05H\bR\vminDiscount\x88\x01\x01\x12!\n" +
 	"\fstop_factors\x18\v \x03(\tR\vstopFactors\x12&\n" +
-	"\fmax_discount\x18\f \x01(\x05H\tR\vmaxDiscount\x88\x01\x01B\a\n" +
+	"\fmax_discount\x18\f \x01(\x05H\tR\vmaxDiscount\x88\x01\x01\x12\x1f\n" +
+	"\bpriority\x18\r \x01(\x05H\n" +
+	"R\bpriority\x88\x01\x01\x12%\n" +
+	"\x0etarget_regions\x18\x0e \x03(\tR\rtargetRegions\x12)\n" +
+	"\x10target_platforms\x18\x0f \x03(\tR\x0ftargetPlatforms\x12'\n" +
+	"\x0fclear_targeting\x18\x10 \x01(\bR\x0eclearTargetingB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\b\n" +
 	"\x06_themeB\f\n" +
@@ -2837,7 +6133,8 @@ const file_admin_proto_rawDesc = "" +
 	"\x0e_pricing_modelB\r\n" +
 	"\v_slot_countB\x0f\n" +
 	"\r_min_discountB\x0f\n" +
-	"\r_max_discount\"\x19\n" +
+	"\r_max_discountB\v\n" +
+	"\t_priority\"\x19\n" +
 	"\x17UpdatePromotionResponse\"(\n" +
 	"\x16DeletePromotionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x19\n" +
@@ -2900,7 +6197,26 @@ const file_admin_proto_rawDesc = "" +
 	"\x15DeleteSegmentResponse\"D\n" +
 	"\x1fShuffleSegmentCategoriesRequest\x12!\n" +
 	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\"\"\n" +
-	" ShuffleSegmentCategoriesResponse\"L\n" +
+	" ShuffleSegmentCategoriesResponse\"[\n" +
+	"\x17ListSegmentTextsRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x02 \x01(\x03R\tsegmentId\"y\n" +
+	"\x12SegmentTextVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"]\n" +
+	"\x18ListSegmentTextsResponse\x12A\n" +
+	"\bversions\x18\x01 \x03(\v2%.wildberries.admin.SegmentTextVersionR\bversions\"v\n" +
+	"\x18RevertSegmentTextRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x02 \x01(\x03R\tsegmentId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\"\\\n" +
+	"\x19RevertSegmentTextResponse\x12?\n" +
+	"\acurrent\x18\x01 \x01(\v2%.wildberries.admin.SegmentTextVersionR\acurrent\"L\n" +
 	"\x13GeneratePollRequest\x12!\n" +
 	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\"\x9e\x01\n" +
@@ -2921,7 +6237,34 @@ const file_admin_proto_rawDesc = "" +
 	"\x14SetAnswerTreeRequest\x12!\n" +
 	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x127\n" +
 	"\x05nodes\x18\x02 \x03(\v2!.wildberries.admin.AnswerTreeNodeR\x05nodes\"\x17\n" +
-	"\x15SetAnswerTreeResponse\"]\n" +
+	"\x15SetAnswerTreeResponse\"a\n" +
+	"\x1dSimulateIdentificationRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x02 \x03(\x03R\toptionIds\"\x94\x01\n" +
+	"\x0eSimulationStep\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\x03R\n" +
+	"questionId\x12#\n" +
+	"\rquestion_text\x18\x02 \x01(\tR\fquestionText\x12\x1b\n" +
+	"\toption_id\x18\x03 \x01(\x03R\boptionId\x12\x1f\n" +
+	"\voption_text\x18\x04 \x01(\tR\n" +
+	"optionText\"\xe3\x01\n" +
+	"\x0eSimulationPath\x127\n" +
+	"\x05steps\x18\x01 \x03(\v2!.wildberries.admin.SimulationStepR\x05steps\x12*\n" +
+	"\x11result_segment_id\x18\x02 \x01(\x03R\x0fresultSegmentId\x12.\n" +
+	"\x13result_segment_name\x18\x03 \x01(\tR\x11resultSegmentName\x12(\n" +
+	"\x10next_question_id\x18\x04 \x01(\x03R\x0enextQuestionId\x12\x12\n" +
+	"\x04loop\x18\x05 \x01(\bR\x04loop\"Z\n" +
+	"\x0fSegmentCoverage\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x01 \x01(\x03R\tsegmentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05paths\x18\x03 \x01(\x05R\x05paths\"\xcf\x01\n" +
+	"\x1eSimulateIdentificationResponse\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x127\n" +
+	"\x05paths\x18\x02 \x03(\v2!.wildberries.admin.SimulationPathR\x05paths\x12>\n" +
+	"\bcoverage\x18\x03 \x03(\v2\".wildberries.admin.SegmentCoverageR\bcoverage\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\"]\n" +
 	" GetModerationApplicationsRequest\x12!\n" +
 	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\"\xa2\x02\n" +
@@ -2946,11 +6289,196 @@ const file_admin_proto_rawDesc = "" +
 	"\x17RejectModerationRequest\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\x03R\rapplicationId\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x1a\n" +
-	"\x18RejectModerationResponse2\x83\x12\n" +
+	"\x18RejectModerationResponse\"t\n" +
+	"\x19GetPromotionFunnelRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\"\xb9\x01\n" +
+	"\fFunnelStages\x12\x14\n" +
+	"\x05views\x18\x01 \x01(\x03R\x05views\x12\x1f\n" +
+	"\vquiz_starts\x18\x02 \x01(\x03R\n" +
+	"quizStarts\x12 \n" +
+	"\vcompletions\x18\x03 \x01(\x03R\vcompletions\x12)\n" +
+	"\x10segment_resolved\x18\x04 \x01(\x03R\x0fsegmentResolved\x12%\n" +
+	"\x0eproduct_clicks\x18\x05 \x01(\x03R\rproductClicks\"\x8a\x01\n" +
+	"\rSegmentFunnel\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x01 \x01(\x03R\tsegmentId\x12!\n" +
+	"\fsegment_name\x18\x02 \x01(\tR\vsegmentName\x127\n" +
+	"\x06stages\x18\x03 \x01(\v2\x1f.wildberries.admin.FunnelStagesR\x06stages\"\xea\x01\n" +
+	"\x1aGetPromotionFunnelResponse\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\x125\n" +
+	"\x05total\x18\x04 \x01(\v2\x1f.wildberries.admin.FunnelStagesR\x05total\x12<\n" +
+	"\bsegments\x18\x05 \x03(\v2 .wildberries.admin.SegmentFunnelR\bsegments\"x\n" +
+	"\x1dGetSegmentDistributionRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\"\x82\x01\n" +
+	"\fSegmentShare\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x01 \x01(\x03R\tsegmentId\x12!\n" +
+	"\fsegment_name\x18\x02 \x01(\tR\vsegmentName\x12\x1a\n" +
+	"\bsessions\x18\x03 \x01(\x03R\bsessions\x12\x14\n" +
+	"\x05share\x18\x04 \x01(\x01R\x05share\"]\n" +
+	"\x1eGetSegmentDistributionResponse\x12;\n" +
+	"\bsegments\x18\x01 \x03(\v2\x1f.wildberries.admin.SegmentShareR\bsegments\"\xad\x01\n" +
+	"\x19GetEventTimeSeriesRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\x12\x16\n" +
+	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x1f\n" +
+	"\vevent_types\x18\x05 \x03(\tR\n" +
+	"eventTypes\"i\n" +
+	"\x0fTimeSeriesPoint\x12!\n" +
+	"\fbucket_start\x18\x01 \x01(\tR\vbucketStart\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"X\n" +
+	"\x1aGetEventTimeSeriesResponse\x12:\n" +
+	"\x06points\x18\x01 \x03(\v2\".wildberries.admin.TimeSeriesPointR\x06points\"?\n" +
+	"\x18ComparePromotionsRequest\x12#\n" +
+	"\rpromotion_ids\x18\x01 \x03(\x03R\fpromotionIds\"\x8e\x06\n" +
+	"\x0fPromotionCohort\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05theme\x18\x03 \x01(\tR\x05theme\x12/\n" +
+	"\x13identification_mode\x18\x04 \x01(\tR\x12identificationMode\x12#\n" +
+	"\rpricing_model\x18\x05 \x01(\tR\fpricingModel\x12\x1b\n" +
+	"\tdate_from\x18\x06 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\a \x01(\tR\x06dateTo\x12\x1f\n" +
+	"\vtotal_slots\x18\b \x01(\x03R\n" +
+	"totalSlots\x12!\n" +
+	"\ffilled_slots\x18\t \x01(\x03R\vfilledSlots\x12\x1b\n" +
+	"\tfill_rate\x18\n" +
+	" \x01(\x01R\bfillRate\x12,\n" +
+	"\x12avg_clearing_price\x18\v \x01(\x01R\x10avgClearingPrice\x12\x12\n" +
+	"\x04bets\x18\f \x01(\x03R\x04bets\x12\x18\n" +
+	"\abidders\x18\r \x01(\x03R\abidders\x123\n" +
+	"\x15approved_applications\x18\x0e \x01(\x03R\x14approvedApplications\x123\n" +
+	"\x15rejected_applications\x18\x0f \x01(\x03R\x14rejectedApplications\x12%\n" +
+	"\x0erejection_rate\x18\x10 \x01(\x01R\rrejectionRate\x12#\n" +
+	"\rview_sessions\x18\x11 \x01(\x03R\fviewSessions\x12+\n" +
+	"\x11resolved_sessions\x18\x12 \x01(\x03R\x10resolvedSessions\x12%\n" +
+	"\x0eclick_sessions\x18\x13 \x01(\x03R\rclickSessions\x120\n" +
+	"\x14quiz_completion_rate\x18\x14 \x01(\x01R\x12quizCompletionRate\x12)\n" +
+	"\x10buyer_conversion\x18\x15 \x01(\x01R\x0fbuyerConversion\"_\n" +
+	"\x19ComparePromotionsResponse\x12B\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\".wildberries.admin.PromotionCohortR\n" +
+	"promotions\"\xd1\x01\n" +
+	"\x18ListAIGenerationsRequest\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\tdate_from\x18\x04 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x05 \x01(\tR\x06dateTo\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\a \x01(\x05R\aperPage\"\x9e\x03\n" +
+	"\fAIGeneration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x06 \x01(\x03R\tlatencyMs\x12#\n" +
+	"\rprompt_tokens\x18\a \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\b \x01(\x03R\x10completionTokens\x12!\n" +
+	"\ftotal_tokens\x18\t \x01(\x03R\vtotalTokens\x12\x19\n" +
+	"\bcost_usd\x18\n" +
+	" \x01(\x01R\acostUsd\x12\x16\n" +
+	"\x06prompt\x18\v \x01(\tR\x06prompt\x12\x1a\n" +
+	"\bresponse\x18\f \x01(\tR\bresponse\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\"\x97\x01\n" +
+	"\x19ListAIGenerationsResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.wildberries.admin.AIGenerationR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\"I\n" +
+	"\x11GetAIUsageRequest\x12\x1b\n" +
+	"\tdate_from\x18\x01 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x02 \x01(\tR\x06dateTo\"\xe2\x02\n" +
+	"\n" +
+	"AIUsageDay\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12\x14\n" +
+	"\x05calls\x18\x04 \x01(\x03R\x05calls\x12!\n" +
+	"\ffailed_calls\x18\x05 \x01(\x03R\vfailedCalls\x12!\n" +
+	"\fcached_calls\x18\x06 \x01(\x03R\vcachedCalls\x12#\n" +
+	"\rprompt_tokens\x18\a \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\b \x01(\x03R\x10completionTokens\x12!\n" +
+	"\ftotal_tokens\x18\t \x01(\x03R\vtotalTokens\x12\x19\n" +
+	"\bcost_usd\x18\n" +
+	" \x01(\x01R\acostUsd\x12$\n" +
+	"\x0eavg_latency_ms\x18\v \x01(\x01R\favgLatencyMs\"\x90\x01\n" +
+	"\x12GetAIUsageResponse\x121\n" +
+	"\x04days\x18\x01 \x03(\v2\x1d.wildberries.admin.AIUsageDayR\x04days\x12!\n" +
+	"\ftotal_tokens\x18\x02 \x01(\x03R\vtotalTokens\x12$\n" +
+	"\x0etotal_cost_usd\x18\x03 \x01(\x01R\ftotalCostUsd\"\x1c\n" +
+	"\x1aListPromptTemplatesRequest\"\x90\x01\n" +
+	"\x0ePromptTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tvariables\x18\x02 \x03(\tR\tvariables\x12%\n" +
+	"\x0eactive_version\x18\x03 \x01(\x05R\ractiveVersion\x12%\n" +
+	"\x0elatest_version\x18\x04 \x01(\x05R\rlatestVersion\"^\n" +
+	"\x1bListPromptTemplatesResponse\x12?\n" +
+	"\ttemplates\x18\x01 \x03(\v2!.wildberries.admin.PromptTemplateR\ttemplates\"\xaa\x01\n" +
+	"\x15PromptTemplateVersion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"7\n" +
+	"!ListPromptTemplateVersionsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"j\n" +
+	"\"ListPromptTemplateVersionsResponse\x12D\n" +
+	"\bversions\x18\x01 \x03(\v2(.wildberries.admin.PromptTemplateVersionR\bversions\"\x82\x01\n" +
+	"\"CreatePromptTemplateVersionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x1a\n" +
+	"\bactivate\x18\x04 \x01(\bR\bactivate\"i\n" +
+	"#CreatePromptTemplateVersionResponse\x12B\n" +
+	"\aversion\x18\x01 \x01(\v2(.wildberries.admin.PromptTemplateVersionR\aversion\"M\n" +
+	"\x1dActivatePromptTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\":\n" +
+	"\x1eActivatePromptTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"a\n" +
+	"\x12PromotionPromptPin\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"C\n" +
+	"\x1eListPromotionPromptPinsRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\"\\\n" +
+	"\x1fListPromotionPromptPinsResponse\x129\n" +
+	"\x04pins\x18\x01 \x03(\v2%.wildberries.admin.PromotionPromptPinR\x04pins\"l\n" +
+	"\x19PinPromotionPromptRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\"6\n" +
+	"\x1aPinPromotionPromptResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"T\n" +
+	"\x1bUnpinPromotionPromptRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"8\n" +
+	"\x1cUnpinPromotionPromptResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xa8\x16\n" +
 	"\x15PromotionAdminService\x12\xa1\x02\n" +
 	"\x0fCreatePromotion\x12).wildberries.admin.CreatePromotionRequest\x1a*.wildberries.admin.CreatePromotionResponse\"\xb6\x01\x92A\x96\x01\n" +
 	"\n" +
-	"Promotions\x12$Создать новую акцию\x1aQСоздает новую акцию с заданными параметрами*\x0fCreatePromotion\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/admin/promotions\x12\x8a\x02\n" +
+	"Promotions\x12$Создать новую акцию\x1aQСоздает новую акцию с заданными параметрами*\x0fCreatePromotion\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/admin/promotions\x12\xa2\x04\n" +
+	"\x16GeneratePromotionDraft\x120.wildberries.admin.GeneratePromotionDraftRequest\x1a1.wildberries.admin.GeneratePromotionDraftResponse\"\xa2\x03\x92A\xfc\x02\n" +
+	"\n" +
+	"Promotions\x126Сгенерировать черновик акции\x1a\x9d\x02Генерирует по теме сегменты, вопросы, дерево ответов, название и описание, проверяет их согласованность и сохраняет акцию в статусе NOT_READY одной транзакцией*\x16GeneratePromotionDraft\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/promotions/draft\x12\x8a\x02\n" +
 	"\rGetPromotions\x12&.wildberries.admin.GetPromotionRequest\x1a'.wildberries.admin.GetPromotionResponse\"\xa7\x01\x92A\x8a\x01\n" +
 	"\n" +
 	"Promotions\x125Получить информацию об акции\x1a7Получает информацию об акциях*\fGetPromotion\x82\xd3\xe4\x93\x02\x13\x12\x11/admin/promotions\x12\xfb\x01\n" +
@@ -2971,7 +6499,7 @@ const file_admin_proto_rawDesc = "" +
 	"Promotions\x128Установить параметры аукциона\x1aSУстанавливает min_price и bid_step для аукциона акции*\x10SetAuctionParams\x82\xd3\xe4\x93\x024:\x01*\x1a//admin/promotions/{promotion_id}/auction-params\x12\x91\x02\n" +
 	"\x0eSetSlotProduct\x12(.wildberries.admin.SetSlotProductRequest\x1a).wildberries.admin.SetSlotProductResponse\"\xa9\x01\x92A\x87\x01\n" +
 	"\n" +
-	"Promotions\x12/Установить продукт в слот\x1a8Ручная установка товара в слот*\x0eSetSlotProduct\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/horoscope/products2\xf6\v\n" +
+	"Promotions\x12/Установить продукт в слот\x1a8Ручная установка товара в слот*\x0eSetSlotProduct\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/horoscope/products2\x85\x12\n" +
 	"\x13SegmentAdminService\x12\xb1\x02\n" +
 	"\x10GenerateSegments\x12*.wildberries.admin.GenerateSegmentsRequest\x1a+.wildberries.admin.GenerateSegmentsResponse\"\xc3\x01\x92A\x82\x01\n" +
 	"\bSegments\x12+Сгенерировать сегменты\x1a7Генерирует сегменты для акции*\x10GenerateSegments\x82\xd3\xe4\x93\x027:\x01*\"2/admin/promotions/{promotion_id}/segments/generate\x12\x90\x02\n" +
@@ -2982,14 +6510,21 @@ const file_admin_proto_rawDesc = "" +
 	"\rDeleteSegment\x12'.wildberries.admin.DeleteSegmentRequest\x1a(.wildberries.admin.DeleteSegmentResponse\"\xa0\x01\x92A_\n" +
 	"\bSegments\x12\x1dУдалить сегмент\x1a%Удаляет сегмент по ID*\rDeleteSegment\x82\xd3\xe4\x93\x028*6/admin/promotions/{promotion_id}/segments/{segment_id}\x12\xf1\x02\n" +
 	"\x18ShuffleSegmentCategories\x122.wildberries.admin.ShuffleSegmentCategoriesRequest\x1a3.wildberries.admin.ShuffleSegmentCategoriesResponse\"\xeb\x01\x92A\xa0\x01\n" +
-	"\bSegments\x12:Перемешать категории сегментов\x1a>Перемешивает категории сегментов*\x18ShuffleSegmentCategories\x82\xd3\xe4\x93\x02A:\x01*\"</admin/promotions/{promotion_id}/segments/shuffle-categories2\xb5\a\n" +
+	"\bSegments\x12:Перемешать категории сегментов\x1a>Перемешивает категории сегментов*\x18ShuffleSegmentCategories\x82\xd3\xe4\x93\x02A:\x01*\"</admin/promotions/{promotion_id}/segments/shuffle-categories\x12\xde\x02\n" +
+	"\x10ListSegmentTexts\x12*.wildberries.admin.ListSegmentTextsRequest\x1a+.wildberries.admin.ListSegmentTextsResponse\"\xf0\x01\x92A\xa8\x01\n" +
+	"\bSegments\x12.История текстов сегмента\x1aZВозвращает версии текста сегмента, новые первыми*\x10ListSegmentTexts\x82\xd3\xe4\x93\x02>\x12</admin/promotions/{promotion_id}/segments/{segment_id}/texts\x12\xab\x03\n" +
+	"\x11RevertSegmentText\x12+.wildberries.admin.RevertSegmentTextRequest\x1a,.wildberries.admin.RevertSegmentTextResponse\"\xba\x02\x92A\xde\x01\n" +
+	"\bSegments\x129Вернуть прошлый текст сегмента\x1a\x83\x01Делает текст выбранной версии текущим; откат сохраняется новой версией*\x11RevertSegmentText\x82\xd3\xe4\x93\x02R:\x01*\"M/admin/promotions/{promotion_id}/segments/{segment_id}/texts/{version}/revert2\xcb\n" +
+	"\n" +
 	"\x10PollAdminService\x12\xa2\x02\n" +
 	"\fGeneratePoll\x12&.wildberries.admin.GeneratePollRequest\x1a'.wildberries.admin.GeneratePollResponse\"\xc0\x01\x92A\x83\x01\n" +
 	"\x04Poll\x12%Сгенерировать опрос\x1aFГенерирует структуру опроса для акции*\fGeneratePoll\x82\xd3\xe4\x93\x023:\x01*\"./admin/promotions/{promotion_id}/poll/generate\x12\xc0\x02\n" +
 	"\x10SetPollQuestions\x12*.wildberries.admin.SetPollQuestionsRequest\x1a+.wildberries.admin.SetPollQuestionsResponse\"\xd2\x01\x92A\x94\x01\n" +
 	"\x04Poll\x120Установить вопросы опроса\x1aHУстанавливает вопросы опроса для акции*\x10SetPollQuestions\x82\xd3\xe4\x93\x024:\x01*\"//admin/promotions/{promotion_id}/poll/questions\x12\xb8\x02\n" +
 	"\rSetAnswerTree\x12'.wildberries.admin.SetAnswerTreeRequest\x1a(.wildberries.admin.SetAnswerTreeResponse\"\xd3\x01\x92A\x93\x01\n" +
-	"\x04Poll\x120Установить дерево ответов\x1aJУстанавливает дерево ответов для опроса*\rSetAnswerTree\x82\xd3\xe4\x93\x026:\x01*\"1/admin/promotions/{promotion_id}/poll/answer-tree2\xa0\a\n" +
+	"\x04Poll\x120Установить дерево ответов\x1aJУстанавливает дерево ответов для опроса*\rSetAnswerTree\x82\xd3\xe4\x93\x026:\x01*\"1/admin/promotions/{promotion_id}/poll/answer-tree\x12\x93\x03\n" +
+	"\x16SimulateIdentification\x120.wildberries.admin.SimulateIdentificationRequest\x1a1.wildberries.admin.SimulateIdentificationResponse\"\x93\x02\x92A\xd6\x01\n" +
+	"\x04Poll\x12\x1fСимуляция опроса\x1a\x94\x01Прогоняет опрос без публикации акции и показывает, в какие сегменты ведут ответы*\x16SimulateIdentification\x82\xd3\xe4\x93\x023:\x01*\"./admin/promotions/{promotion_id}/poll/simulate2\xa0\a\n" +
 	"\x11ModerationService\x12\xed\x02\n" +
 	"\x0fGetApplications\x123.wildberries.admin.GetModerationApplicationsRequest\x1a4.wildberries.admin.GetModerationApplicationsResponse\"\xee\x01\x92A\xaa\x01\n" +
 	"\n" +
@@ -2999,7 +6534,38 @@ const file_admin_proto_rawDesc = "" +
 	"Moderation\x12\x1dОдобрить заявку\x1a7Одобрение заявки на модерацию*\aApprove\x82\xd3\xe4\x93\x02/:\x01*\"*/admin/moderation/{application_id}/approve\x12\x8b\x02\n" +
 	"\x06Reject\x12*.wildberries.admin.RejectModerationRequest\x1a+.wildberries.admin.RejectModerationResponse\"\xa7\x01\x92Ap\n" +
 	"\n" +
-	"Moderation\x12\x1fОтклонить заявку\x1a9Отклонение заявки на модерацию*\x06Reject\x82\xd3\xe4\x93\x02.:\x01*\")/admin/moderation/{application_id}/rejectB\xa3\x01\x92A\x82\x01\x12I\n" +
+	"Moderation\x12\x1fОтклонить заявку\x1a9Отклонение заявки на модерацию*\x06Reject\x82\xd3\xe4\x93\x02.:\x01*\")/admin/moderation/{application_id}/reject2\xd9\f\n" +
+	"\x19PromotionAnalyticsService\x12\x83\x03\n" +
+	"\x12GetPromotionFunnel\x12,.wildberries.admin.GetPromotionFunnelRequest\x1a-.wildberries.admin.GetPromotionFunnelResponse\"\x8f\x02\x92A\xd2\x01\n" +
+	"\tAnalytics\x12\x19Воронка акции\x1a\x95\x01Просмотры → старт опроса → завершение → сегмент → клики, по акции и по сегментам*\x12GetPromotionFunnel\x82\xd3\xe4\x93\x023\x121/admin/promotions/{promotion_id}/analytics/funnel\x12\xfd\x02\n" +
+	"\x16GetSegmentDistribution\x120.wildberries.admin.GetSegmentDistributionRequest\x1a1.wildberries.admin.GetSegmentDistributionResponse\"\xfd\x01\x92A\xbe\x01\n" +
+	"\tAnalytics\x122Распределение по сегментам\x1aeДоля результатов опроса, пришедшихся на каждый сегмент*\x16GetSegmentDistribution\x82\xd3\xe4\x93\x025\x123/admin/promotions/{promotion_id}/analytics/segments\x12\xd4\x02\n" +
+	"\x12GetEventTimeSeries\x12,.wildberries.admin.GetEventTimeSeriesRequest\x1a-.wildberries.admin.GetEventTimeSeriesResponse\"\xe0\x01\x92A\x9f\x01\n" +
+	"\tAnalytics\x12\"События по времени\x1aZКоличество событий покупателей по часам или дням*\x12GetEventTimeSeries\x82\xd3\xe4\x93\x027\x125/admin/promotions/{promotion_id}/analytics/timeseries\x12\xde\x03\n" +
+	"\x11ComparePromotions\x12+.wildberries.admin.ComparePromotionsRequest\x1a,.wildberries.admin.ComparePromotionsResponse\"\xed\x02\x92A\xbe\x02\n" +
+	"\tAnalytics\x124Сравнение завершённых акций\x1a\xe7\x01Тема, режим идентификации, модель цены, заполненность слотов, средняя цена, доля отклонений модерации и конверсия покупателей*\x11ComparePromotions\x82\xd3\xe4\x93\x02%\x12#/admin/analytics/promotions/compare2\xad\x05\n" +
+	"\x0eAIAuditService\x12\x87\x03\n" +
+	"\x11ListAIGenerations\x12+.wildberries.admin.ListAIGenerationsRequest\x1a,.wildberries.admin.ListAIGenerationsResponse\"\x96\x02\x92A\xf5\x01\n" +
+	"\x02AI\x12\"Журнал AI генераций\x1a\xb7\x01Промпты, ответы, провайдер, модель, задержка, токены, стоимость и результат валидации каждого вызова*\x11ListAIGenerations\x82\xd3\xe4\x93\x02\x17\x12\x15/admin/ai/generations\x12\x90\x02\n" +
+	"\n" +
+	"GetAIUsage\x12$.wildberries.admin.GetAIUsageRequest\x1a%.wildberries.admin.GetAIUsageResponse\"\xb4\x01\x92A\x99\x01\n" +
+	"\x02AI\x12\x1dРасход AI по дням\x1ahВызовы, токены и стоимость по дням, провайдерам и моделям*\n" +
+	"GetAIUsage\x82\xd3\xe4\x93\x02\x11\x12\x0f/admin/ai/usage2\xad\x16\n" +
+	"\x15PromptTemplateService\x12\xbb\x02\n" +
+	"\x13ListPromptTemplates\x12-.wildberries.admin.ListPromptTemplatesRequest\x1a..wildberries.admin.ListPromptTemplatesResponse\"\xc4\x01\x92A\xa7\x01\n" +
+	"\x02AI\x12\x11Промпты AI\x1ayРедактируемые промпты, их переменные, активная и последняя версии*\x13ListPromptTemplates\x82\xd3\xe4\x93\x02\x13\x12\x11/admin/ai/prompts\x12\xd1\x02\n" +
+	"\x1aListPromptTemplateVersions\x124.wildberries.admin.ListPromptTemplateVersionsRequest\x1a5.wildberries.admin.ListPromptTemplateVersionsResponse\"\xc5\x01\x92A\x98\x01\n" +
+	"\x02AI\x12\x1bВерсии промпта\x1aYСохранённые версии промпта и встроенная версия 0*\x1aListPromptTemplateVersions\x82\xd3\xe4\x93\x02#\x12!/admin/ai/prompts/{name}/versions\x12\xdd\x03\n" +
+	"\x1bCreatePromptTemplateVersion\x125.wildberries.admin.CreatePromptTemplateVersionRequest\x1a6.wildberries.admin.CreatePromptTemplateVersionResponse\"\xce\x02\x92A\x9e\x02\n" +
+	"\x02AI\x12.Сохранить версию промпта\x1a\xca\x01Проверяет шаблон на примерах переменных и сохраняет следующую версию; с activate генерации сразу переходят на неё*\x1bCreatePromptTemplateVersion\x82\xd3\xe4\x93\x02&:\x01*\"!/admin/ai/prompts/{name}/versions\x12\xff\x03\n" +
+	"\x16ActivatePromptTemplate\x120.wildberries.admin.ActivatePromptTemplateRequest\x1a1.wildberries.admin.ActivatePromptTemplateResponse\"\xff\x02\x92A\xbc\x02\n" +
+	"\x02AI\x124Активировать версию промпта\x1a\xe7\x01Переключает генерации на версию промпта; версия 0 — откат на встроенный промпт. Акции с закреплённой версией не затрагиваются*\x16ActivatePromptTemplate\x82\xd3\xe4\x93\x029:\x01*\"4/admin/ai/prompts/{name}/versions/{version}/activate\x12\x9a\x03\n" +
+	"\x17ListPromotionPromptPins\x121.wildberries.admin.ListPromotionPromptPinsRequest\x1a2.wildberries.admin.ListPromotionPromptPinsResponse\"\x97\x02\x92A\xe3\x01\n" +
+	"\x02AI\x12:Промпты, закреплённые за акцией\x1a\x87\x01Версии промптов, которыми пользуются генерации для акции вместо активных*\x17ListPromotionPromptPins\x82\xd3\xe4\x93\x02*\x12(/admin/promotions/{promotion_id}/prompts\x12\x90\x03\n" +
+	"\x12PinPromotionPrompt\x12,.wildberries.admin.PinPromotionPromptRequest\x1a-.wildberries.admin.PinPromotionPromptResponse\"\x9c\x02\x92A\xde\x01\n" +
+	"\x02AI\x12@Закрепить версию промпта за акцией\x1a\x81\x01Генерации для акции будут использовать эту версию, пока её не открепят*\x12PinPromotionPrompt\x82\xd3\xe4\x93\x024:\x01*\x1a//admin/promotions/{promotion_id}/prompts/{name}\x12\xef\x02\n" +
+	"\x14UnpinPromotionPrompt\x12..wildberries.admin.UnpinPromotionPromptRequest\x1a/.wildberries.admin.UnpinPromotionPromptResponse\"\xf5\x01\x92A\xba\x01\n" +
+	"\x02AI\x12/Открепить промпт от акции\x1amГенерации для акции возвращаются к активной версии промпта*\x14UnpinPromotionPrompt\x82\xd3\xe4\x93\x021*//admin/promotions/{promotion_id}/prompts/{name}B\xa3\x01\x92A\x82\x01\x12I\n" +
 	"\x1fАдминская панель\x12\x1fАдминская панель2\x051.0.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZ\x1bwildberries/pkg/admin;adminb\x06proto3"
 
 var (
@@ -3014,118 +6580,219 @@ func file_admin_proto_rawDescGZIP() []byte {
 	return file_admin_proto_rawDescData
 }
 
-var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
 var file_admin_proto_goTypes = []any{
-	(*CreatePromotionRequest)(nil),            // 0: wildberries.admin.CreatePromotionRequest
-	(*CreatePromotionResponse)(nil),           // 1: wildberries.admin.CreatePromotionResponse
-	(*GetPromotionRequest)(nil),               // 2: wildberries.admin.GetPromotionRequest
-	(*GetPromotionResponse)(nil),              // 3: wildberries.admin.GetPromotionResponse
-	(*SinglePromotion)(nil),                   // 4: wildberries.admin.SinglePromotion
-	(*SegmentWithOrder)(nil),                  // 5: wildberries.admin.SegmentWithOrder
-	(*PromotionPoll)(nil),                     // 6: wildberries.admin.PromotionPoll
-	(*PollQuestionAdmin)(nil),                 // 7: wildberries.admin.PollQuestionAdmin
-	(*PollOptionAdmin)(nil),                   // 8: wildberries.admin.PollOptionAdmin
-	(*AnswerTreeNode)(nil),                    // 9: wildberries.admin.AnswerTreeNode
-	(*UpdatePromotionRequest)(nil),            // 10: wildberries.admin.UpdatePromotionRequest
-	(*UpdatePromotionResponse)(nil),           // 11: wildberries.admin.UpdatePromotionResponse
-	(*DeletePromotionRequest)(nil),            // 12: wildberries.admin.DeletePromotionRequest
-	(*DeletePromotionResponse)(nil),           // 13: wildberries.admin.DeletePromotionResponse
-	(*SetFixedPricesRequest)(nil),             // 14: wildberries.admin.SetFixedPricesRequest
-	(*FixedPriceEntry)(nil),                   // 15: wildberries.admin.FixedPriceEntry
-	(*SetFixedPricesResponse)(nil),            // 16: wildberries.admin.SetFixedPricesResponse
-	(*ChangeStatusRequest)(nil),               // 17: wildberries.admin.ChangeStatusRequest
-	(*ChangeStatusResponse)(nil),              // 18: wildberries.admin.ChangeStatusResponse
-	(*SetAuctionParamsRequest)(nil),           // 19: wildberries.admin.SetAuctionParamsRequest
-	(*SetAuctionParamsResponse)(nil),          // 20: wildberries.admin.SetAuctionParamsResponse
-	(*SetSlotProductRequest)(nil),             // 21: wildberries.admin.SetSlotProductRequest
-	(*SetSlotProductResponse)(nil),            // 22: wildberries.admin.SetSlotProductResponse
-	(*GenerateSegmentsRequest)(nil),           // 23: wildberries.admin.GenerateSegmentsRequest
-	(*GenerateSegmentsResponse)(nil),          // 24: wildberries.admin.GenerateSegmentsResponse
-	(*CreateSegmentRequest)(nil),              // 25: wildberries.admin.CreateSegmentRequest
-	(*CreateSegmentResponse)(nil),             // 26: wildberries.admin.CreateSegmentResponse
-	(*UpdateSegmentRequest)(nil),              // 27: wildberries.admin.UpdateSegmentRequest
-	(*UpdateSegmentResponse)(nil),             // 28: wildberries.admin.UpdateSegmentResponse
-	(*DeleteSegmentRequest)(nil),              // 29: wildberries.admin.DeleteSegmentRequest
-	(*DeleteSegmentResponse)(nil),             // 30: wildberries.admin.DeleteSegmentResponse
-	(*ShuffleSegmentCategoriesRequest)(nil),   // 31: wildberries.admin.ShuffleSegmentCategoriesRequest
-	(*ShuffleSegmentCategoriesResponse)(nil),  // 32: wildberries.admin.ShuffleSegmentCategoriesResponse
-	(*GeneratePollRequest)(nil),               // 33: wildberries.admin.GeneratePollRequest
-	(*GeneratePollResponse)(nil),              // 34: wildberries.admin.GeneratePollResponse
-	(*SetPollQuestionsRequest)(nil),           // 35: wildberries.admin.SetPollQuestionsRequest
-	(*SetQuestionInput)(nil),                  // 36: wildberries.admin.SetQuestionInput
-	(*SetOptionInput)(nil),                    // 37: wildberries.admin.SetOptionInput
-	(*SetPollQuestionsResponse)(nil),          // 38: wildberries.admin.SetPollQuestionsResponse
-	(*SetAnswerTreeRequest)(nil),              // 39: wildberries.admin.SetAnswerTreeRequest
-	(*SetAnswerTreeResponse)(nil),             // 40: wildberries.admin.SetAnswerTreeResponse
-	(*GetModerationApplicationsRequest)(nil),  // 41: wildberries.admin.GetModerationApplicationsRequest
-	(*ModerationApplication)(nil),             // 42: wildberries.admin.ModerationApplication
-	(*GetModerationApplicationsResponse)(nil), // 43: wildberries.admin.GetModerationApplicationsResponse
-	(*ApproveModerationRequest)(nil),          // 44: wildberries.admin.ApproveModerationRequest
-	(*ApproveModerationResponse)(nil),         // 45: wildberries.admin.ApproveModerationResponse
-	(*RejectModerationRequest)(nil),           // 46: wildberries.admin.RejectModerationRequest
-	(*RejectModerationResponse)(nil),          // 47: wildberries.admin.RejectModerationResponse
-	nil,                                       // 48: wildberries.admin.SinglePromotion.FixedPricesEntry
-	(*common.Segment)(nil),                    // 49: wildberries.common.Segment
+	(*CreatePromotionRequest)(nil),              // 0: wildberries.admin.CreatePromotionRequest
+	(*CreatePromotionResponse)(nil),             // 1: wildberries.admin.CreatePromotionResponse
+	(*GeneratePromotionDraftRequest)(nil),       // 2: wildberries.admin.GeneratePromotionDraftRequest
+	(*GeneratePromotionDraftResponse)(nil),      // 3: wildberries.admin.GeneratePromotionDraftResponse
+	(*GetPromotionRequest)(nil),                 // 4: wildberries.admin.GetPromotionRequest
+	(*GetPromotionResponse)(nil),                // 5: wildberries.admin.GetPromotionResponse
+	(*SinglePromotion)(nil),                     // 6: wildberries.admin.SinglePromotion
+	(*SegmentWithOrder)(nil),                    // 7: wildberries.admin.SegmentWithOrder
+	(*PromotionPoll)(nil),                       // 8: wildberries.admin.PromotionPoll
+	(*PollQuestionAdmin)(nil),                   // 9: wildberries.admin.PollQuestionAdmin
+	(*PollOptionAdmin)(nil),                     // 10: wildberries.admin.PollOptionAdmin
+	(*AnswerTreeNode)(nil),                      // 11: wildberries.admin.AnswerTreeNode
+	(*UpdatePromotionRequest)(nil),              // 12: wildberries.admin.UpdatePromotionRequest
+	(*UpdatePromotionResponse)(nil),             // 13: wildberries.admin.UpdatePromotionResponse
+	(*DeletePromotionRequest)(nil),              // 14: wildberries.admin.DeletePromotionRequest
+	(*DeletePromotionResponse)(nil),             // 15: wildberries.admin.DeletePromotionResponse
+	(*SetFixedPricesRequest)(nil),               // 16: wildberries.admin.SetFixedPricesRequest
+	(*FixedPriceEntry)(nil),                     // 17: wildberries.admin.FixedPriceEntry
+	(*SetFixedPricesResponse)(nil),              // 18: wildberries.admin.SetFixedPricesResponse
+	(*ChangeStatusRequest)(nil),                 // 19: wildberries.admin.ChangeStatusRequest
+	(*ChangeStatusResponse)(nil),                // 20: wildberries.admin.ChangeStatusResponse
+	(*SetAuctionParamsRequest)(nil),             // 21: wildberries.admin.SetAuctionParamsRequest
+	(*SetAuctionParamsResponse)(nil),            // 22: wildberries.admin.SetAuctionParamsResponse
+	(*SetSlotProductRequest)(nil),               // 23: wildberries.admin.SetSlotProductRequest
+	(*SetSlotProductResponse)(nil),              // 24: wildberries.admin.SetSlotProductResponse
+	(*GenerateSegmentsRequest)(nil),             // 25: wildberries.admin.GenerateSegmentsRequest
+	(*GenerateSegmentsResponse)(nil),            // 26: wildberries.admin.GenerateSegmentsResponse
+	(*CreateSegmentRequest)(nil),                // 27: wildberries.admin.CreateSegmentRequest
+	(*CreateSegmentResponse)(nil),               // 28: wildberries.admin.CreateSegmentResponse
+	(*UpdateSegmentRequest)(nil),                // 29: wildberries.admin.UpdateSegmentRequest
+	(*UpdateSegmentResponse)(nil),               // 30: wildberries.admin.UpdateSegmentResponse
+	(*DeleteSegmentRequest)(nil),                // 31: wildberries.admin.DeleteSegmentRequest
+	(*DeleteSegmentResponse)(nil),               // 32: wildberries.admin.DeleteSegmentResponse
+	(*ShuffleSegmentCategoriesRequest)(nil),     // 33: wildberries.admin.ShuffleSegmentCategoriesRequest
+	(*ShuffleSegmentCategoriesResponse)(nil),    // 34: wildberries.admin.ShuffleSegmentCategoriesResponse
+	(*ListSegmentTextsRequest)(nil),             // 35: wildberries.admin.ListSegmentTextsRequest
+	(*SegmentTextVersion)(nil),                  // 36: wildberries.admin.SegmentTextVersion
+	(*ListSegmentTextsResponse)(nil),            // 37: wildberries.admin.ListSegmentTextsResponse
+	(*RevertSegmentTextRequest)(nil),            // 38: wildberries.admin.RevertSegmentTextRequest
+	(*RevertSegmentTextResponse)(nil),           // 39: wildberries.admin.RevertSegmentTextResponse
+	(*GeneratePollRequest)(nil),                 // 40: wildberries.admin.GeneratePollRequest
+	(*GeneratePollResponse)(nil),                // 41: wildberries.admin.GeneratePollResponse
+	(*SetPollQuestionsRequest)(nil),             // 42: wildberries.admin.SetPollQuestionsRequest
+	(*SetQuestionInput)(nil),                    // 43: wildberries.admin.SetQuestionInput
+	(*SetOptionInput)(nil),                      // 44: wildberries.admin.SetOptionInput
+	(*SetPollQuestionsResponse)(nil),            // 45: wildberries.admin.SetPollQuestionsResponse
+	(*SetAnswerTreeRequest)(nil),                // 46: wildberries.admin.SetAnswerTreeRequest
+	(*SetAnswerTreeResponse)(nil),               // 47: wildberries.admin.SetAnswerTreeResponse
+	(*SimulateIdentificationRequest)(nil),       // 48: wildberries.admin.SimulateIdentificationRequest
+	(*SimulationStep)(nil),                      // 49: wildberries.admin.SimulationStep
+	(*SimulationPath)(nil),                      // 50: wildberries.admin.SimulationPath
+	(*SegmentCoverage)(nil),                     // 51: wildberries.admin.SegmentCoverage
+	(*SimulateIdentificationResponse)(nil),      // 52: wildberries.admin.SimulateIdentificationResponse
+	(*GetModerationApplicationsRequest)(nil),    // 53: wildberries.admin.GetModerationApplicationsRequest
+	(*ModerationApplication)(nil),               // 54: wildberries.admin.ModerationApplication
+	(*GetModerationApplicationsResponse)(nil),   // 55: wildberries.admin.GetModerationApplicationsResponse
+	(*ApproveModerationRequest)(nil),            // 56: wildberries.admin.ApproveModerationRequest
+	(*ApproveModerationResponse)(nil),           // 57: wildberries.admin.ApproveModerationResponse
+	(*RejectModerationRequest)(nil),             // 58: wildberries.admin.RejectModerationRequest
+	(*RejectModerationResponse)(nil),            // 59: wildberries.admin.RejectModerationResponse
+	(*GetPromotionFunnelRequest)(nil),           // 60: wildberries.admin.GetPromotionFunnelRequest
+	(*FunnelStages)(nil),                        // 61: wildberries.admin.FunnelStages
+	(*SegmentFunnel)(nil),                       // 62: wildberries.admin.SegmentFunnel
+	(*GetPromotionFunnelResponse)(nil),          // 63: wildberries.admin.GetPromotionFunnelResponse
+	(*GetSegmentDistributionRequest)(nil),       // 64: wildberries.admin.GetSegmentDistributionRequest
+	(*SegmentShare)(nil),                        // 65: wildberries.admin.SegmentShare
+	(*GetSegmentDistributionResponse)(nil),      // 66: wildberries.admin.GetSegmentDistributionResponse
+	(*GetEventTimeSeriesRequest)(nil),           // 67: wildberries.admin.GetEventTimeSeriesRequest
+	(*TimeSeriesPoint)(nil),                     // 68: wildberries.admin.TimeSeriesPoint
+	(*GetEventTimeSeriesResponse)(nil),          // 69: wildberries.admin.GetEventTimeSeriesResponse
+	(*ComparePromotionsRequest)(nil),            // 70: wildberries.admin.ComparePromotionsRequest
+	(*PromotionCohort)(nil),                     // 71: wildberries.admin.PromotionCohort
+	(*ComparePromotionsResponse)(nil),           // 72: wildberries.admin.ComparePromotionsResponse
+	(*ListAIGenerationsRequest)(nil),            // 73: wildberries.admin.ListAIGenerationsRequest
+	(*AIGeneration)(nil),                        // 74: wildberries.admin.AIGeneration
+	(*ListAIGenerationsResponse)(nil),           // 75: wildberries.admin.ListAIGenerationsResponse
+	(*GetAIUsageRequest)(nil),                   // 76: wildberries.admin.GetAIUsageRequest
+	(*AIUsageDay)(nil),                          // 77: wildberries.admin.AIUsageDay
+	(*GetAIUsageResponse)(nil),                  // 78: wildberries.admin.GetAIUsageResponse
+	(*ListPromptTemplatesRequest)(nil),          // 79: wildberries.admin.ListPromptTemplatesRequest
+	(*PromptTemplate)(nil),                      // 80: wildberries.admin.PromptTemplate
+	(*ListPromptTemplatesResponse)(nil),         // 81: wildberries.admin.ListPromptTemplatesResponse
+	(*PromptTemplateVersion)(nil),               // 82: wildberries.admin.PromptTemplateVersion
+	(*ListPromptTemplateVersionsRequest)(nil),   // 83: wildberries.admin.ListPromptTemplateVersionsRequest
+	(*ListPromptTemplateVersionsResponse)(nil),  // 84: wildberries.admin.ListPromptTemplateVersionsResponse
+	(*CreatePromptTemplateVersionRequest)(nil),  // 85: wildberries.admin.CreatePromptTemplateVersionRequest
+	(*CreatePromptTemplateVersionResponse)(nil), // 86: wildberries.admin.CreatePromptTemplateVersionResponse
+	(*ActivatePromptTemplateRequest)(nil),       // 87: wildberries.admin.ActivatePromptTemplateRequest
+	(*ActivatePromptTemplateResponse)(nil),      // 88: wildberries.admin.ActivatePromptTemplateResponse
+	(*PromotionPromptPin)(nil),                  // 89: wildberries.admin.PromotionPromptPin
+	(*ListPromotionPromptPinsRequest)(nil),      // 90: wildberries.admin.ListPromotionPromptPinsRequest
+	(*ListPromotionPromptPinsResponse)(nil),     // 91: wildberries.admin.ListPromotionPromptPinsResponse
+	(*PinPromotionPromptRequest)(nil),           // 92: wildberries.admin.PinPromotionPromptRequest
+	(*PinPromotionPromptResponse)(nil),          // 93: wildberries.admin.PinPromotionPromptResponse
+	(*UnpinPromotionPromptRequest)(nil),         // 94: wildberries.admin.UnpinPromotionPromptRequest
+	(*UnpinPromotionPromptResponse)(nil),        // 95: wildberries.admin.UnpinPromotionPromptResponse
+	nil,                                         // 96: wildberries.admin.SinglePromotion.FixedPricesEntry
+	(*common.Segment)(nil),                      // 97: wildberries.common.Segment
 }
 var file_admin_proto_depIdxs = []int32{
-	4,  // 0: wildberries.admin.GetPromotionResponse.promotions:type_name -> wildberries.admin.SinglePromotion
-	5,  // 1: wildberries.admin.SinglePromotion.segments:type_name -> wildberries.admin.SegmentWithOrder
-	48, // 2: wildberries.admin.SinglePromotion.fixed_prices:type_name -> wildberries.admin.SinglePromotion.FixedPricesEntry
-	6,  // 3: wildberries.admin.SinglePromotion.poll:type_name -> wildberries.admin.PromotionPoll
-	7,  // 4: wildberries.admin.PromotionPoll.questions:type_name -> wildberries.admin.PollQuestionAdmin
-	9,  // 5: wildberries.admin.PromotionPoll.answer_tree:type_name -> wildberries.admin.AnswerTreeNode
-	8,  // 6: wildberries.admin.PollQuestionAdmin.options:type_name -> wildberries.admin.PollOptionAdmin
-	15, // 7: wildberries.admin.SetFixedPricesRequest.prices:type_name -> wildberries.admin.FixedPriceEntry
-	49, // 8: wildberries.admin.GenerateSegmentsResponse.segments:type_name -> wildberries.common.Segment
-	7,  // 9: wildberries.admin.GeneratePollResponse.questions:type_name -> wildberries.admin.PollQuestionAdmin
-	9,  // 10: wildberries.admin.GeneratePollResponse.answer_tree:type_name -> wildberries.admin.AnswerTreeNode
-	36, // 11: wildberries.admin.SetPollQuestionsRequest.questions:type_name -> wildberries.admin.SetQuestionInput
-	37, // 12: wildberries.admin.SetQuestionInput.options:type_name -> wildberries.admin.SetOptionInput
-	9,  // 13: wildberries.admin.SetAnswerTreeRequest.nodes:type_name -> wildberries.admin.AnswerTreeNode
-	42, // 14: wildberries.admin.GetModerationApplicationsResponse.applications:type_name -> wildberries.admin.ModerationApplication
-	0,  // 15: wildberries.admin.PromotionAdminService.CreatePromotion:input_type -> wildberries.admin.CreatePromotionRequest
-	2,  // 16: wildberries.admin.PromotionAdminService.GetPromotions:input_type -> wildberries.admin.GetPromotionRequest
-	10, // 17: wildberries.admin.PromotionAdminService.UpdatePromotion:input_type -> wildberries.admin.UpdatePromotionRequest
-	12, // 18: wildberries.admin.PromotionAdminService.DeletePromotion:input_type -> wildberries.admin.DeletePromotionRequest
-	14, // 19: wildberries.admin.PromotionAdminService.SetFixedPrices:input_type -> wildberries.admin.SetFixedPricesRequest
-	17, // 20: wildberries.admin.PromotionAdminService.ChangeStatus:input_type -> wildberries.admin.ChangeStatusRequest
-	19, // 21: wildberries.admin.PromotionAdminService.SetAuctionParams:input_type -> wildberries.admin.SetAuctionParamsRequest
-	21, // 22: wildberries.admin.PromotionAdminService.SetSlotProduct:input_type -> wildberries.admin.SetSlotProductRequest
-	23, // 23: wildberries.admin.SegmentAdminService.GenerateSegments:input_type -> wildberries.admin.GenerateSegmentsRequest
-	25, // 24: wildberries.admin.SegmentAdminService.CreateSegment:input_type -> wildberries.admin.CreateSegmentRequest
-	27, // 25: wildberries.admin.SegmentAdminService.UpdateSegment:input_type -> wildberries.admin.UpdateSegmentRequest
-	29, // 26: wildberries.admin.SegmentAdminService.DeleteSegment:input_type -> wildberries.admin.DeleteSegmentRequest
-	31, // 27: wildberries.admin.SegmentAdminService.ShuffleSegmentCategories:input_type -> wildberries.admin.ShuffleSegmentCategoriesRequest
-	33, // 28: wildberries.admin.PollAdminService.GeneratePoll:input_type -> wildberries.admin.GeneratePollRequest
-	35, // 29: wildberries.admin.PollAdminService.SetPollQuestions:input_type -> wildberries.admin.SetPollQuestionsRequest
-	39, // 30: wildberries.admin.PollAdminService.SetAnswerTree:input_type -> wildberries.admin.SetAnswerTreeRequest
-	41, // 31: wildberries.admin.ModerationService.GetApplications:input_type -> wildberries.admin.GetModerationApplicationsRequest
-	44, // 32: wildberries.admin.ModerationService.Approve:input_type -> wildberries.admin.ApproveModerationRequest
-	46, // 33: wildberries.admin.ModerationService.Reject:input_type -> wildberries.admin.RejectModerationRequest
-	1,  // 34: wildberries.admin.PromotionAdminService.CreatePromotion:output_type -> wildberries.admin.CreatePromotionResponse
-	3,  // 35: wildberries.admin.PromotionAdminService.GetPromotions:output_type -> wildberries.admin.GetPromotionResponse
-	11, // 36: wildberries.admin.PromotionAdminService.UpdatePromotion:output_type -> wildberries.admin.UpdatePromotionResponse
-	13, // 37: wildberries.admin.PromotionAdminService.DeletePromotion:output_type -> wildberries.admin.DeletePromotionResponse
-	16, // 38: wildberries.admin.PromotionAdminService.SetFixedPrices:output_type -> wildberries.admin.SetFixedPricesResponse
-	18, // 39: wildberries.admin.PromotionAdminService.ChangeStatus:output_type -> wildberries.admin.ChangeStatusResponse
-	20, // 40: wildberries.admin.PromotionAdminService.SetAuctionParams:output_type -> wildberries.admin.SetAuctionParamsResponse
-	22, // 41: wildberries.admin.PromotionAdminService.SetSlotProduct:output_type -> wildberries.admin.SetSlotProductResponse
-	24, // 42: wildberries.admin.SegmentAdminService.GenerateSegments:output_type -> wildberries.admin.GenerateSegmentsResponse
-	26, // 43: wildberries.admin.SegmentAdminService.CreateSegment:output_type -> wildberries.admin.CreateSegmentResponse
-	28, // 44: wildberries.admin.SegmentAdminService.UpdateSegment:output_type -> wildberries.admin.UpdateSegmentResponse
-	30, // 45: wildberries.admin.SegmentAdminService.DeleteSegment:output_type -> wildberries.admin.DeleteSegmentResponse
-	32, // 46: wildberries.admin.SegmentAdminService.ShuffleSegmentCategories:output_type -> wildberries.admin.ShuffleSegmentCategoriesResponse
-	34, // 47: wildberries.admin.PollAdminService.GeneratePoll:output_type -> wildberries.admin.GeneratePollResponse
-	38, // 48: wildberries.admin.PollAdminService.SetPollQuestions:output_type -> wildberries.admin.SetPollQuestionsResponse
-	40, // 49: wildberries.admin.PollAdminService.SetAnswerTree:output_type -> wildberries.admin.SetAnswerTreeResponse
-	43, // 50: wildberries.admin.ModerationService.GetApplications:output_type -> wildberries.admin.GetModerationApplicationsResponse
-	45, // 51: wildberries.admin.ModerationService.Approve:output_type -> wildberries.admin.ApproveModerationResponse
-	47, // 52: wildberries.admin.ModerationService.Reject:output_type -> wildberries.admin.RejectModerationResponse
-	34, // [34:53] is the sub-list for method output_type
-	15, // [15:34] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	7,  // 0: wildberries.admin.GeneratePromotionDraftResponse.segments:type_name -> wildberries.admin.SegmentWithOrder
+	8,  // 1: wildberries.admin.GeneratePromotionDraftResponse.poll:type_name -> wildberries.admin.PromotionPoll
+	6,  // 2: wildberries.admin.GetPromotionResponse.promotions:type_name -> wildberries.admin.SinglePromotion
+	7,  // 3: wildberries.admin.SinglePromotion.segments:type_name -> wildberries.admin.SegmentWithOrder
+	96, // 4: wildberries.admin.SinglePromotion.fixed_prices:type_name -> wildberries.admin.SinglePromotion.FixedPricesEntry
+	8,  // 5: wildberries.admin.SinglePromotion.poll:type_name -> wildberries.admin.PromotionPoll
+	9,  // 6: wildberries.admin.PromotionPoll.questions:type_name -> wildberries.admin.PollQuestionAdmin
+	11, // 7: wildberries.admin.PromotionPoll.answer_tree:type_name -> wildberries.admin.AnswerTreeNode
+	10, // 8: wildberries.admin.PollQuestionAdmin.options:type_name -> wildberries.admin.PollOptionAdmin
+	17, // 9: wildberries.admin.SetFixedPricesRequest.prices:type_name -> wildberries.admin.FixedPriceEntry
+	97, // 10: wildberries.admin.GenerateSegmentsResponse.segments:type_name -> wildberries.common.Segment
+	36, // 11: wildberries.admin.ListSegmentTextsResponse.versions:type_name -> wildberries.admin.SegmentTextVersion
+	36, // 12: wildberries.admin.RevertSegmentTextResponse.current:type_name -> wildberries.admin.SegmentTextVersion
+	9,  // 13: wildberries.admin.GeneratePollResponse.questions:type_name -> wildberries.admin.PollQuestionAdmin
+	11, // 14: wildberries.admin.GeneratePollResponse.answer_tree:type_name -> wildberries.admin.AnswerTreeNode
+	43, // 15: wildberries.admin.SetPollQuestionsRequest.questions:type_name -> wildberries.admin.SetQuestionInput
+	44, // 16: wildberries.admin.SetQuestionInput.options:type_name -> wildberries.admin.SetOptionInput
+	11, // 17: wildberries.admin.SetAnswerTreeRequest.nodes:type_name -> wildberries.admin.AnswerTreeNode
+	49, // 18: wildberries.admin.SimulationPath.steps:type_name -> wildberries.admin.SimulationStep
+	50, // 19: wildberries.admin.SimulateIdentificationResponse.paths:type_name -> wildberries.admin.SimulationPath
+	51, // 20: wildberries.admin.SimulateIdentificationResponse.coverage:type_name -> wildberries.admin.SegmentCoverage
+	54, // 21: wildberries.admin.GetModerationApplicationsResponse.applications:type_name -> wildberries.admin.ModerationApplication
+	61, // 22: wildberries.admin.SegmentFunnel.stages:type_name -> wildberries.admin.FunnelStages
+	61, // 23: wildberries.admin.GetPromotionFunnelResponse.total:type_name -> wildberries.admin.FunnelStages
+	62, // 24: wildberries.admin.GetPromotionFunnelResponse.segments:type_name -> wildberries.admin.SegmentFunnel
+	65, // 25: wildberries.admin.GetSegmentDistributionResponse.segments:type_name -> wildberries.admin.SegmentShare
+	68, // 26: wildberries.admin.GetEventTimeSeriesResponse.points:type_name -> wildberries.admin.TimeSeriesPoint
+	71, // 27: wildberries.admin.ComparePromotionsResponse.promotions:type_name -> wildberries.admin.PromotionCohort
+	74, // 28: wildberries.admin.ListAIGenerationsResponse.items:type_name -> wildberries.admin.AIGeneration
+	77, // 29: wildberries.admin.GetAIUsageResponse.days:type_name -> wildberries.admin.AIUsageDay
+	80, // 30: wildberries.admin.ListPromptTemplatesResponse.templates:type_name -> wildberries.admin.PromptTemplate
+	82, // 31: wildberries.admin.ListPromptTemplateVersionsResponse.versions:type_name -> wildberries.admin.PromptTemplateVersion
+	82, // 32: wildberries.admin.CreatePromptTemplateVersionResponse.version:type_name -> wildberries.admin.PromptTemplateVersion
+	89, // 33: wildberries.admin.ListPromotionPromptPinsResponse.pins:type_name -> wildberries.admin.PromotionPromptPin
+	0,  // 34: wildberries.admin.PromotionAdminService.CreatePromotion:input_type -> wildberries.admin.CreatePromotionRequest
+	2,  // 35: wildberries.admin.PromotionAdminService.GeneratePromotionDraft:input_type -> wildberries.admin.GeneratePromotionDraftRequest
+	4,  // 36: wildberries.admin.PromotionAdminService.GetPromotions:input_type -> wildberries.admin.GetPromotionRequest
+	12, // 37: wildberries.admin.PromotionAdminService.UpdatePromotion:input_type -> wildberries.admin.UpdatePromotionRequest
+	14, // 38: wildberries.admin.PromotionAdminService.DeletePromotion:input_type -> wildberries.admin.DeletePromotionRequest
+	16, // 39: wildberries.admin.PromotionAdminService.SetFixedPrices:input_type -> wildberries.admin.SetFixedPricesRequest
+	19, // 40: wildberries.admin.PromotionAdminService.ChangeStatus:input_type -> wildberries.admin.ChangeStatusRequest
+	21, // 41: wildberries.admin.PromotionAdminService.SetAuctionParams:input_type -> wildberries.admin.SetAuctionParamsRequest
+	23, // 42: wildberries.admin.PromotionAdminService.SetSlotProduct:input_type -> wildberries.admin.SetSlotProductRequest
+	25, // 43: wildberries.admin.SegmentAdminService.GenerateSegments:input_type -> wildberries.admin.GenerateSegmentsRequest
+	27, // 44: wildberries.admin.SegmentAdminService.CreateSegment:input_type -> wildberries.admin.CreateSegmentRequest
+	29, // 45: wildberries.admin.SegmentAdminService.UpdateSegment:input_type -> wildberries.admin.UpdateSegmentRequest
+	31, // 46: wildberries.admin.SegmentAdminService.DeleteSegment:input_type -> wildberries.admin.DeleteSegmentRequest
+	33, // 47: wildberries.admin.SegmentAdminService.ShuffleSegmentCategories:input_type -> wildberries.admin.ShuffleSegmentCategoriesRequest
+	35, // 48: wildberries.admin.SegmentAdminService.ListSegmentTexts:input_type -> wildberries.admin.ListSegmentTextsRequest
+	38, // 49: wildberries.admin.SegmentAdminService.RevertSegmentText:input_type -> wildberries.admin.RevertSegmentTextRequest
+	40, // 50: wildberries.admin.PollAdminService.GeneratePoll:input_type -> wildberries.admin.GeneratePollRequest
+	42, // 51: wildberries.admin.PollAdminService.SetPollQuestions:input_type -> wildberries.admin.SetPollQuestionsRequest
+	46, // 52: wildberries.admin.PollAdminService.SetAnswerTree:input_type -> wildberries.admin.SetAnswerTreeRequest
+	48, // 53: wildberries.admin.PollAdminService.SimulateIdentification:input_type -> wildberries.admin.SimulateIdentificationRequest
+	53, // 54: wildberries.admin.ModerationService.GetApplications:input_type -> wildberries.admin.GetModerationApplicationsRequest
+	56, // 55: wildberries.admin.ModerationService.Approve:input_type -> wildberries.admin.ApproveModerationRequest
+	58, // 56: wildberries.admin.ModerationService.Reject:input_type -> wildberries.admin.RejectModerationRequest
+	60, // 57: wildberries.admin.PromotionAnalyticsService.GetPromotionFunnel:input_type -> wildberries.admin.GetPromotionFunnelRequest
+	64, // 58: wildberries.admin.PromotionAnalyticsService.GetSegmentDistribution:input_type -> wildberries.admin.GetSegmentDistributionRequest
+	67, // 59: wildberries.admin.PromotionAnalyticsService.GetEventTimeSeries:input_type -> wildberries.admin.GetEventTimeSeriesRequest
+	70, // 60: wildberries.admin.PromotionAnalyticsService.ComparePromotions:input_type -> wildberries.admin.ComparePromotionsRequest
+	73, // 61: wildberries.admin.AIAuditService.ListAIGenerations:input_type -> wildberries.admin.ListAIGenerationsRequest
+	76, // 62: wildberries.admin.AIAuditService.GetAIUsage:input_type -> wildberries.admin.GetAIUsageRequest
+	79, // 63: wildberries.admin.PromptTemplateService.ListPromptTemplates:input_type -> wildberries.admin.ListPromptTemplatesRequest
+	83, // 64: wildberries.admin.PromptTemplateService.ListPromptTemplateVersions:input_type -> wildberries.admin.ListPromptTemplateVersionsRequest
+	85, // 65: wildberries.admin.PromptTemplateService.CreatePromptTemplateVersion:input_type -> wildberries.admin.CreatePromptTemplateVersionRequest
+	87, // 66: wildberries.admin.PromptTemplateService.ActivatePromptTemplate:input_type -> wildberries.admin.ActivatePromptTemplateRequest
+	90, // 67: wildberries.admin.PromptTemplateService.ListPromotionPromptPins:input_type -> wildberries.admin.ListPromotionPromptPinsRequest
+	92, // 68: wildberries.admin.PromptTemplateService.PinPromotionPrompt:input_type -> wildberries.admin.PinPromotionPromptRequest
+	94, // 69: wildberries.admin.PromptTemplateService.UnpinPromotionPrompt:input_type -> wildberries.admin.UnpinPromotionPromptRequest
+	1,  // 70: wildberries.admin.PromotionAdminService.CreatePromotion:output_type -> wildberries.admin.CreatePromotionResponse
+	3,  // 71: wildberries.admin.PromotionAdminService.GeneratePromotionDraft:output_type -> wildberries.admin.GeneratePromotionDraftResponse
+	5,  // 72: wildberries.admin.PromotionAdminService.GetPromotions:output_type -> wildberries.admin.GetPromotionResponse
+	13, // 73: wildberries.admin.PromotionAdminService.UpdatePromotion:output_type -> wildberries.admin.UpdatePromotionResponse
+	15, // 74: wildberries.admin.PromotionAdminService.DeletePromotion:output_type -> wildberries.admin.DeletePromotionResponse
+	18, // 75: wildberries.admin.PromotionAdminService.SetFixedPrices:output_type -> wildberries.admin.SetFixedPricesResponse
+	20, // 76: wildberries.admin.PromotionAdminService.ChangeStatus:output_type -> wildberries.admin.ChangeStatusResponse
+	22, // 77: wildberries.admin.PromotionAdminService.SetAuctionParams:output_type -> wildberries.admin.SetAuctionParamsResponse
+	24, // 78: wildberries.admin.PromotionAdminService.SetSlotProduct:output_type -> wildberries.admin.SetSlotProductResponse
+	26, // 79: wildberries.admin.SegmentAdminService.GenerateSegments:output_type -> wildberries.admin.GenerateSegmentsResponse
+	28, // 80: wildberries.admin.SegmentAdminService.CreateSegment:output_type -> wildberries.admin.CreateSegmentResponse
+	30, // 81: wildberries.admin.SegmentAdminService.UpdateSegment:output_type -> wildberries.admin.UpdateSegmentResponse
+	32, // 82: wildberries.admin.SegmentAdminService.DeleteSegment:output_type -> wildberries.admin.DeleteSegmentResponse
+	34, // 83: wildberries.admin.SegmentAdminService.ShuffleSegmentCategories:output_type -> wildberries.admin.ShuffleSegmentCategoriesResponse
+	37, // 84: wildberries.admin.SegmentAdminService.ListSegmentTexts:output_type -> wildberries.admin.ListSegmentTextsResponse
+	39, // 85: wildberries.admin.SegmentAdminService.RevertSegmentText:output_type -> wildberries.admin.RevertSegmentTextResponse
+	41, // 86: wildberries.admin.PollAdminService.GeneratePoll:output_type -> wildberries.admin.GeneratePollResponse
+	45, // 87: wildberries.admin.PollAdminService.SetPollQuestions:output_type -> wildberries.admin.SetPollQuestionsResponse
+	47, // 88: wildberries.admin.PollAdminService.SetAnswerTree:output_type -> wildberries.admin.SetAnswerTreeResponse
+	52, // 89: wildberries.admin.PollAdminService.SimulateIdentification:output_type -> wildberries.admin.SimulateIdentificationResponse
+	55, // 90: wildberries.admin.ModerationService.GetApplications:output_type -> wildberries.admin.GetModerationApplicationsResponse
+	57, // 91: wildberries.admin.ModerationService.Approve:output_type -> wildberries.admin.ApproveModerationResponse
+	59, // 92: wildberries.admin.ModerationService.Reject:output_type -> wildberries.admin.RejectModerationResponse
+	63, // 93: wildberries.admin.PromotionAnalyticsService.GetPromotionFunnel:output_type -> wildberries.admin.GetPromotionFunnelResponse
+	66, // 94: wildberries.admin.PromotionAnalyticsService.GetSegmentDistribution:output_type -> wildberries.admin.GetSegmentDistributionResponse
+	69, // 95: wildberries.admin.PromotionAnalyticsService.GetEventTimeSeries:output_type -> wildberries.admin.GetEventTimeSeriesResponse
+	72, // 96: wildberries.admin.PromotionAnalyticsService.ComparePromotions:output_type -> wildberries.admin.ComparePromotionsResponse
+	75, // 97: wildberries.admin.AIAuditService.ListAIGenerations:output_type -> wildberries.admin.ListAIGenerationsResponse
+	78, // 98: wildberries.admin.AIAuditService.GetAIUsage:output_type -> wildberries.admin.GetAIUsageResponse
+	81, // 99: wildberries.admin.PromptTemplateService.ListPromptTemplates:output_type -> wildberries.admin.ListPromptTemplatesResponse
+	84, // 100: wildberries.admin.PromptTemplateService.ListPromptTemplateVersions:output_type -> wildberries.admin.ListPromptTemplateVersionsResponse
+	86, // 101: wildberries.admin.PromptTemplateService.CreatePromptTemplateVersion:output_type -> wildberries.admin.CreatePromptTemplateVersionResponse
+	88, // 102: wildberries.admin.PromptTemplateService.ActivatePromptTemplate:output_type -> wildberries.admin.ActivatePromptTemplateResponse
+	91, // 103: wildberries.admin.PromptTemplateService.ListPromotionPromptPins:output_type -> wildberries.admin.ListPromotionPromptPinsResponse
+	93, // 104: wildberries.admin.PromptTemplateService.PinPromotionPrompt:output_type -> wildberries.admin.PinPromotionPromptResponse
+	95, // 105: wildberries.admin.PromptTemplateService.UnpinPromotionPrompt:output_type -> wildberries.admin.UnpinPromotionPromptResponse
+	70, // [70:106] is the sub-list for method output_type
+	34, // [34:70] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_admin_proto_init() }
@@ -3133,18 +6800,18 @@ func file_admin_proto_init() {
 	if File_admin_proto != nil {
 		return
 	}
-	file_admin_proto_msgTypes[4].OneofWrappers = []any{}
-	file_admin_proto_msgTypes[10].OneofWrappers = []any{}
-	file_admin_proto_msgTypes[27].OneofWrappers = []any{}
+	file_admin_proto_msgTypes[6].OneofWrappers = []any{}
+	file_admin_proto_msgTypes[12].OneofWrappers = []any{}
+	file_admin_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   49,
+			NumMessages:   97,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   7,
 		},
 		GoTypes:           file_admin_proto_goTypes,
 		DependencyIndexes: file_admin_proto_depIdxs,
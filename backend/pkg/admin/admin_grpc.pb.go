@@ -19,14 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PromotionAdminService_CreatePromotion_FullMethodName  = "/wildberries.admin.PromotionAdminService/CreatePromotion"
-	PromotionAdminService_GetPromotions_FullMethodName    = "/wildberries.admin.PromotionAdminService/GetPromotions"
-	PromotionAdminService_UpdatePromotion_FullMethodName  = "/wildberries.admin.PromotionAdminService/UpdatePromotion"
-	PromotionAdminService_DeletePromotion_FullMethodName  = "/wildberries.admin.PromotionAdminService/DeletePromotion"
-	PromotionAdminService_SetFixedPrices_FullMethodName   = "/wildberries.admin.PromotionAdminService/SetFixedPrices"
-	PromotionAdminService_ChangeStatus_FullMethodName     = "/wildberries.admin.PromotionAdminService/ChangeStatus"
-	PromotionAdminService_SetAuctionParams_FullMethodName = "/wildberries.admin.PromotionAdminService/SetAuctionParams"
-	PromotionAdminService_SetSlotProduct_FullMethodName   = "/wildberries.admin.PromotionAdminService/SetSlotProduct"
+	PromotionAdminService_CreatePromotion_FullMethodName        = "/wildberries.admin.PromotionAdminService/CreatePromotion"
+	PromotionAdminService_GeneratePromotionDraft_FullMethodName = "/wildberries.admin.PromotionAdminService/GeneratePromotionDraft"
+	PromotionAdminService_GetPromotions_FullMethodName          = "/wildberries.admin.PromotionAdminService/GetPromotions"
+	PromotionAdminService_UpdatePromotion_FullMethodName        = "/wildberries.admin.PromotionAdminService/UpdatePromotion"
+	PromotionAdminService_DeletePromotion_FullMethodName        = "/wildberries.admin.PromotionAdminService/DeletePromotion"
+	PromotionAdminService_SetFixedPrices_FullMethodName         = "/wildberries.admin.PromotionAdminService/SetFixedPrices"
+	PromotionAdminService_ChangeStatus_FullMethodName           = "/wildberries.admin.PromotionAdminService/ChangeStatus"
+	PromotionAdminService_SetAuctionParams_FullMethodName       = "/wildberries.admin.PromotionAdminService/SetAuctionParams"
+	PromotionAdminService_SetSlotProduct_FullMethodName         = "/wildberries.admin.PromotionAdminService/SetSlotProduct"
 )
 
 // PromotionAdminServiceClient is the client API for PromotionAdminService service.
@@ -36,6 +37,7 @@ const (
 // --- Admin Services ---
 type PromotionAdminServiceClient interface {
 	CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error)
+	GeneratePromotionDraft(ctx context.Context, in *GeneratePromotionDraftRequest, opts ...grpc.CallOption) (*GeneratePromotionDraftResponse, error)
 	GetPromotions(ctx context.Context, in *GetPromotionRequest, opts ...grpc.CallOption) (*GetPromotionResponse, error)
 	UpdatePromotion(ctx context.Context, in *UpdatePromotionRequest, opts ...grpc.CallOption) (*UpdatePromotionResponse, error)
 	DeletePromotion(ctx context.Context, in *DeletePromotionRequest, opts ...grpc.CallOption) (*DeletePromotionResponse, error)
@@ -63,6 +65,16 @@ func (c *promotionAdminServiceClient) CreatePromotion(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *promotionAdminServiceClient) GeneratePromotionDraft(ctx context.Context, in *GeneratePromotionDraftRequest, opts ...grpc.CallOption) (*GeneratePromotionDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePromotionDraftResponse)
+	err := c.cc.Invoke(ctx, PromotionAdminService_GeneratePromotionDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *promotionAdminServiceClient) GetPromotions(ctx context.Context, in *GetPromotionRequest, opts ...grpc.CallOption) (*GetPromotionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPromotionResponse)
@@ -140,6 +152,7 @@ func (c *promotionAdminServiceClient) SetSlotProduct(ctx context.Context, in *Se
 // --- Admin Services ---
 type PromotionAdminServiceServer interface {
 	CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error)
+	GeneratePromotionDraft(context.Context, *GeneratePromotionDraftRequest) (*GeneratePromotionDraftResponse, error)
 	GetPromotions(context.Context, *GetPromotionRequest) (*GetPromotionResponse, error)
 	UpdatePromotion(context.Context, *UpdatePromotionRequest) (*UpdatePromotionResponse, error)
 	DeletePromotion(context.Context, *DeletePromotionRequest) (*DeletePromotionResponse, error)
@@ -160,6 +173,9 @@ type UnimplementedPromotionAdminServiceServer struct{}
 func (UnimplementedPromotionAdminServiceServer) CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreatePromotion not implemented")
 }
+func (UnimplementedPromotionAdminServiceServer) GeneratePromotionDraft(context.Context, *GeneratePromotionDraftRequest) (*GeneratePromotionDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GeneratePromotionDraft not implemented")
+}
 func (UnimplementedPromotionAdminServiceServer) GetPromotions(context.Context, *GetPromotionRequest) (*GetPromotionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPromotions not implemented")
 }
@@ -220,6 +236,24 @@ func _PromotionAdminService_CreatePromotion_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PromotionAdminService_GeneratePromotionDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePromotionDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionAdminServiceServer).GeneratePromotionDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionAdminService_GeneratePromotionDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionAdminServiceServer).GeneratePromotionDraft(ctx, req.(*GeneratePromotionDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PromotionAdminService_GetPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPromotionRequest)
 	if err := dec(in); err != nil {
@@ -357,6 +391,10 @@ var PromotionAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePromotion",
 			Handler:    _PromotionAdminService_CreatePromotion_Handler,
 		},
+		{
+			MethodName: "GeneratePromotionDraft",
+			Handler:    _PromotionAdminService_GeneratePromotionDraft_Handler,
+		},
 		{
 			MethodName: "GetPromotions",
 			Handler:    _PromotionAdminService_GetPromotions_Handler,
@@ -396,6 +434,8 @@ const (
 	SegmentAdminService_UpdateSegment_FullMethodName            = "/wildberries.admin.SegmentAdminService/UpdateSegment"
 	SegmentAdminService_DeleteSegment_FullMethodName            = "/wildberries.admin.SegmentAdminService/DeleteSegment"
 	SegmentAdminService_ShuffleSegmentCategories_FullMethodName = "/wildberries.admin.SegmentAdminService/ShuffleSegmentCategories"
+	SegmentAdminService_ListSegmentTexts_FullMethodName         = "/wildberries.admin.SegmentAdminService/ListSegmentTexts"
+	SegmentAdminService_RevertSegmentText_FullMethodName        = "/wildberries.admin.SegmentAdminService/RevertSegmentText"
 )
 
 // SegmentAdminServiceClient is the client API for SegmentAdminService service.
@@ -407,6 +447,8 @@ type SegmentAdminServiceClient interface {
 	UpdateSegment(ctx context.Context, in *UpdateSegmentRequest, opts ...grpc.CallOption) (*UpdateSegmentResponse, error)
 	DeleteSegment(ctx context.Context, in *DeleteSegmentRequest, opts ...grpc.CallOption) (*DeleteSegmentResponse, error)
 	ShuffleSegmentCategories(ctx context.Context, in *ShuffleSegmentCategoriesRequest, opts ...grpc.CallOption) (*ShuffleSegmentCategoriesResponse, error)
+	ListSegmentTexts(ctx context.Context, in *ListSegmentTextsRequest, opts ...grpc.CallOption) (*ListSegmentTextsResponse, error)
+	RevertSegmentText(ctx context.Context, in *RevertSegmentTextRequest, opts ...grpc.CallOption) (*RevertSegmentTextResponse, error)
 }
 
 type segmentAdminServiceClient struct {
@@ -467,6 +509,26 @@ func (c *segmentAdminServiceClient) ShuffleSegmentCategories(ctx context.Context
 	return out, nil
 }
 
+func (c *segmentAdminServiceClient) ListSegmentTexts(ctx context.Context, in *ListSegmentTextsRequest, opts ...grpc.CallOption) (*ListSegmentTextsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSegmentTextsResponse)
+	err := c.cc.Invoke(ctx, SegmentAdminService_ListSegmentTexts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentAdminServiceClient) RevertSegmentText(ctx context.Context, in *RevertSegmentTextRequest, opts ...grpc.CallOption) (*RevertSegmentTextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertSegmentTextResponse)
+	err := c.cc.Invoke(ctx, SegmentAdminService_RevertSegmentText_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SegmentAdminServiceServer is the server API for SegmentAdminService service.
 // All implementations must embed UnimplementedSegmentAdminServiceServer
 // for forward compatibility.
@@ -476,6 +538,8 @@ type SegmentAdminServiceServer interface {
 	UpdateSegment(context.Context, *UpdateSegmentRequest) (*UpdateSegmentResponse, error)
 	DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error)
 	ShuffleSegmentCategories(context.Context, *ShuffleSegmentCategoriesRequest) (*ShuffleSegmentCategoriesResponse, error)
+	ListSegmentTexts(context.Context, *ListSegmentTextsRequest) (*ListSegmentTextsResponse, error)
+	RevertSegmentText(context.Context, *RevertSegmentTextRequest) (*RevertSegmentTextResponse, error)
 	mustEmbedUnimplementedSegmentAdminServiceServer()
 }
 
@@ -501,6 +565,12 @@ func (UnimplementedSegmentAdminServiceServer) DeleteSegment(context.Context, *De
 func (UnimplementedSegmentAdminServiceServer) ShuffleSegmentCategories(context.Context, *ShuffleSegmentCategoriesRequest) (*ShuffleSegmentCategoriesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ShuffleSegmentCategories not implemented")
 }
+func (UnimplementedSegmentAdminServiceServer) ListSegmentTexts(context.Context, *ListSegmentTextsRequest) (*ListSegmentTextsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSegmentTexts not implemented")
+}
+func (UnimplementedSegmentAdminServiceServer) RevertSegmentText(context.Context, *RevertSegmentTextRequest) (*RevertSegmentTextResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevertSegmentText not implemented")
+}
 func (UnimplementedSegmentAdminServiceServer) mustEmbedUnimplementedSegmentAdminServiceServer() {}
 func (UnimplementedSegmentAdminServiceServer) testEmbeddedByValue()                             {}
 
@@ -612,6 +682,42 @@ func _SegmentAdminService_ShuffleSegmentCategories_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentAdminService_ListSegmentTexts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSegmentTextsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentAdminServiceServer).ListSegmentTexts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentAdminService_ListSegmentTexts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentAdminServiceServer).ListSegmentTexts(ctx, req.(*ListSegmentTextsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentAdminService_RevertSegmentText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertSegmentTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentAdminServiceServer).RevertSegmentText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SegmentAdminService_RevertSegmentText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentAdminServiceServer).RevertSegmentText(ctx, req.(*RevertSegmentTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SegmentAdminService_ServiceDesc is the grpc.ServiceDesc for SegmentAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -639,15 +745,24 @@ var SegmentAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShuffleSegmentCategories",
 			Handler:    _SegmentAdminService_ShuffleSegmentCategories_Handler,
 		},
+		{
+			MethodName: "ListSegmentTexts",
+			Handler:    _SegmentAdminService_ListSegmentTexts_Handler,
+		},
+		{
+			MethodName: "RevertSegmentText",
+			Handler:    _SegmentAdminService_RevertSegmentText_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin.proto",
 }
 
 const (
-	PollAdminService_GeneratePoll_FullMethodName     = "/wildberries.admin.PollAdminService/GeneratePoll"
-	PollAdminService_SetPollQuestions_FullMethodName = "/wildberries.admin.PollAdminService/SetPollQuestions"
-	PollAdminService_SetAnswerTree_FullMethodName    = "/wildberries.admin.PollAdminService/SetAnswerTree"
+	PollAdminService_GeneratePoll_FullMethodName           = "/wildberries.admin.PollAdminService/GeneratePoll"
+	PollAdminService_SetPollQuestions_FullMethodName       = "/wildberries.admin.PollAdminService/SetPollQuestions"
+	PollAdminService_SetAnswerTree_FullMethodName          = "/wildberries.admin.PollAdminService/SetAnswerTree"
+	PollAdminService_SimulateIdentification_FullMethodName = "/wildberries.admin.PollAdminService/SimulateIdentification"
 )
 
 // PollAdminServiceClient is the client API for PollAdminService service.
@@ -657,6 +772,7 @@ type PollAdminServiceClient interface {
 	GeneratePoll(ctx context.Context, in *GeneratePollRequest, opts ...grpc.CallOption) (*GeneratePollResponse, error)
 	SetPollQuestions(ctx context.Context, in *SetPollQuestionsRequest, opts ...grpc.CallOption) (*SetPollQuestionsResponse, error)
 	SetAnswerTree(ctx context.Context, in *SetAnswerTreeRequest, opts ...grpc.CallOption) (*SetAnswerTreeResponse, error)
+	SimulateIdentification(ctx context.Context, in *SimulateIdentificationRequest, opts ...grpc.CallOption) (*SimulateIdentificationResponse, error)
 }
 
 type pollAdminServiceClient struct {
@@ -697,6 +813,16 @@ func (c *pollAdminServiceClient) SetAnswerTree(ctx context.Context, in *SetAnswe
 	return out, nil
 }
 
+func (c *pollAdminServiceClient) SimulateIdentification(ctx context.Context, in *SimulateIdentificationRequest, opts ...grpc.CallOption) (*SimulateIdentificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateIdentificationResponse)
+	err := c.cc.Invoke(ctx, PollAdminService_SimulateIdentification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PollAdminServiceServer is the server API for PollAdminService service.
 // All implementations must embed UnimplementedPollAdminServiceServer
 // for forward compatibility.
@@ -704,6 +830,7 @@ type PollAdminServiceServer interface {
 	GeneratePoll(context.Context, *GeneratePollRequest) (*GeneratePollResponse, error)
 	SetPollQuestions(context.Context, *SetPollQuestionsRequest) (*SetPollQuestionsResponse, error)
 	SetAnswerTree(context.Context, *SetAnswerTreeRequest) (*SetAnswerTreeResponse, error)
+	SimulateIdentification(context.Context, *SimulateIdentificationRequest) (*SimulateIdentificationResponse, error)
 	mustEmbedUnimplementedPollAdminServiceServer()
 }
 
@@ -723,6 +850,9 @@ func (UnimplementedPollAdminServiceServer) SetPollQuestions(context.Context, *Se
 func (UnimplementedPollAdminServiceServer) SetAnswerTree(context.Context, *SetAnswerTreeRequest) (*SetAnswerTreeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetAnswerTree not implemented")
 }
+func (UnimplementedPollAdminServiceServer) SimulateIdentification(context.Context, *SimulateIdentificationRequest) (*SimulateIdentificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateIdentification not implemented")
+}
 func (UnimplementedPollAdminServiceServer) mustEmbedUnimplementedPollAdminServiceServer() {}
 func (UnimplementedPollAdminServiceServer) testEmbeddedByValue()                          {}
 
@@ -798,6 +928,24 @@ func _PollAdminService_SetAnswerTree_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PollAdminService_SimulateIdentification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateIdentificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PollAdminServiceServer).SimulateIdentification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PollAdminService_SimulateIdentification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PollAdminServiceServer).SimulateIdentification(ctx, req.(*SimulateIdentificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PollAdminService_ServiceDesc is the grpc.ServiceDesc for PollAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -817,6 +965,10 @@ var PollAdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAnswerTree",
 			Handler:    _PollAdminService_SetAnswerTree_Handler,
 		},
+		{
+			MethodName: "SimulateIdentification",
+			Handler:    _PollAdminService_SimulateIdentification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin.proto",
@@ -999,3 +1151,690 @@ var ModerationService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin.proto",
 }
+
+const (
+	PromotionAnalyticsService_GetPromotionFunnel_FullMethodName     = "/wildberries.admin.PromotionAnalyticsService/GetPromotionFunnel"
+	PromotionAnalyticsService_GetSegmentDistribution_FullMethodName = "/wildberries.admin.PromotionAnalyticsService/GetSegmentDistribution"
+	PromotionAnalyticsService_GetEventTimeSeries_FullMethodName     = "/wildberries.admin.PromotionAnalyticsService/GetEventTimeSeries"
+	PromotionAnalyticsService_ComparePromotions_FullMethodName      = "/wildberries.admin.PromotionAnalyticsService/ComparePromotions"
+)
+
+// PromotionAnalyticsServiceClient is the client API for PromotionAnalyticsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PromotionAnalyticsServiceClient interface {
+	GetPromotionFunnel(ctx context.Context, in *GetPromotionFunnelRequest, opts ...grpc.CallOption) (*GetPromotionFunnelResponse, error)
+	GetSegmentDistribution(ctx context.Context, in *GetSegmentDistributionRequest, opts ...grpc.CallOption) (*GetSegmentDistributionResponse, error)
+	GetEventTimeSeries(ctx context.Context, in *GetEventTimeSeriesRequest, opts ...grpc.CallOption) (*GetEventTimeSeriesResponse, error)
+	ComparePromotions(ctx context.Context, in *ComparePromotionsRequest, opts ...grpc.CallOption) (*ComparePromotionsResponse, error)
+}
+
+type promotionAnalyticsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromotionAnalyticsServiceClient(cc grpc.ClientConnInterface) PromotionAnalyticsServiceClient {
+	return &promotionAnalyticsServiceClient{cc}
+}
+
+func (c *promotionAnalyticsServiceClient) GetPromotionFunnel(ctx context.Context, in *GetPromotionFunnelRequest, opts ...grpc.CallOption) (*GetPromotionFunnelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPromotionFunnelResponse)
+	err := c.cc.Invoke(ctx, PromotionAnalyticsService_GetPromotionFunnel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionAnalyticsServiceClient) GetSegmentDistribution(ctx context.Context, in *GetSegmentDistributionRequest, opts ...grpc.CallOption) (*GetSegmentDistributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSegmentDistributionResponse)
+	err := c.cc.Invoke(ctx, PromotionAnalyticsService_GetSegmentDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionAnalyticsServiceClient) GetEventTimeSeries(ctx context.Context, in *GetEventTimeSeriesRequest, opts ...grpc.CallOption) (*GetEventTimeSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, PromotionAnalyticsService_GetEventTimeSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionAnalyticsServiceClient) ComparePromotions(ctx context.Context, in *ComparePromotionsRequest, opts ...grpc.CallOption) (*ComparePromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComparePromotionsResponse)
+	err := c.cc.Invoke(ctx, PromotionAnalyticsService_ComparePromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromotionAnalyticsServiceServer is the server API for PromotionAnalyticsService service.
+// All implementations must embed UnimplementedPromotionAnalyticsServiceServer
+// for forward compatibility.
+type PromotionAnalyticsServiceServer interface {
+	GetPromotionFunnel(context.Context, *GetPromotionFunnelRequest) (*GetPromotionFunnelResponse, error)
+	GetSegmentDistribution(context.Context, *GetSegmentDistributionRequest) (*GetSegmentDistributionResponse, error)
+	GetEventTimeSeries(context.Context, *GetEventTimeSeriesRequest) (*GetEventTimeSeriesResponse, error)
+	ComparePromotions(context.Context, *ComparePromotionsRequest) (*ComparePromotionsResponse, error)
+	mustEmbedUnimplementedPromotionAnalyticsServiceServer()
+}
+
+// UnimplementedPromotionAnalyticsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPromotionAnalyticsServiceServer struct{}
+
+func (UnimplementedPromotionAnalyticsServiceServer) GetPromotionFunnel(context.Context, *GetPromotionFunnelRequest) (*GetPromotionFunnelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPromotionFunnel not implemented")
+}
+func (UnimplementedPromotionAnalyticsServiceServer) GetSegmentDistribution(context.Context, *GetSegmentDistributionRequest) (*GetSegmentDistributionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSegmentDistribution not implemented")
+}
+func (UnimplementedPromotionAnalyticsServiceServer) GetEventTimeSeries(context.Context, *GetEventTimeSeriesRequest) (*GetEventTimeSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEventTimeSeries not implemented")
+}
+func (UnimplementedPromotionAnalyticsServiceServer) ComparePromotions(context.Context, *ComparePromotionsRequest) (*ComparePromotionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ComparePromotions not implemented")
+}
+func (UnimplementedPromotionAnalyticsServiceServer) mustEmbedUnimplementedPromotionAnalyticsServiceServer() {
+}
+func (UnimplementedPromotionAnalyticsServiceServer) testEmbeddedByValue() {}
+
+// UnsafePromotionAnalyticsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromotionAnalyticsServiceServer will
+// result in compilation errors.
+type UnsafePromotionAnalyticsServiceServer interface {
+	mustEmbedUnimplementedPromotionAnalyticsServiceServer()
+}
+
+func RegisterPromotionAnalyticsServiceServer(s grpc.ServiceRegistrar, srv PromotionAnalyticsServiceServer) {
+	// If the following call panics, it indicates UnimplementedPromotionAnalyticsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PromotionAnalyticsService_ServiceDesc, srv)
+}
+
+func _PromotionAnalyticsService_GetPromotionFunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromotionFunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionAnalyticsServiceServer).GetPromotionFunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionAnalyticsService_GetPromotionFunnel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionAnalyticsServiceServer).GetPromotionFunnel(ctx, req.(*GetPromotionFunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionAnalyticsService_GetSegmentDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionAnalyticsServiceServer).GetSegmentDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionAnalyticsService_GetSegmentDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionAnalyticsServiceServer).GetSegmentDistribution(ctx, req.(*GetSegmentDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionAnalyticsService_GetEventTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionAnalyticsServiceServer).GetEventTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionAnalyticsService_GetEventTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionAnalyticsServiceServer).GetEventTimeSeries(ctx, req.(*GetEventTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionAnalyticsService_ComparePromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComparePromotionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionAnalyticsServiceServer).ComparePromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionAnalyticsService_ComparePromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionAnalyticsServiceServer).ComparePromotions(ctx, req.(*ComparePromotionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromotionAnalyticsService_ServiceDesc is the grpc.ServiceDesc for PromotionAnalyticsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromotionAnalyticsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wildberries.admin.PromotionAnalyticsService",
+	HandlerType: (*PromotionAnalyticsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPromotionFunnel",
+			Handler:    _PromotionAnalyticsService_GetPromotionFunnel_Handler,
+		},
+		{
+			MethodName: "GetSegmentDistribution",
+			Handler:    _PromotionAnalyticsService_GetSegmentDistribution_Handler,
+		},
+		{
+			MethodName: "GetEventTimeSeries",
+			Handler:    _PromotionAnalyticsService_GetEventTimeSeries_Handler,
+		},
+		{
+			MethodName: "ComparePromotions",
+			Handler:    _PromotionAnalyticsService_ComparePromotions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
+
+const (
+	AIAuditService_ListAIGenerations_FullMethodName = "/wildberries.admin.AIAuditService/ListAIGenerations"
+	AIAuditService_GetAIUsage_FullMethodName        = "/wildberries.admin.AIAuditService/GetAIUsage"
+)
+
+// AIAuditServiceClient is the client API for AIAuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AIAuditServiceClient interface {
+	ListAIGenerations(ctx context.Context, in *ListAIGenerationsRequest, opts ...grpc.CallOption) (*ListAIGenerationsResponse, error)
+	GetAIUsage(ctx context.Context, in *GetAIUsageRequest, opts ...grpc.CallOption) (*GetAIUsageResponse, error)
+}
+
+type aIAuditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAIAuditServiceClient(cc grpc.ClientConnInterface) AIAuditServiceClient {
+	return &aIAuditServiceClient{cc}
+}
+
+func (c *aIAuditServiceClient) ListAIGenerations(ctx context.Context, in *ListAIGenerationsRequest, opts ...grpc.CallOption) (*ListAIGenerationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAIGenerationsResponse)
+	err := c.cc.Invoke(ctx, AIAuditService_ListAIGenerations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aIAuditServiceClient) GetAIUsage(ctx context.Context, in *GetAIUsageRequest, opts ...grpc.CallOption) (*GetAIUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAIUsageResponse)
+	err := c.cc.Invoke(ctx, AIAuditService_GetAIUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AIAuditServiceServer is the server API for AIAuditService service.
+// All implementations must embed UnimplementedAIAuditServiceServer
+// for forward compatibility.
+type AIAuditServiceServer interface {
+	ListAIGenerations(context.Context, *ListAIGenerationsRequest) (*ListAIGenerationsResponse, error)
+	GetAIUsage(context.Context, *GetAIUsageRequest) (*GetAIUsageResponse, error)
+	mustEmbedUnimplementedAIAuditServiceServer()
+}
+
+// UnimplementedAIAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAIAuditServiceServer struct{}
+
+func (UnimplementedAIAuditServiceServer) ListAIGenerations(context.Context, *ListAIGenerationsRequest) (*ListAIGenerationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAIGenerations not implemented")
+}
+func (UnimplementedAIAuditServiceServer) GetAIUsage(context.Context, *GetAIUsageRequest) (*GetAIUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAIUsage not implemented")
+}
+func (UnimplementedAIAuditServiceServer) mustEmbedUnimplementedAIAuditServiceServer() {}
+func (UnimplementedAIAuditServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeAIAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AIAuditServiceServer will
+// result in compilation errors.
+type UnsafeAIAuditServiceServer interface {
+	mustEmbedUnimplementedAIAuditServiceServer()
+}
+
+func RegisterAIAuditServiceServer(s grpc.ServiceRegistrar, srv AIAuditServiceServer) {
+	// If the following call panics, it indicates UnimplementedAIAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AIAuditService_ServiceDesc, srv)
+}
+
+func _AIAuditService_ListAIGenerations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAIGenerationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIAuditServiceServer).ListAIGenerations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIAuditService_ListAIGenerations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIAuditServiceServer).ListAIGenerations(ctx, req.(*ListAIGenerationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AIAuditService_GetAIUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAIUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIAuditServiceServer).GetAIUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIAuditService_GetAIUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIAuditServiceServer).GetAIUsage(ctx, req.(*GetAIUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AIAuditService_ServiceDesc is the grpc.ServiceDesc for AIAuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AIAuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wildberries.admin.AIAuditService",
+	HandlerType: (*AIAuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAIGenerations",
+			Handler:    _AIAuditService_ListAIGenerations_Handler,
+		},
+		{
+			MethodName: "GetAIUsage",
+			Handler:    _AIAuditService_GetAIUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
+
+const (
+	PromptTemplateService_ListPromptTemplates_FullMethodName         = "/wildberries.admin.PromptTemplateService/ListPromptTemplates"
+	PromptTemplateService_ListPromptTemplateVersions_FullMethodName  = "/wildberries.admin.PromptTemplateService/ListPromptTemplateVersions"
+	PromptTemplateService_CreatePromptTemplateVersion_FullMethodName = "/wildberries.admin.PromptTemplateService/CreatePromptTemplateVersion"
+	PromptTemplateService_ActivatePromptTemplate_FullMethodName      = "/wildberries.admin.PromptTemplateService/ActivatePromptTemplate"
+	PromptTemplateService_ListPromotionPromptPins_FullMethodName     = "/wildberries.admin.PromptTemplateService/ListPromotionPromptPins"
+	PromptTemplateService_PinPromotionPrompt_FullMethodName          = "/wildberries.admin.PromptTemplateService/PinPromotionPrompt"
+	PromptTemplateService_UnpinPromotionPrompt_FullMethodName        = "/wildberries.admin.PromptTemplateService/UnpinPromotionPrompt"
+)
+
+// PromptTemplateServiceClient is the client API for PromptTemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PromptTemplateServiceClient interface {
+	ListPromptTemplates(ctx context.Context, in *ListPromptTemplatesRequest, opts ...grpc.CallOption) (*ListPromptTemplatesResponse, error)
+	ListPromptTemplateVersions(ctx context.Context, in *ListPromptTemplateVersionsRequest, opts ...grpc.CallOption) (*ListPromptTemplateVersionsResponse, error)
+	CreatePromptTemplateVersion(ctx context.Context, in *CreatePromptTemplateVersionRequest, opts ...grpc.CallOption) (*CreatePromptTemplateVersionResponse, error)
+	ActivatePromptTemplate(ctx context.Context, in *ActivatePromptTemplateRequest, opts ...grpc.CallOption) (*ActivatePromptTemplateResponse, error)
+	ListPromotionPromptPins(ctx context.Context, in *ListPromotionPromptPinsRequest, opts ...grpc.CallOption) (*ListPromotionPromptPinsResponse, error)
+	PinPromotionPrompt(ctx context.Context, in *PinPromotionPromptRequest, opts ...grpc.CallOption) (*PinPromotionPromptResponse, error)
+	UnpinPromotionPrompt(ctx context.Context, in *UnpinPromotionPromptRequest, opts ...grpc.CallOption) (*UnpinPromotionPromptResponse, error)
+}
+
+type promptTemplateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromptTemplateServiceClient(cc grpc.ClientConnInterface) PromptTemplateServiceClient {
+	return &promptTemplateServiceClient{cc}
+}
+
+func (c *promptTemplateServiceClient) ListPromptTemplates(ctx context.Context, in *ListPromptTemplatesRequest, opts ...grpc.CallOption) (*ListPromptTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptTemplatesResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_ListPromptTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) ListPromptTemplateVersions(ctx context.Context, in *ListPromptTemplateVersionsRequest, opts ...grpc.CallOption) (*ListPromptTemplateVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptTemplateVersionsResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_ListPromptTemplateVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) CreatePromptTemplateVersion(ctx context.Context, in *CreatePromptTemplateVersionRequest, opts ...grpc.CallOption) (*CreatePromptTemplateVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromptTemplateVersionResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_CreatePromptTemplateVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) ActivatePromptTemplate(ctx context.Context, in *ActivatePromptTemplateRequest, opts ...grpc.CallOption) (*ActivatePromptTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivatePromptTemplateResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_ActivatePromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) ListPromotionPromptPins(ctx context.Context, in *ListPromotionPromptPinsRequest, opts ...grpc.CallOption) (*ListPromotionPromptPinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionPromptPinsResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_ListPromotionPromptPins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) PinPromotionPrompt(ctx context.Context, in *PinPromotionPromptRequest, opts ...grpc.CallOption) (*PinPromotionPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinPromotionPromptResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_PinPromotionPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) UnpinPromotionPrompt(ctx context.Context, in *UnpinPromotionPromptRequest, opts ...grpc.CallOption) (*UnpinPromotionPromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinPromotionPromptResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_UnpinPromotionPrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromptTemplateServiceServer is the server API for PromptTemplateService service.
+// All implementations must embed UnimplementedPromptTemplateServiceServer
+// for forward compatibility.
+type PromptTemplateServiceServer interface {
+	ListPromptTemplates(context.Context, *ListPromptTemplatesRequest) (*ListPromptTemplatesResponse, error)
+	ListPromptTemplateVersions(context.Context, *ListPromptTemplateVersionsRequest) (*ListPromptTemplateVersionsResponse, error)
+	CreatePromptTemplateVersion(context.Context, *CreatePromptTemplateVersionRequest) (*CreatePromptTemplateVersionResponse, error)
+	ActivatePromptTemplate(context.Context, *ActivatePromptTemplateRequest) (*ActivatePromptTemplateResponse, error)
+	ListPromotionPromptPins(context.Context, *ListPromotionPromptPinsRequest) (*ListPromotionPromptPinsResponse, error)
+	PinPromotionPrompt(context.Context, *PinPromotionPromptRequest) (*PinPromotionPromptResponse, error)
+	UnpinPromotionPrompt(context.Context, *UnpinPromotionPromptRequest) (*UnpinPromotionPromptResponse, error)
+	mustEmbedUnimplementedPromptTemplateServiceServer()
+}
+
+// UnimplementedPromptTemplateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPromptTemplateServiceServer struct{}
+
+func (UnimplementedPromptTemplateServiceServer) ListPromptTemplates(context.Context, *ListPromptTemplatesRequest) (*ListPromptTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPromptTemplates not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) ListPromptTemplateVersions(context.Context, *ListPromptTemplateVersionsRequest) (*ListPromptTemplateVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPromptTemplateVersions not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) CreatePromptTemplateVersion(context.Context, *CreatePromptTemplateVersionRequest) (*CreatePromptTemplateVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePromptTemplateVersion not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) ActivatePromptTemplate(context.Context, *ActivatePromptTemplateRequest) (*ActivatePromptTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivatePromptTemplate not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) ListPromotionPromptPins(context.Context, *ListPromotionPromptPinsRequest) (*ListPromotionPromptPinsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPromotionPromptPins not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) PinPromotionPrompt(context.Context, *PinPromotionPromptRequest) (*PinPromotionPromptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PinPromotionPrompt not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) UnpinPromotionPrompt(context.Context, *UnpinPromotionPromptRequest) (*UnpinPromotionPromptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpinPromotionPrompt not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) mustEmbedUnimplementedPromptTemplateServiceServer() {}
+func (UnimplementedPromptTemplateServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafePromptTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromptTemplateServiceServer will
+// result in compilation errors.
+type UnsafePromptTemplateServiceServer interface {
+	mustEmbedUnimplementedPromptTemplateServiceServer()
+}
+
+func RegisterPromptTemplateServiceServer(s grpc.ServiceRegistrar, srv PromptTemplateServiceServer) {
+	// If the following call panics, it indicates UnimplementedPromptTemplateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PromptTemplateService_ServiceDesc, srv)
+}
+
+func _PromptTemplateService_ListPromptTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).ListPromptTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_ListPromptTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).ListPromptTemplates(ctx, req.(*ListPromptTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_ListPromptTemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptTemplateVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).ListPromptTemplateVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_ListPromptTemplateVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).ListPromptTemplateVersions(ctx, req.(*ListPromptTemplateVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_CreatePromptTemplateVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromptTemplateVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).CreatePromptTemplateVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_CreatePromptTemplateVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).CreatePromptTemplateVersion(ctx, req.(*CreatePromptTemplateVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_ActivatePromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivatePromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).ActivatePromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_ActivatePromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).ActivatePromptTemplate(ctx, req.(*ActivatePromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_ListPromotionPromptPins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionPromptPinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).ListPromotionPromptPins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_ListPromotionPromptPins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).ListPromotionPromptPins(ctx, req.(*ListPromotionPromptPinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_PinPromotionPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPromotionPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).PinPromotionPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_PinPromotionPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).PinPromotionPrompt(ctx, req.(*PinPromotionPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_UnpinPromotionPrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinPromotionPromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).UnpinPromotionPrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_UnpinPromotionPrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).UnpinPromotionPrompt(ctx, req.(*UnpinPromotionPromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromptTemplateService_ServiceDesc is the grpc.ServiceDesc for PromptTemplateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromptTemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wildberries.admin.PromptTemplateService",
+	HandlerType: (*PromptTemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPromptTemplates",
+			Handler:    _PromptTemplateService_ListPromptTemplates_Handler,
+		},
+		{
+			MethodName: "ListPromptTemplateVersions",
+			Handler:    _PromptTemplateService_ListPromptTemplateVersions_Handler,
+		},
+		{
+			MethodName: "CreatePromptTemplateVersion",
+			Handler:    _PromptTemplateService_CreatePromptTemplateVersion_Handler,
+		},
+		{
+			MethodName: "ActivatePromptTemplate",
+			Handler:    _PromptTemplateService_ActivatePromptTemplate_Handler,
+		},
+		{
+			MethodName: "ListPromotionPromptPins",
+			Handler:    _PromptTemplateService_ListPromotionPromptPins_Handler,
+		},
+		{
+			MethodName: "PinPromotionPrompt",
+			Handler:    _PromptTemplateService_PinPromotionPrompt_Handler,
+		},
+		{
+			MethodName: "UnpinPromotionPrompt",
+			Handler:    _PromptTemplateService_UnpinPromotionPrompt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
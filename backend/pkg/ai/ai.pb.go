@@ -26,6 +26,8 @@ const (
 // --- POST /ai/themes ---
 type GenerateThemesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Async         bool                   `protobuf:"varint,1,opt,name=async,proto3" json:"async,omitempty"` // вернуть job_id сразу, результат — GET /ai/jobs/{id}
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // сколько тем предложить, 1..10; по умолчанию 1
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -60,6 +62,20 @@ func (*GenerateThemesRequest) Descriptor() ([]byte, []int) {
 	return file_ai_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GenerateThemesRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *GenerateThemesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type ThemeItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` // machine name, e.g. zodiac, harry-potter
@@ -115,6 +131,7 @@ func (x *ThemeItem) GetLabel() string {
 type GenerateThemesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Themes        []*ThemeItem           `protobuf:"bytes,1,rep,name=themes,proto3" json:"themes,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // для async запроса; результат пуст
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -156,11 +173,22 @@ func (x *GenerateThemesResponse) GetThemes() []*ThemeItem {
 	return nil
 }
 
+func (x *GenerateThemesResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 // --- POST /ai/segments ---
 type GenerateSegmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Theme         string                 `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"` // не брать ответ из кэша генераций, перегенерировать
+	Async         bool                   `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`
+	SlotCount     int32                  `protobuf:"varint,5,opt,name=slot_count,json=slotCount,proto3" json:"slot_count,omitempty"`       // > 0 — только категории, товаров которых хватит на столько позиций
+	PromotionId   int64                  `protobuf:"varint,6,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"` // optional, версии промптов, закреплённые за акцией
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -209,6 +237,34 @@ func (x *GenerateSegmentsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GenerateSegmentsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *GenerateSegmentsRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *GenerateSegmentsRequest) GetSlotCount() int32 {
+	if x != nil {
+		return x.SlotCount
+	}
+	return 0
+}
+
+func (x *GenerateSegmentsRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
 type SegmentSuggestion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -264,6 +320,7 @@ func (x *SegmentSuggestion) GetCategoryName() string {
 type GenerateSegmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Segments      []*SegmentSuggestion   `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -305,12 +362,25 @@ func (x *GenerateSegmentsResponse) GetSegments() []*SegmentSuggestion {
 	return nil
 }
 
+func (x *GenerateSegmentsResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 // --- POST /ai/questions ---
 type GenerateQuestionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Theme         string                 `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Theme              string                 `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
+	Force              bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // не брать ответ из кэша генераций, перегенерировать
+	Async              bool                   `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
+	PromotionId        int64                  `protobuf:"varint,4,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`    // optional, версии промптов, закреплённые за акцией
+	MinQuestions       int32                  `protobuf:"varint,5,opt,name=min_questions,json=minQuestions,proto3" json:"min_questions,omitempty"` // по умолчанию 4, не больше 10; задана одна граница — ровно столько; вне пределов — INVALID_ARGUMENT
+	MaxQuestions       int32                  `protobuf:"varint,6,opt,name=max_questions,json=maxQuestions,proto3" json:"max_questions,omitempty"`
+	OptionsPerQuestion int32                  `protobuf:"varint,7,opt,name=options_per_question,json=optionsPerQuestion,proto3" json:"options_per_question,omitempty"` // 2..6, по умолчанию 3
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GenerateQuestionsRequest) Reset() {
@@ -350,6 +420,48 @@ func (x *GenerateQuestionsRequest) GetTheme() string {
 	return ""
 }
 
+func (x *GenerateQuestionsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *GenerateQuestionsRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *GenerateQuestionsRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *GenerateQuestionsRequest) GetMinQuestions() int32 {
+	if x != nil {
+		return x.MinQuestions
+	}
+	return 0
+}
+
+func (x *GenerateQuestionsRequest) GetMaxQuestions() int32 {
+	if x != nil {
+		return x.MaxQuestions
+	}
+	return 0
+}
+
+func (x *GenerateQuestionsRequest) GetOptionsPerQuestion() int32 {
+	if x != nil {
+		return x.OptionsPerQuestion
+	}
+	return 0
+}
+
 type QuestionSuggestion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
@@ -457,6 +569,7 @@ func (x *OptionSuggestion) GetValue() string {
 type GenerateQuestionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Questions     []*QuestionSuggestion  `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -498,12 +611,23 @@ func (x *GenerateQuestionsResponse) GetQuestions() []*QuestionSuggestion {
 	return nil
 }
 
+func (x *GenerateQuestionsResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 // --- POST /ai/answer-tree ---
 type GenerateAnswerTreeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Theme         string                 `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Theme              string                 `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
+	Async              bool                   `protobuf:"varint,2,opt,name=async,proto3" json:"async,omitempty"`
+	PromotionId        int64                  `protobuf:"varint,3,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`                        // optional, версии промптов, закреплённые за акцией
+	QuestionCount      int32                  `protobuf:"varint,4,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`                  // вопросов в опросе, по умолчанию 4, не больше 10
+	OptionsPerQuestion int32                  `protobuf:"varint,5,opt,name=options_per_question,json=optionsPerQuestion,proto3" json:"options_per_question,omitempty"` // 2..6, по умолчанию 3
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GenerateAnswerTreeRequest) Reset() {
@@ -543,6 +667,34 @@ func (x *GenerateAnswerTreeRequest) GetTheme() string {
 	return ""
 }
 
+func (x *GenerateAnswerTreeRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *GenerateAnswerTreeRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *GenerateAnswerTreeRequest) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *GenerateAnswerTreeRequest) GetOptionsPerQuestion() int32 {
+	if x != nil {
+		return x.OptionsPerQuestion
+	}
+	return 0
+}
+
 type AnswerTreeNode struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
@@ -614,6 +766,7 @@ func (x *AnswerTreeNode) GetValue() string {
 type GenerateAnswerTreeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Nodes         []*AnswerTreeNode      `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -655,11 +808,21 @@ func (x *GenerateAnswerTreeResponse) GetNodes() []*AnswerTreeNode {
 	return nil
 }
 
+func (x *GenerateAnswerTreeResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
 // --- POST /ai/get-text ---
 type GetTextRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Params        map[string]string      `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // e.g. theme, segment_name
-	SegmentId     int64                  `protobuf:"varint,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`                                                   // optional, для обновления текста сегмента
+	SegmentId     int64                  `protobuf:"varint,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`                                                   // optional, текст для сегмента; контекст сегмента и акции подставляется
+	Async         bool                   `protobuf:"varint,3,opt,name=async,proto3" json:"async,omitempty"`
+	PromotionId   int64                  `protobuf:"varint,4,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"` // optional, тексты для всех сегментов акции
+	Save          bool                   `protobuf:"varint,5,opt,name=save,proto3" json:"save,omitempty"`                                  // сохранить тексты сегментов новой версией
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -708,16 +871,99 @@ func (x *GetTextRequest) GetSegmentId() int64 {
 	return 0
 }
 
+func (x *GetTextRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *GetTextRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *GetTextRequest) GetSave() bool {
+	if x != nil {
+		return x.Save
+	}
+	return false
+}
+
+type SegmentText struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SegmentId     int64                  `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // 0 — текст не сохранялся
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentText) Reset() {
+	*x = SegmentText{}
+	mi := &file_ai_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentText) ProtoMessage() {}
+
+func (x *SegmentText) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentText.ProtoReflect.Descriptor instead.
+func (*SegmentText) Descriptor() ([]byte, []int) {
+	return file_ai_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SegmentText) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *SegmentText) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SegmentText) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetTextResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Segments      []*SegmentText         `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"` // при segment_id или promotion_id
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTextResponse) Reset() {
 	*x = GetTextResponse{}
-	mi := &file_ai_proto_msgTypes[14]
+	mi := &file_ai_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -729,7 +975,7 @@ func (x *GetTextResponse) String() string {
 func (*GetTextResponse) ProtoMessage() {}
 
 func (x *GetTextResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ai_proto_msgTypes[14]
+	mi := &file_ai_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -742,7 +988,7 @@ func (x *GetTextResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTextResponse.ProtoReflect.Descriptor instead.
 func (*GetTextResponse) Descriptor() ([]byte, []int) {
-	return file_ai_proto_rawDescGZIP(), []int{14}
+	return file_ai_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetTextResponse) GetText() string {
@@ -752,66 +998,421 @@ func (x *GetTextResponse) GetText() string {
 	return ""
 }
 
+func (x *GetTextResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *GetTextResponse) GetSegments() []*SegmentText {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// --- GET /ai/jobs/{id} ---
+type GetGenerationJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGenerationJobRequest) Reset() {
+	*x = GetGenerationJobRequest{}
+	mi := &file_ai_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGenerationJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenerationJobRequest) ProtoMessage() {}
+
+func (x *GetGenerationJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGenerationJobRequest.ProtoReflect.Descriptor instead.
+func (*GetGenerationJobRequest) Descriptor() ([]byte, []int) {
+	return file_ai_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetGenerationJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGenerationJobResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind       string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`     // themes | segments | questions | answer_tree | text | promotion_draft
+	Status     string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // queued | running | done | failed
+	Error      string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts   int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreatedAt  string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	StartedAt  string                 `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt string                 `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// результат при status = done; заполнено поле, соответствующее kind
+	Themes         *GenerateThemesResponse     `protobuf:"bytes,9,opt,name=themes,proto3" json:"themes,omitempty"`
+	Segments       *GenerateSegmentsResponse   `protobuf:"bytes,10,opt,name=segments,proto3" json:"segments,omitempty"`
+	Questions      *GenerateQuestionsResponse  `protobuf:"bytes,11,opt,name=questions,proto3" json:"questions,omitempty"`
+	AnswerTree     *GenerateAnswerTreeResponse `protobuf:"bytes,12,opt,name=answer_tree,json=answerTree,proto3" json:"answer_tree,omitempty"`
+	Text           *GetTextResponse            `protobuf:"bytes,13,opt,name=text,proto3" json:"text,omitempty"`
+	PromotionDraft *PromotionDraftResult       `protobuf:"bytes,14,opt,name=promotion_draft,json=promotionDraft,proto3" json:"promotion_draft,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGenerationJobResponse) Reset() {
+	*x = GetGenerationJobResponse{}
+	mi := &file_ai_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGenerationJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenerationJobResponse) ProtoMessage() {}
+
+func (x *GetGenerationJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGenerationJobResponse.ProtoReflect.Descriptor instead.
+func (*GetGenerationJobResponse) Descriptor() ([]byte, []int) {
+	return file_ai_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGenerationJobResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGenerationJobResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetGenerationJobResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetGenerationJobResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetGenerationJobResponse) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *GetGenerationJobResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetGenerationJobResponse) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *GetGenerationJobResponse) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *GetGenerationJobResponse) GetThemes() *GenerateThemesResponse {
+	if x != nil {
+		return x.Themes
+	}
+	return nil
+}
+
+func (x *GetGenerationJobResponse) GetSegments() *GenerateSegmentsResponse {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *GetGenerationJobResponse) GetQuestions() *GenerateQuestionsResponse {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *GetGenerationJobResponse) GetAnswerTree() *GenerateAnswerTreeResponse {
+	if x != nil {
+		return x.AnswerTree
+	}
+	return nil
+}
+
+func (x *GetGenerationJobResponse) GetText() *GetTextResponse {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *GetGenerationJobResponse) GetPromotionDraft() *PromotionDraftResult {
+	if x != nil {
+		return x.PromotionDraft
+	}
+	return nil
+}
+
+// акция NOT_READY, созданная задачей promotion_draft
+type PromotionDraftResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Theme         string                 `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	Segments      []*SegmentSuggestion   `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	SegmentIds    []int64                `protobuf:"varint,6,rep,packed,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"` // в порядке segments
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionDraftResult) Reset() {
+	*x = PromotionDraftResult{}
+	mi := &file_ai_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionDraftResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionDraftResult) ProtoMessage() {}
+
+func (x *PromotionDraftResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ai_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionDraftResult.ProtoReflect.Descriptor instead.
+func (*PromotionDraftResult) Descriptor() ([]byte, []int) {
+	return file_ai_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PromotionDraftResult) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *PromotionDraftResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromotionDraftResult) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromotionDraftResult) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *PromotionDraftResult) GetSegments() []*SegmentSuggestion {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *PromotionDraftResult) GetSegmentIds() []int64 {
+	if x != nil {
+		return x.SegmentIds
+	}
+	return nil
+}
+
 var File_ai_proto protoreflect.FileDescriptor
 
 const file_ai_proto_rawDesc = "" +
 	"\n" +
-	"\bai.proto\x12\x0ewildberries.ai\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x17\n" +
-	"\x15GenerateThemesRequest\"7\n" +
+	"\bai.proto\x12\x0ewildberries.ai\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"C\n" +
+	"\x15GenerateThemesRequest\x12\x14\n" +
+	"\x05async\x18\x01 \x01(\bR\x05async\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"7\n" +
 	"\tThemeItem\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
-	"\x05label\x18\x02 \x01(\tR\x05label\"K\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\"b\n" +
 	"\x16GenerateThemesResponse\x121\n" +
-	"\x06themes\x18\x01 \x03(\v2\x19.wildberries.ai.ThemeItemR\x06themes\"E\n" +
+	"\x06themes\x18\x01 \x03(\v2\x19.wildberries.ai.ThemeItemR\x06themes\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"\xb3\x01\n" +
 	"\x17GenerateSegmentsRequest\x12\x14\n" +
 	"\x05theme\x18\x01 \x01(\tR\x05theme\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"L\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\x12\x14\n" +
+	"\x05async\x18\x04 \x01(\bR\x05async\x12\x1d\n" +
+	"\n" +
+	"slot_count\x18\x05 \x01(\x05R\tslotCount\x12!\n" +
+	"\fpromotion_id\x18\x06 \x01(\x03R\vpromotionId\"L\n" +
 	"\x11SegmentSuggestion\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
-	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\"Y\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\"p\n" +
 	"\x18GenerateSegmentsResponse\x12=\n" +
-	"\bsegments\x18\x01 \x03(\v2!.wildberries.ai.SegmentSuggestionR\bsegments\"0\n" +
+	"\bsegments\x18\x01 \x03(\v2!.wildberries.ai.SegmentSuggestionR\bsegments\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"\xfb\x01\n" +
 	"\x18GenerateQuestionsRequest\x12\x14\n" +
-	"\x05theme\x18\x01 \x01(\tR\x05theme\"d\n" +
+	"\x05theme\x18\x01 \x01(\tR\x05theme\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12\x14\n" +
+	"\x05async\x18\x03 \x01(\bR\x05async\x12!\n" +
+	"\fpromotion_id\x18\x04 \x01(\x03R\vpromotionId\x12#\n" +
+	"\rmin_questions\x18\x05 \x01(\x05R\fminQuestions\x12#\n" +
+	"\rmax_questions\x18\x06 \x01(\x05R\fmaxQuestions\x120\n" +
+	"\x14options_per_question\x18\a \x01(\x05R\x12optionsPerQuestion\"d\n" +
 	"\x12QuestionSuggestion\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12:\n" +
 	"\aoptions\x18\x02 \x03(\v2 .wildberries.ai.OptionSuggestionR\aoptions\"<\n" +
 	"\x10OptionSuggestion\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"]\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"t\n" +
 	"\x19GenerateQuestionsResponse\x12@\n" +
-	"\tquestions\x18\x01 \x03(\v2\".wildberries.ai.QuestionSuggestionR\tquestions\"1\n" +
+	"\tquestions\x18\x01 \x03(\v2\".wildberries.ai.QuestionSuggestionR\tquestions\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"\xc3\x01\n" +
 	"\x19GenerateAnswerTreeRequest\x12\x14\n" +
-	"\x05theme\x18\x01 \x01(\tR\x05theme\"{\n" +
+	"\x05theme\x18\x01 \x01(\tR\x05theme\x12\x14\n" +
+	"\x05async\x18\x02 \x01(\bR\x05async\x12!\n" +
+	"\fpromotion_id\x18\x03 \x01(\x03R\vpromotionId\x12%\n" +
+	"\x0equestion_count\x18\x04 \x01(\x05R\rquestionCount\x120\n" +
+	"\x14options_per_question\x18\x05 \x01(\x05R\x12optionsPerQuestion\"{\n" +
 	"\x0eAnswerTreeNode\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12$\n" +
 	"\x0eparent_node_id\x18\x02 \x01(\tR\fparentNodeId\x12\x14\n" +
 	"\x05label\x18\x03 \x01(\tR\x05label\x12\x14\n" +
-	"\x05value\x18\x04 \x01(\tR\x05value\"R\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"i\n" +
 	"\x1aGenerateAnswerTreeResponse\x124\n" +
-	"\x05nodes\x18\x01 \x03(\v2\x1e.wildberries.ai.AnswerTreeNodeR\x05nodes\"\xae\x01\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1e.wildberries.ai.AnswerTreeNodeR\x05nodes\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"\xfb\x01\n" +
 	"\x0eGetTextRequest\x12B\n" +
 	"\x06params\x18\x01 \x03(\v2*.wildberries.ai.GetTextRequest.ParamsEntryR\x06params\x12\x1d\n" +
 	"\n" +
-	"segment_id\x18\x02 \x01(\x03R\tsegmentId\x1a9\n" +
+	"segment_id\x18\x02 \x01(\x03R\tsegmentId\x12\x14\n" +
+	"\x05async\x18\x03 \x01(\bR\x05async\x12!\n" +
+	"\fpromotion_id\x18\x04 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04save\x18\x05 \x01(\bR\x04save\x1a9\n" +
 	"\vParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"%\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Z\n" +
+	"\vSegmentText\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x01 \x01(\x03R\tsegmentId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\"u\n" +
 	"\x0fGetTextResponse\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text2\xe2\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x127\n" +
+	"\bsegments\x18\x03 \x03(\v2\x1b.wildberries.ai.SegmentTextR\bsegments\")\n" +
+	"\x17GetGenerationJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x87\x05\n" +
+	"\x18GetGenerationJobResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"\tAIService\x12\x90\x02\n" +
-	"\x0eGenerateThemes\x12%.wildberries.ai.GenerateThemesRequest\x1a&.wildberries.ai.GenerateThemesResponse\"\xae\x01\x92A\x95\x01\n" +
-	"\x02AI\x12#Сгенерировать темы\x1aZГенерирует список тем для использования в акциях*\x0eGenerateThemes\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
-	"/ai/themes\x12\xa1\x02\n" +
-	"\x10GenerateSegments\x12'.wildberries.ai.GenerateSegmentsRequest\x1a(.wildberries.ai.GenerateSegmentsResponse\"\xb9\x01\x92A\x9e\x01\n" +
-	"\x02AI\x12+Сгенерировать сегменты\x1aYГенерирует предложения сегментов на основе темы*\x10GenerateSegments\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/ai/segments\x12\x9d\x02\n" +
-	"\x11GenerateQuestions\x12(.wildberries.ai.GenerateQuestionsRequest\x1a).wildberries.ai.GenerateQuestionsResponse\"\xb2\x01\x92A\x96\x01\n" +
-	"\x02AI\x12)Сгенерировать вопросы\x1aRГенерирует вопросы для опроса на основе темы*\x11GenerateQuestions\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/ai/questions\x12\xa2\x02\n" +
-	"\x12GenerateAnswerTree\x12).wildberries.ai.GenerateAnswerTreeRequest\x1a*.wildberries.ai.GenerateAnswerTreeResponse\"\xb4\x01\x92A\x96\x01\n" +
-	"\x02AI\x126Сгенерировать дерево ответов\x1aDГенерирует дерево ответов для опроса*\x12GenerateAnswerTree\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/ai/answer-tree\x12\xd8\x01\n" +
+	"started_at\x18\a \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\b \x01(\tR\n" +
+	"finishedAt\x12>\n" +
+	"\x06themes\x18\t \x01(\v2&.wildberries.ai.GenerateThemesResponseR\x06themes\x12D\n" +
+	"\bsegments\x18\n" +
+	" \x01(\v2(.wildberries.ai.GenerateSegmentsResponseR\bsegments\x12G\n" +
+	"\tquestions\x18\v \x01(\v2).wildberries.ai.GenerateQuestionsResponseR\tquestions\x12K\n" +
+	"\vanswer_tree\x18\f \x01(\v2*.wildberries.ai.GenerateAnswerTreeResponseR\n" +
+	"answerTree\x123\n" +
+	"\x04text\x18\r \x01(\v2\x1f.wildberries.ai.GetTextResponseR\x04text\x12M\n" +
+	"\x0fpromotion_draft\x18\x0e \x01(\v2$.wildberries.ai.PromotionDraftResultR\x0epromotionDraft\"\xe5\x01\n" +
+	"\x14PromotionDraftResult\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05theme\x18\x04 \x01(\tR\x05theme\x12=\n" +
+	"\bsegments\x18\x05 \x03(\v2!.wildberries.ai.SegmentSuggestionR\bsegments\x12\x1f\n" +
+	"\vsegment_ids\x18\x06 \x03(\x03R\n" +
+	"segmentIds2\x90\x11\n" +
+	"\tAIService\x12\x99\x02\n" +
+	"\x0eGenerateThemes\x12%.wildberries.ai.GenerateThemesRequest\x1a&.wildberries.ai.GenerateThemesResponse\"\xb7\x01\x92A\x9e\x01\n" +
+	"\x02AI\x12#Сгенерировать темы\x1acГенерирует count тем на выбор для использования в акциях*\x0eGenerateThemes\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/ai/themes\x12\xc8\x03\n" +
+	"\x10GenerateSegments\x12'.wildberries.ai.GenerateSegmentsRequest\x1a(.wildberries.ai.GenerateSegmentsResponse\"\xe0\x02\x92A\xc5\x02\n" +
+	"\x02AI\x12+Сгенерировать сегменты\x1a\xff\x01Генерирует предложения сегментов на основе темы; category_name выбирается из категорий каталога товаров. Ответ кэшируется, force=true перегенерирует*\x10GenerateSegments\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/ai/segments\x12\xcd\x03\n" +
+	"\x11GenerateQuestions\x12(.wildberries.ai.GenerateQuestionsRequest\x1a).wildberries.ai.GenerateQuestionsResponse\"\xe2\x02\x92A\xc6\x02\n" +
+	"\x02AI\x12)Сгенерировать вопросы\x1a\x81\x02Генерирует вопросы для опроса на основе темы: от min_questions до max_questions вопросов по options_per_question вариантов. Ответ кэшируется, force=true перегенерирует*\x11GenerateQuestions\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/ai/questions\x12\xf5\x02\n" +
+	"\x12GenerateAnswerTree\x12).wildberries.ai.GenerateAnswerTreeRequest\x1a*.wildberries.ai.GenerateAnswerTreeResponse\"\x87\x02\x92A\xe9\x01\n" +
+	"\x02AI\x126Сгенерировать дерево ответов\x1a\x96\x01Генерирует дерево ответов для опроса из question_count вопросов по options_per_question вариантов*\x12GenerateAnswerTree\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/ai/answer-tree\x12\xd8\x01\n" +
 	"\aGetText\x12\x1e.wildberries.ai.GetTextRequest\x1a\x1f.wildberries.ai.GetTextResponse\"\x8b\x01\x92Aq\n" +
-	"\x02AI\x12\x1bПолучить текст\x1aEПолучает текст для сегмента или акции*\aGetText\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/ai/get-textB\xda\x01\x92A\xbf\x01\x12\x85\x01\n" +
+	"\x02AI\x12\x1bПолучить текст\x1aEПолучает текст для сегмента или акции*\aGetText\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/ai/get-text\x12\xf8\x02\n" +
+	"\x10GetGenerationJob\x12'.wildberries.ai.GetGenerationJobRequest\x1a(.wildberries.ai.GetGenerationJobResponse\"\x90\x02\x92A\xf7\x01\n" +
+	"\x02AI\x12:Получить асинхронную генерацию\x1a\xa2\x01Возвращает статус задачи, запущенной запросом с async = true, и результат, когда она выполнена*\x10GetGenerationJob\x82\xd3\xe4\x93\x02\x0f\x12\r/ai/jobs/{id}B\xda\x01\x92A\xbf\x01\x12\x85\x01\n" +
 	"\x0fAI сервис\x12kСервис генерации тем, сегментов, вопросов и дерева ответов2\x051.0.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZ\x15wildberries/pkg/ai;aib\x06proto3"
 
 var (
@@ -826,7 +1427,7 @@ func file_ai_proto_rawDescGZIP() []byte {
 	return file_ai_proto_rawDescData
 }
 
-var file_ai_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_ai_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_ai_proto_goTypes = []any{
 	(*GenerateThemesRequest)(nil),      // 0: wildberries.ai.GenerateThemesRequest
 	(*ThemeItem)(nil),                  // 1: wildberries.ai.ThemeItem
@@ -842,8 +1443,12 @@ var file_ai_proto_goTypes = []any{
 	(*AnswerTreeNode)(nil),             // 11: wildberries.ai.AnswerTreeNode
 	(*GenerateAnswerTreeResponse)(nil), // 12: wildberries.ai.GenerateAnswerTreeResponse
 	(*GetTextRequest)(nil),             // 13: wildberries.ai.GetTextRequest
-	(*GetTextResponse)(nil),            // 14: wildberries.ai.GetTextResponse
-	nil,                                // 15: wildberries.ai.GetTextRequest.ParamsEntry
+	(*SegmentText)(nil),                // 14: wildberries.ai.SegmentText
+	(*GetTextResponse)(nil),            // 15: wildberries.ai.GetTextResponse
+	(*GetGenerationJobRequest)(nil),    // 16: wildberries.ai.GetGenerationJobRequest
+	(*GetGenerationJobResponse)(nil),   // 17: wildberries.ai.GetGenerationJobResponse
+	(*PromotionDraftResult)(nil),       // 18: wildberries.ai.PromotionDraftResult
+	nil,                                // 19: wildberries.ai.GetTextRequest.ParamsEntry
 }
 var file_ai_proto_depIdxs = []int32{
 	1,  // 0: wildberries.ai.GenerateThemesResponse.themes:type_name -> wildberries.ai.ThemeItem
@@ -851,22 +1456,32 @@ var file_ai_proto_depIdxs = []int32{
 	8,  // 2: wildberries.ai.QuestionSuggestion.options:type_name -> wildberries.ai.OptionSuggestion
 	7,  // 3: wildberries.ai.GenerateQuestionsResponse.questions:type_name -> wildberries.ai.QuestionSuggestion
 	11, // 4: wildberries.ai.GenerateAnswerTreeResponse.nodes:type_name -> wildberries.ai.AnswerTreeNode
-	15, // 5: wildberries.ai.GetTextRequest.params:type_name -> wildberries.ai.GetTextRequest.ParamsEntry
-	0,  // 6: wildberries.ai.AIService.GenerateThemes:input_type -> wildberries.ai.GenerateThemesRequest
-	3,  // 7: wildberries.ai.AIService.GenerateSegments:input_type -> wildberries.ai.GenerateSegmentsRequest
-	6,  // 8: wildberries.ai.AIService.GenerateQuestions:input_type -> wildberries.ai.GenerateQuestionsRequest
-	10, // 9: wildberries.ai.AIService.GenerateAnswerTree:input_type -> wildberries.ai.GenerateAnswerTreeRequest
-	13, // 10: wildberries.ai.AIService.GetText:input_type -> wildberries.ai.GetTextRequest
-	2,  // 11: wildberries.ai.AIService.GenerateThemes:output_type -> wildberries.ai.GenerateThemesResponse
-	5,  // 12: wildberries.ai.AIService.GenerateSegments:output_type -> wildberries.ai.GenerateSegmentsResponse
-	9,  // 13: wildberries.ai.AIService.GenerateQuestions:output_type -> wildberries.ai.GenerateQuestionsResponse
-	12, // 14: wildberries.ai.AIService.GenerateAnswerTree:output_type -> wildberries.ai.GenerateAnswerTreeResponse
-	14, // 15: wildberries.ai.AIService.GetText:output_type -> wildberries.ai.GetTextResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	19, // 5: wildberries.ai.GetTextRequest.params:type_name -> wildberries.ai.GetTextRequest.ParamsEntry
+	14, // 6: wildberries.ai.GetTextResponse.segments:type_name -> wildberries.ai.SegmentText
+	2,  // 7: wildberries.ai.GetGenerationJobResponse.themes:type_name -> wildberries.ai.GenerateThemesResponse
+	5,  // 8: wildberries.ai.GetGenerationJobResponse.segments:type_name -> wildberries.ai.GenerateSegmentsResponse
+	9,  // 9: wildberries.ai.GetGenerationJobResponse.questions:type_name -> wildberries.ai.GenerateQuestionsResponse
+	12, // 10: wildberries.ai.GetGenerationJobResponse.answer_tree:type_name -> wildberries.ai.GenerateAnswerTreeResponse
+	15, // 11: wildberries.ai.GetGenerationJobResponse.text:type_name -> wildberries.ai.GetTextResponse
+	18, // 12: wildberries.ai.GetGenerationJobResponse.promotion_draft:type_name -> wildberries.ai.PromotionDraftResult
+	4,  // 13: wildberries.ai.PromotionDraftResult.segments:type_name -> wildberries.ai.SegmentSuggestion
+	0,  // 14: wildberries.ai.AIService.GenerateThemes:input_type -> wildberries.ai.GenerateThemesRequest
+	3,  // 15: wildberries.ai.AIService.GenerateSegments:input_type -> wildberries.ai.GenerateSegmentsRequest
+	6,  // 16: wildberries.ai.AIService.GenerateQuestions:input_type -> wildberries.ai.GenerateQuestionsRequest
+	10, // 17: wildberries.ai.AIService.GenerateAnswerTree:input_type -> wildberries.ai.GenerateAnswerTreeRequest
+	13, // 18: wildberries.ai.AIService.GetText:input_type -> wildberries.ai.GetTextRequest
+	16, // 19: wildberries.ai.AIService.GetGenerationJob:input_type -> wildberries.ai.GetGenerationJobRequest
+	2,  // 20: wildberries.ai.AIService.GenerateThemes:output_type -> wildberries.ai.GenerateThemesResponse
+	5,  // 21: wildberries.ai.AIService.GenerateSegments:output_type -> wildberries.ai.GenerateSegmentsResponse
+	9,  // 22: wildberries.ai.AIService.GenerateQuestions:output_type -> wildberries.ai.GenerateQuestionsResponse
+	12, // 23: wildberries.ai.AIService.GenerateAnswerTree:output_type -> wildberries.ai.GenerateAnswerTreeResponse
+	15, // 24: wildberries.ai.AIService.GetText:output_type -> wildberries.ai.GetTextResponse
+	17, // 25: wildberries.ai.AIService.GetGenerationJob:output_type -> wildberries.ai.GetGenerationJobResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_ai_proto_init() }
@@ -880,7 +1495,7 @@ func file_ai_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ai_proto_rawDesc), len(file_ai_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
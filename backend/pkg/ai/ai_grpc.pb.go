@@ -24,6 +24,7 @@ const (
 	AIService_GenerateQuestions_FullMethodName  = "/wildberries.ai.AIService/GenerateQuestions"
 	AIService_GenerateAnswerTree_FullMethodName = "/wildberries.ai.AIService/GenerateAnswerTree"
 	AIService_GetText_FullMethodName            = "/wildberries.ai.AIService/GetText"
+	AIService_GetGenerationJob_FullMethodName   = "/wildberries.ai.AIService/GetGenerationJob"
 )
 
 // AIServiceClient is the client API for AIService service.
@@ -37,6 +38,7 @@ type AIServiceClient interface {
 	GenerateQuestions(ctx context.Context, in *GenerateQuestionsRequest, opts ...grpc.CallOption) (*GenerateQuestionsResponse, error)
 	GenerateAnswerTree(ctx context.Context, in *GenerateAnswerTreeRequest, opts ...grpc.CallOption) (*GenerateAnswerTreeResponse, error)
 	GetText(ctx context.Context, in *GetTextRequest, opts ...grpc.CallOption) (*GetTextResponse, error)
+	GetGenerationJob(ctx context.Context, in *GetGenerationJobRequest, opts ...grpc.CallOption) (*GetGenerationJobResponse, error)
 }
 
 type aIServiceClient struct {
@@ -97,6 +99,16 @@ func (c *aIServiceClient) GetText(ctx context.Context, in *GetTextRequest, opts
 	return out, nil
 }
 
+func (c *aIServiceClient) GetGenerationJob(ctx context.Context, in *GetGenerationJobRequest, opts ...grpc.CallOption) (*GetGenerationJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGenerationJobResponse)
+	err := c.cc.Invoke(ctx, AIService_GetGenerationJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AIServiceServer is the server API for AIService service.
 // All implementations must embed UnimplementedAIServiceServer
 // for forward compatibility.
@@ -108,6 +120,7 @@ type AIServiceServer interface {
 	GenerateQuestions(context.Context, *GenerateQuestionsRequest) (*GenerateQuestionsResponse, error)
 	GenerateAnswerTree(context.Context, *GenerateAnswerTreeRequest) (*GenerateAnswerTreeResponse, error)
 	GetText(context.Context, *GetTextRequest) (*GetTextResponse, error)
+	GetGenerationJob(context.Context, *GetGenerationJobRequest) (*GetGenerationJobResponse, error)
 	mustEmbedUnimplementedAIServiceServer()
 }
 
@@ -133,6 +146,9 @@ func (UnimplementedAIServiceServer) GenerateAnswerTree(context.Context, *Generat
 func (UnimplementedAIServiceServer) GetText(context.Context, *GetTextRequest) (*GetTextResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetText not implemented")
 }
+func (UnimplementedAIServiceServer) GetGenerationJob(context.Context, *GetGenerationJobRequest) (*GetGenerationJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGenerationJob not implemented")
+}
 func (UnimplementedAIServiceServer) mustEmbedUnimplementedAIServiceServer() {}
 func (UnimplementedAIServiceServer) testEmbeddedByValue()                   {}
 
@@ -244,6 +260,24 @@ func _AIService_GetText_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AIService_GetGenerationJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGenerationJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AIServiceServer).GetGenerationJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AIService_GetGenerationJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AIServiceServer).GetGenerationJob(ctx, req.(*GetGenerationJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AIService_ServiceDesc is the grpc.ServiceDesc for AIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -271,6 +305,10 @@ var AIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetText",
 			Handler:    _AIService_GetText_Handler,
 		},
+		{
+			MethodName: "GetGenerationJob",
+			Handler:    _AIService_GetGenerationJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ai.proto",
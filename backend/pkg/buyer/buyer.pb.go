@@ -28,6 +28,9 @@ const (
 // GET /promotions/current
 type GetCurrentPromotionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   *int64                 `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3,oneof" json:"promotion_id,omitempty"` // если не задан — акция с наибольшим приоритетом
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`                                     // пусто — только акции без таргетинга по региону
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`                                 // пусто — только акции без таргетинга по платформе
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -62,6 +65,27 @@ func (*GetCurrentPromotionRequest) Descriptor() ([]byte, []int) {
 	return file_buyer_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetCurrentPromotionRequest) GetPromotionId() int64 {
+	if x != nil && x.PromotionId != nil {
+		return *x.PromotionId
+	}
+	return 0
+}
+
+func (x *GetCurrentPromotionRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetCurrentPromotionRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
 type GetCurrentPromotionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -162,6 +186,212 @@ func (x *GetCurrentPromotionResponse) GetSegments() []*common.Segment {
 	return nil
 }
 
+// --- Buyer: все активные акции ---
+// GET /promotions/active
+type ListActivePromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        string                 `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`     // пусто — только акции без таргетинга по региону
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"` // пусто — только акции без таргетинга по платформе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivePromotionsRequest) Reset() {
+	*x = ListActivePromotionsRequest{}
+	mi := &file_buyer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivePromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivePromotionsRequest) ProtoMessage() {}
+
+func (x *ListActivePromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buyer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivePromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListActivePromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_buyer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListActivePromotionsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListActivePromotionsRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+type ActivePromotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Theme         string                 `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	DateFrom      string                 `protobuf:"bytes,6,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        string                 `protobuf:"bytes,7,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	Priority      int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	Segments      []*common.Segment      `protobuf:"bytes,9,rep,name=segments,proto3" json:"segments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivePromotion) Reset() {
+	*x = ActivePromotion{}
+	mi := &file_buyer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivePromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivePromotion) ProtoMessage() {}
+
+func (x *ActivePromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_buyer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivePromotion.ProtoReflect.Descriptor instead.
+func (*ActivePromotion) Descriptor() ([]byte, []int) {
+	return file_buyer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivePromotion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivePromotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivePromotion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ActivePromotion) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *ActivePromotion) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ActivePromotion) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ActivePromotion) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *ActivePromotion) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ActivePromotion) GetSegments() []*common.Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type ListActivePromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*ActivePromotion     `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"` // по убыванию приоритета
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivePromotionsResponse) Reset() {
+	*x = ListActivePromotionsResponse{}
+	mi := &file_buyer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivePromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivePromotionsResponse) ProtoMessage() {}
+
+func (x *ListActivePromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buyer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivePromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListActivePromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_buyer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListActivePromotionsResponse) GetPromotions() []*ActivePromotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
 // --- Buyer: продукты сегмента ---
 // GET /promotions/{promotionId}/segments/{segmentId}/products
 type GetSegmentProductsRequest struct {
@@ -170,16 +400,16 @@ type GetSegmentProductsRequest struct {
 	SegmentId     int64                  `protobuf:"varint,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
 	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                                 // filter
 	OnlyDiscounts bool                   `protobuf:"varint,4,opt,name=only_discounts,json=onlyDiscounts,proto3" json:"only_discounts,omitempty"` // filter
-	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`                                         // e.g. price_asc, discount_desc
-	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,7,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`                                         // position_asc (default), position_desc, price_asc, price_desc, discount_asc, discount_desc
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`                                        // с 1
+	PerPage       int32                  `protobuf:"varint,7,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`                   // по умолчанию 20, максимум 100
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSegmentProductsRequest) Reset() {
 	*x = GetSegmentProductsRequest{}
-	mi := &file_buyer_proto_msgTypes[2]
+	mi := &file_buyer_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +421,7 @@ func (x *GetSegmentProductsRequest) String() string {
 func (*GetSegmentProductsRequest) ProtoMessage() {}
 
 func (x *GetSegmentProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_buyer_proto_msgTypes[2]
+	mi := &file_buyer_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +434,7 @@ func (x *GetSegmentProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSegmentProductsRequest.ProtoReflect.Descriptor instead.
 func (*GetSegmentProductsRequest) Descriptor() ([]byte, []int) {
-	return file_buyer_proto_rawDescGZIP(), []int{2}
+	return file_buyer_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetSegmentProductsRequest) GetPromotionId() int64 {
@@ -269,7 +499,7 @@ type GetSegmentProductsResponse struct {
 
 func (x *GetSegmentProductsResponse) Reset() {
 	*x = GetSegmentProductsResponse{}
-	mi := &file_buyer_proto_msgTypes[3]
+	mi := &file_buyer_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -281,7 +511,7 @@ func (x *GetSegmentProductsResponse) String() string {
 func (*GetSegmentProductsResponse) ProtoMessage() {}
 
 func (x *GetSegmentProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_buyer_proto_msgTypes[3]
+	mi := &file_buyer_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +524,7 @@ func (x *GetSegmentProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSegmentProductsResponse.ProtoReflect.Descriptor instead.
 func (*GetSegmentProductsResponse) Descriptor() ([]byte, []int) {
-	return file_buyer_proto_rawDescGZIP(), []int{3}
+	return file_buyer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetSegmentProductsResponse) GetItems() []*common.ProductItem {
@@ -343,7 +573,7 @@ type StartIdentificationRequest struct {
 
 func (x *StartIdentificationRequest) Reset() {
 	*x = StartIdentificationRequest{}
-	mi := &file_buyer_proto_msgTypes[4]
+	mi := &file_buyer_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +585,7 @@ func (x *StartIdentificationRequest) String() string {
 func (*StartIdentificationRequest) ProtoMessage() {}
 
 func (x *StartIdentificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_buyer_proto_msgTypes[4]
+	mi := &file_buyer_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +598,7 @@ func (x *StartIdentificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartIdentificationRequest.ProtoReflect.Descriptor instead.
 func (*StartIdentificationRequest) Descriptor() ([]byte, []int) {
-	return file_buyer_proto_rawDescGZIP(), []int{4}
+	return file_buyer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *StartIdentificationRequest) GetPromotionId() int64 {
@@ -389,7 +619,7 @@ type PollQuestion struct {
 
 func (x *PollQuestion) Reset() {
 	*x = PollQuestion{}
-	mi := &file_buyer_proto_msgTypes[5]
+	mi := &file_buyer_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -401,7 +631,7 @@ func (x *PollQuestion) String() string {
 func (*PollQuestion) ProtoMessage() {}
 
 func (x *PollQuestion) ProtoReflect() protoreflect.Message {
-	mi := &file_buyer_proto_msgTypes[5]
+	mi := &file_buyer_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +644,7 @@ func (x *PollQuestion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PollQuestion.ProtoReflect.Descriptor instead.
 func (*PollQuestion) Descriptor() ([]byte, []int) {
-	return file_buyer_proto_rawDescGZIP(), []int{5}
+	return file_buyer_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PollQuestion) GetId() int64 {
@@ -449,7 +679,7 @@ type PollOption struct {
 
 func (x *PollOption) Reset() {
 	*x = PollOption{}
-	mi := &file_buyer_proto_msgTypes[6]
+	mi := &file_buyer_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +691,7 @@ func (x *PollOption) String() string {
 func (*PollOption) ProtoMessage() {}
 
 func (x *PollOption) ProtoReflect() protoreflect.Message {
-	mi := &file_buyer_proto_msgTypes[6]
+	mi := &file_buyer_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +704,7 @@ func (x *PollOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
 func (*PollOption) Descriptor() ([]byte, []int) {
-	return file_buyer_proto_rawDescGZIP(), []int{6}
+	return file_buyer_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PollOption) GetId() int64 {
@@ -512,7 +742,7 @@ type StartIdentificationResponse struct {
 
 func (x *StartIdentificationResponse) Reset() {
 	*x = StartIdentificationResponse{}
-	mi := &file_buyer_proto_msgTypes[7]
+	mi := &file_buyer_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -524,7 +754,7 @@ func (x *StartIdentificationResponse) String() string {
 func (*StartIdentificationResponse) ProtoMessage() {}
 
 func (x *StartIdentificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_buyer_proto_msgTypes[7]
+	mi := &file_buyer_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +767,7 @@ func (x *StartIdentificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartIdentificationResponse.ProtoReflect.Descriptor instead.
 func (*StartIdentificationResponse) Descriptor() ([]byte, []int) {
-	return file_buyer_proto_rawDescGZIP(), []int{7}
+	return file_buyer_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StartIdentificationResponse) GetMethod() string {
@@ -597,7 +827,7 @@ type Poll struct {
 
 func (x *Poll) Reset() {
 	*x = Poll{}
-	mi := &file_buyer_proto_msgTypes[8]
+	mi := &file_buyer_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +839,7 @@ func (x *Poll) String() string {
 func (*Poll) ProtoMessage() {}
 
 func (x *Poll) ProtoReflect() protoreflect.Message {
-	mi := &file_buyer_proto_msgTypes[8]
+	mi := &file_buyer_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +852,7 @@ func (x *Poll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Poll.ProtoReflect.Descriptor instead.
 func (*Poll) Descriptor() ([]byte, []int) {
-	return file_buyer_proto_rawDescGZIP(), []int{8}
+	return file_buyer_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Poll) GetQuestions() []*PollQuestion {
@@ -644,7 +874,7 @@ type AnswerRequest struct {
 
 func (x *AnswerRequest) Reset() {
 	*x = AnswerRequest{}
-	mi := &file_buyer_proto_msgTypes[9]
+	mi := &file_buyer_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -656,7 +886,7 @@ func (x *AnswerRequest) String() string {
 func (*AnswerRequest) ProtoMessage() {}
 
 func (x *AnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_buyer_proto_msgTypes[9]
+	mi := &file_buyer_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +899,7 @@ func (x *AnswerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnswerRequest.ProtoReflect.Descriptor instead.
 func (*AnswerRequest) Descriptor() ([]byte, []int) {
-	return file_buyer_proto_rawDescGZIP(), []int{9}
+	return file_buyer_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AnswerRequest) GetPromotionId() int64 {
@@ -703,7 +933,7 @@ type AnswerResponse struct {
 
 func (x *AnswerResponse) Reset() {
 	*x = AnswerResponse{}
-	mi := &file_buyer_proto_msgTypes[10]
+	mi := &file_buyer_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +945,7 @@ func (x *AnswerResponse) String() string {
 func (*AnswerResponse) ProtoMessage() {}
 
 func (x *AnswerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_buyer_proto_msgTypes[10]
+	mi := &file_buyer_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +958,7 @@ func (x *AnswerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnswerResponse.ProtoReflect.Descriptor instead.
 func (*AnswerResponse) Descriptor() ([]byte, []int) {
-	return file_buyer_proto_rawDescGZIP(), []int{10}
+	return file_buyer_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AnswerResponse) GetNextQuestionId() int64 {
@@ -745,12 +975,290 @@ func (x *AnswerResponse) GetResultSegmentId() int64 {
 	return 0
 }
 
+// --- Events ---
+// POST /events
+type BuyerEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // идемпотентность; пусто — генерируется сервером
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                      // promotion_view | quiz_start | question_answered | segment_resolved | product_impression | product_click
+	PromotionId   int64                  `protobuf:"varint,3,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	SegmentId     int64                  `protobuf:"varint,4,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	QuestionId    int64                  `protobuf:"varint,5,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	OptionId      int64                  `protobuf:"varint,6,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,7,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,8,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC3339; пусто — время сервера, вне окна [-24h, +5m] событие отклоняется
+	Properties    map[string]string      `protobuf:"bytes,10,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SlotId        int64                  `protobuf:"varint,11,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"` // слот товара; пусто — определяется по segment_id и product_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyerEvent) Reset() {
+	*x = BuyerEvent{}
+	mi := &file_buyer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyerEvent) ProtoMessage() {}
+
+func (x *BuyerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_buyer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyerEvent.ProtoReflect.Descriptor instead.
+func (*BuyerEvent) Descriptor() ([]byte, []int) {
+	return file_buyer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BuyerEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *BuyerEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BuyerEvent) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *BuyerEvent) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *BuyerEvent) GetQuestionId() int64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *BuyerEvent) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *BuyerEvent) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *BuyerEvent) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *BuyerEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *BuyerEvent) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *BuyerEvent) GetSlotId() int64 {
+	if x != nil {
+		return x.SlotId
+	}
+	return 0
+}
+
+type TrackEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*BuyerEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // не более 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackEventsRequest) Reset() {
+	*x = TrackEventsRequest{}
+	mi := &file_buyer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackEventsRequest) ProtoMessage() {}
+
+func (x *TrackEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buyer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackEventsRequest.ProtoReflect.Descriptor instead.
+func (*TrackEventsRequest) Descriptor() ([]byte, []int) {
+	return file_buyer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TrackEventsRequest) GetEvents() []*BuyerEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type RejectedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedEvent) Reset() {
+	*x = RejectedEvent{}
+	mi := &file_buyer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedEvent) ProtoMessage() {}
+
+func (x *RejectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_buyer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedEvent.ProtoReflect.Descriptor instead.
+func (*RejectedEvent) Descriptor() ([]byte, []int) {
+	return file_buyer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RejectedEvent) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RejectedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TrackEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected      []*RejectedEvent       `protobuf:"bytes,2,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackEventsResponse) Reset() {
+	*x = TrackEventsResponse{}
+	mi := &file_buyer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackEventsResponse) ProtoMessage() {}
+
+func (x *TrackEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buyer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackEventsResponse.ProtoReflect.Descriptor instead.
+func (*TrackEventsResponse) Descriptor() ([]byte, []int) {
+	return file_buyer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TrackEventsResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *TrackEventsResponse) GetRejected() []*RejectedEvent {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
 var File_buyer_proto protoreflect.FileDescriptor
 
 const file_buyer_proto_rawDesc = "" +
 	"\n" +
-	"\vbuyer.proto\x12\x11wildberries.buyer\x1a\fcommon.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x1c\n" +
-	"\x1aGetCurrentPromotionRequest\"\x80\x02\n" +
+	"\vbuyer.proto\x12\x11wildberries.buyer\x1a\fcommon.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x89\x01\n" +
+	"\x1aGetCurrentPromotionRequest\x12&\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03H\x00R\vpromotionId\x88\x01\x01\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatformB\x0f\n" +
+	"\r_promotion_id\"\x80\x02\n" +
 	"\x1bGetCurrentPromotionResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -759,7 +1267,24 @@ const file_buyer_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1b\n" +
 	"\tdate_from\x18\x06 \x01(\tR\bdateFrom\x12\x17\n" +
 	"\adate_to\x18\a \x01(\tR\x06dateTo\x127\n" +
-	"\bsegments\x18\b \x03(\v2\x1b.wildberries.common.SegmentR\bsegments\"\xe3\x01\n" +
+	"\bsegments\x18\b \x03(\v2\x1b.wildberries.common.SegmentR\bsegments\"Q\n" +
+	"\x1bListActivePromotionsRequest\x12\x16\n" +
+	"\x06region\x18\x01 \x01(\tR\x06region\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\"\x90\x02\n" +
+	"\x0fActivePromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05theme\x18\x04 \x01(\tR\x05theme\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1b\n" +
+	"\tdate_from\x18\x06 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\a \x01(\tR\x06dateTo\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x127\n" +
+	"\bsegments\x18\t \x03(\v2\x1b.wildberries.common.SegmentR\bsegments\"b\n" +
+	"\x1cListActivePromotionsResponse\x12B\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\".wildberries.buyer.ActivePromotionR\n" +
+	"promotions\"\xe3\x01\n" +
 	"\x19GetSegmentProductsRequest\x12!\n" +
 	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x1d\n" +
 	"\n" +
@@ -800,18 +1325,56 @@ const file_buyer_proto_rawDesc = "" +
 	"\toption_id\x18\x03 \x01(\x03R\boptionId\"f\n" +
 	"\x0eAnswerResponse\x12(\n" +
 	"\x10next_question_id\x18\x01 \x01(\x03R\x0enextQuestionId\x12*\n" +
-	"\x11result_segment_id\x18\x02 \x01(\x03R\x0fresultSegmentId2\xac\x05\n" +
+	"\x11result_segment_id\x18\x02 \x01(\x03R\x0fresultSegmentId\"\xc1\x03\n" +
+	"\n" +
+	"BuyerEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12!\n" +
+	"\fpromotion_id\x18\x03 \x01(\x03R\vpromotionId\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x04 \x01(\x03R\tsegmentId\x12\x1f\n" +
+	"\vquestion_id\x18\x05 \x01(\x03R\n" +
+	"questionId\x12\x1b\n" +
+	"\toption_id\x18\x06 \x01(\x03R\boptionId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\a \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\b \x01(\tR\tsessionId\x12\x1f\n" +
+	"\voccurred_at\x18\t \x01(\tR\n" +
+	"occurredAt\x12M\n" +
+	"\n" +
+	"properties\x18\n" +
+	" \x03(\v2-.wildberries.buyer.BuyerEvent.PropertiesEntryR\n" +
+	"properties\x12\x17\n" +
+	"\aslot_id\x18\v \x01(\x03R\x06slotId\x1a=\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
+	"\x12TrackEventsRequest\x125\n" +
+	"\x06events\x18\x01 \x03(\v2\x1d.wildberries.buyer.BuyerEventR\x06events\"=\n" +
+	"\rRejectedEvent\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"o\n" +
+	"\x13TrackEventsResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\x12<\n" +
+	"\brejected\x18\x02 \x03(\v2 .wildberries.buyer.RejectedEventR\brejected2\xb1\b\n" +
 	"\x15BuyerPromotionService\x12\xa7\x02\n" +
 	"\x13GetCurrentPromotion\x12-.wildberries.buyer.GetCurrentPromotionRequest\x1a..wildberries.buyer.GetCurrentPromotionResponse\"\xb0\x01\x92A\x91\x01\n" +
 	"\n" +
-	"Promotions\x12*Получить текущую акцию\x1aBПолучает информацию о текущей акции*\x13GetCurrentPromotion\x82\xd3\xe4\x93\x02\x15\x12\x13/promotions/current\x12\xe8\x02\n" +
+	"Promotions\x12*Получить текущую акцию\x1aBПолучает информацию о текущей акции*\x13GetCurrentPromotion\x82\xd3\xe4\x93\x02\x15\x12\x13/promotions/current\x12\x82\x03\n" +
+	"\x14ListActivePromotions\x12..wildberries.buyer.ListActivePromotionsRequest\x1a/.wildberries.buyer.ListActivePromotionsResponse\"\x88\x02\x92A\xea\x01\n" +
+	"\n" +
+	"Promotions\x12,Получить активные акции\x1a\x97\x01Возвращает все запущенные акции в порядке приоритета с учётом региона и платформы*\x14ListActivePromotions\x82\xd3\xe4\x93\x02\x14\x12\x12/promotions/active\x12\xe8\x02\n" +
 	"\x12GetSegmentProducts\x12,.wildberries.buyer.GetSegmentProductsRequest\x1a-.wildberries.buyer.GetSegmentProductsResponse\"\xf4\x01\x92A\xaf\x01\n" +
 	"\bProducts\x122Получить продукты сегмента\x1a[Получает список продуктов для заданного сегмента*\x12GetSegmentProducts\x82\xd3\xe4\x93\x02;\x129/promotions/{promotion_id}/segments/{segment_id}/products2\xb8\x04\n" +
 	"\x15IdentificationService\x12\xba\x02\n" +
 	"\x13StartIdentification\x12-.wildberries.buyer.StartIdentificationRequest\x1a..wildberries.buyer.StartIdentificationResponse\"\xc3\x01\x92A\x9f\x01\n" +
 	"\x0eIdentification\x12'Начать идентификацию\x1aOНачинает процесс идентификации покупателя*\x13StartIdentification\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/identification/start\x12\xe1\x01\n" +
 	"\x06Answer\x12 .wildberries.buyer.AnswerRequest\x1a!.wildberries.buyer.AnswerResponse\"\x91\x01\x92Am\n" +
-	"\x0eIdentification\x12\"Ответить на вопрос\x1a/Отвечает на вопрос опроса*\x06Answer\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/identification/answerB\xbe\x01\x92A\x9d\x01\x12d\n" +
+	"\x0eIdentification\x12\"Ответить на вопрос\x1a/Отвечает на вопрос опроса*\x06Answer\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/identification/answer2\xe1\x02\n" +
+	"\x11BuyerEventService\x12\xcb\x02\n" +
+	"\vTrackEvents\x12%.wildberries.buyer.TrackEventsRequest\x1a&.wildberries.buyer.TrackEventsResponse\"\xec\x01\x92A\xd6\x01\n" +
+	"\x06Events\x126Отправить события покупателя\x1a\x86\x01Принимает пачку событий (просмотр акции, опрос, показы и клики по товарам)*\vTrackEvents\x82\xd3\xe4\x93\x02\f:\x01*\"\a/eventsB\xbe\x01\x92A\x9d\x01\x12d\n" +
 	"\x12Buyer сервис\x12GСервис покупателя для работы с акциями2\x051.0.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZ\x1bwildberries/pkg/buyer;buyerb\x06proto3"
 
 var (
@@ -826,41 +1389,58 @@ func file_buyer_proto_rawDescGZIP() []byte {
 	return file_buyer_proto_rawDescData
 }
 
-var file_buyer_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_buyer_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_buyer_proto_goTypes = []any{
-	(*GetCurrentPromotionRequest)(nil),  // 0: wildberries.buyer.GetCurrentPromotionRequest
-	(*GetCurrentPromotionResponse)(nil), // 1: wildberries.buyer.GetCurrentPromotionResponse
-	(*GetSegmentProductsRequest)(nil),   // 2: wildberries.buyer.GetSegmentProductsRequest
-	(*GetSegmentProductsResponse)(nil),  // 3: wildberries.buyer.GetSegmentProductsResponse
-	(*StartIdentificationRequest)(nil),  // 4: wildberries.buyer.StartIdentificationRequest
-	(*PollQuestion)(nil),                // 5: wildberries.buyer.PollQuestion
-	(*PollOption)(nil),                  // 6: wildberries.buyer.PollOption
-	(*StartIdentificationResponse)(nil), // 7: wildberries.buyer.StartIdentificationResponse
-	(*Poll)(nil),                        // 8: wildberries.buyer.Poll
-	(*AnswerRequest)(nil),               // 9: wildberries.buyer.AnswerRequest
-	(*AnswerResponse)(nil),              // 10: wildberries.buyer.AnswerResponse
-	(*common.Segment)(nil),              // 11: wildberries.common.Segment
-	(*common.ProductItem)(nil),          // 12: wildberries.common.ProductItem
+	(*GetCurrentPromotionRequest)(nil),   // 0: wildberries.buyer.GetCurrentPromotionRequest
+	(*GetCurrentPromotionResponse)(nil),  // 1: wildberries.buyer.GetCurrentPromotionResponse
+	(*ListActivePromotionsRequest)(nil),  // 2: wildberries.buyer.ListActivePromotionsRequest
+	(*ActivePromotion)(nil),              // 3: wildberries.buyer.ActivePromotion
+	(*ListActivePromotionsResponse)(nil), // 4: wildberries.buyer.ListActivePromotionsResponse
+	(*GetSegmentProductsRequest)(nil),    // 5: wildberries.buyer.GetSegmentProductsRequest
+	(*GetSegmentProductsResponse)(nil),   // 6: wildberries.buyer.GetSegmentProductsResponse
+	(*StartIdentificationRequest)(nil),   // 7: wildberries.buyer.StartIdentificationRequest
+	(*PollQuestion)(nil),                 // 8: wildberries.buyer.PollQuestion
+	(*PollOption)(nil),                   // 9: wildberries.buyer.PollOption
+	(*StartIdentificationResponse)(nil),  // 10: wildberries.buyer.StartIdentificationResponse
+	(*Poll)(nil),                         // 11: wildberries.buyer.Poll
+	(*AnswerRequest)(nil),                // 12: wildberries.buyer.AnswerRequest
+	(*AnswerResponse)(nil),               // 13: wildberries.buyer.AnswerResponse
+	(*BuyerEvent)(nil),                   // 14: wildberries.buyer.BuyerEvent
+	(*TrackEventsRequest)(nil),           // 15: wildberries.buyer.TrackEventsRequest
+	(*RejectedEvent)(nil),                // 16: wildberries.buyer.RejectedEvent
+	(*TrackEventsResponse)(nil),          // 17: wildberries.buyer.TrackEventsResponse
+	nil,                                  // 18: wildberries.buyer.BuyerEvent.PropertiesEntry
+	(*common.Segment)(nil),               // 19: wildberries.common.Segment
+	(*common.ProductItem)(nil),           // 20: wildberries.common.ProductItem
 }
 var file_buyer_proto_depIdxs = []int32{
-	11, // 0: wildberries.buyer.GetCurrentPromotionResponse.segments:type_name -> wildberries.common.Segment
-	12, // 1: wildberries.buyer.GetSegmentProductsResponse.items:type_name -> wildberries.common.ProductItem
-	6,  // 2: wildberries.buyer.PollQuestion.options:type_name -> wildberries.buyer.PollOption
-	8,  // 3: wildberries.buyer.StartIdentificationResponse.poll:type_name -> wildberries.buyer.Poll
-	5,  // 4: wildberries.buyer.Poll.questions:type_name -> wildberries.buyer.PollQuestion
-	0,  // 5: wildberries.buyer.BuyerPromotionService.GetCurrentPromotion:input_type -> wildberries.buyer.GetCurrentPromotionRequest
-	2,  // 6: wildberries.buyer.BuyerPromotionService.GetSegmentProducts:input_type -> wildberries.buyer.GetSegmentProductsRequest
-	4,  // 7: wildberries.buyer.IdentificationService.StartIdentification:input_type -> wildberries.buyer.StartIdentificationRequest
-	9,  // 8: wildberries.buyer.IdentificationService.Answer:input_type -> wildberries.buyer.AnswerRequest
-	1,  // 9: wildberries.buyer.BuyerPromotionService.GetCurrentPromotion:output_type -> wildberries.buyer.GetCurrentPromotionResponse
-	3,  // 10: wildberries.buyer.BuyerPromotionService.GetSegmentProducts:output_type -> wildberries.buyer.GetSegmentProductsResponse
-	7,  // 11: wildberries.buyer.IdentificationService.StartIdentification:output_type -> wildberries.buyer.StartIdentificationResponse
-	10, // 12: wildberries.buyer.IdentificationService.Answer:output_type -> wildberries.buyer.AnswerResponse
-	9,  // [9:13] is the sub-list for method output_type
-	5,  // [5:9] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	19, // 0: wildberries.buyer.GetCurrentPromotionResponse.segments:type_name -> wildberries.common.Segment
+	19, // 1: wildberries.buyer.ActivePromotion.segments:type_name -> wildberries.common.Segment
+	3,  // 2: wildberries.buyer.ListActivePromotionsResponse.promotions:type_name -> wildberries.buyer.ActivePromotion
+	20, // 3: wildberries.buyer.GetSegmentProductsResponse.items:type_name -> wildberries.common.ProductItem
+	9,  // 4: wildberries.buyer.PollQuestion.options:type_name -> wildberries.buyer.PollOption
+	11, // 5: wildberries.buyer.StartIdentificationResponse.poll:type_name -> wildberries.buyer.Poll
+	8,  // 6: wildberries.buyer.Poll.questions:type_name -> wildberries.buyer.PollQuestion
+	18, // 7: wildberries.buyer.BuyerEvent.properties:type_name -> wildberries.buyer.BuyerEvent.PropertiesEntry
+	14, // 8: wildberries.buyer.TrackEventsRequest.events:type_name -> wildberries.buyer.BuyerEvent
+	16, // 9: wildberries.buyer.TrackEventsResponse.rejected:type_name -> wildberries.buyer.RejectedEvent
+	0,  // 10: wildberries.buyer.BuyerPromotionService.GetCurrentPromotion:input_type -> wildberries.buyer.GetCurrentPromotionRequest
+	2,  // 11: wildberries.buyer.BuyerPromotionService.ListActivePromotions:input_type -> wildberries.buyer.ListActivePromotionsRequest
+	5,  // 12: wildberries.buyer.BuyerPromotionService.GetSegmentProducts:input_type -> wildberries.buyer.GetSegmentProductsRequest
+	7,  // 13: wildberries.buyer.IdentificationService.StartIdentification:input_type -> wildberries.buyer.StartIdentificationRequest
+	12, // 14: wildberries.buyer.IdentificationService.Answer:input_type -> wildberries.buyer.AnswerRequest
+	15, // 15: wildberries.buyer.BuyerEventService.TrackEvents:input_type -> wildberries.buyer.TrackEventsRequest
+	1,  // 16: wildberries.buyer.BuyerPromotionService.GetCurrentPromotion:output_type -> wildberries.buyer.GetCurrentPromotionResponse
+	4,  // 17: wildberries.buyer.BuyerPromotionService.ListActivePromotions:output_type -> wildberries.buyer.ListActivePromotionsResponse
+	6,  // 18: wildberries.buyer.BuyerPromotionService.GetSegmentProducts:output_type -> wildberries.buyer.GetSegmentProductsResponse
+	10, // 19: wildberries.buyer.IdentificationService.StartIdentification:output_type -> wildberries.buyer.StartIdentificationResponse
+	13, // 20: wildberries.buyer.IdentificationService.Answer:output_type -> wildberries.buyer.AnswerResponse
+	17, // 21: wildberries.buyer.BuyerEventService.TrackEvents:output_type -> wildberries.buyer.TrackEventsResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_buyer_proto_init() }
@@ -868,7 +1448,8 @@ func file_buyer_proto_init() {
 	if File_buyer_proto != nil {
 		return
 	}
-	file_buyer_proto_msgTypes[7].OneofWrappers = []any{
+	file_buyer_proto_msgTypes[0].OneofWrappers = []any{}
+	file_buyer_proto_msgTypes[10].OneofWrappers = []any{
 		(*StartIdentificationResponse_Poll)(nil),
 		(*StartIdentificationResponse_ResultSegmentId)(nil),
 	}
@@ -878,9 +1459,9 @@ func file_buyer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_buyer_proto_rawDesc), len(file_buyer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   19,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_buyer_proto_goTypes,
 		DependencyIndexes: file_buyer_proto_depIdxs,
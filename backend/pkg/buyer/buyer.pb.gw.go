@@ -35,6 +35,8 @@ var (
 	_ = metadata.Join
 )
 
+var filter_BuyerPromotionService_GetCurrentPromotion_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
 func request_BuyerPromotionService_GetCurrentPromotion_0(ctx context.Context, marshaler runtime.Marshaler, client BuyerPromotionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq GetCurrentPromotionRequest
@@ -43,6 +45,12 @@ func request_BuyerPromotionService_GetCurrentPromotion_0(ctx context.Context, ma
 	if req.Body != nil {
 		_, _ = io.Copy(io.Discard, req.Body)
 	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_BuyerPromotionService_GetCurrentPromotion_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	msg, err := client.GetCurrentPromotion(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
 	return msg, metadata, err
 }
@@ -52,10 +60,51 @@ func local_request_BuyerPromotionService_GetCurrentPromotion_0(ctx context.Conte
 		protoReq GetCurrentPromotionRequest
 		metadata runtime.ServerMetadata
 	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_BuyerPromotionService_GetCurrentPromotion_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	msg, err := server.GetCurrentPromotion(ctx, &protoReq)
 	return msg, metadata, err
 }
 
+var filter_BuyerPromotionService_ListActivePromotions_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_BuyerPromotionService_ListActivePromotions_0(ctx context.Context, marshaler runtime.Marshaler, client BuyerPromotionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ListActivePromotionsRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_BuyerPromotionService_ListActivePromotions_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.ListActivePromotions(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_BuyerPromotionService_ListActivePromotions_0(ctx context.Context, marshaler runtime.Marshaler, server BuyerPromotionServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ListActivePromotionsRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_BuyerPromotionService_ListActivePromotions_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.ListActivePromotions(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 var filter_BuyerPromotionService_GetSegmentProducts_0 = &utilities.DoubleArray{Encoding: map[string]int{"promotion_id": 0, "segment_id": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
 
 func request_BuyerPromotionService_GetSegmentProducts_0(ctx context.Context, marshaler runtime.Marshaler, client BuyerPromotionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
@@ -179,6 +228,33 @@ func local_request_IdentificationService_Answer_0(ctx context.Context, marshaler
 	return msg, metadata, err
 }
 
+func request_BuyerEventService_TrackEvents_0(ctx context.Context, marshaler runtime.Marshaler, client BuyerEventServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq TrackEventsRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	msg, err := client.TrackEvents(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_BuyerEventService_TrackEvents_0(ctx context.Context, marshaler runtime.Marshaler, server BuyerEventServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq TrackEventsRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.TrackEvents(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterBuyerPromotionServiceHandlerServer registers the http handlers for service BuyerPromotionService to "mux".
 // UnaryRPC     :call BuyerPromotionServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -205,6 +281,26 @@ func RegisterBuyerPromotionServiceHandlerServer(ctx context.Context, mux *runtim
 		}
 		forward_BuyerPromotionService_GetCurrentPromotion_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_BuyerPromotionService_ListActivePromotions_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/wildberries.buyer.BuyerPromotionService/ListActivePromotions", runtime.WithHTTPPathPattern("/promotions/active"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_BuyerPromotionService_ListActivePromotions_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_BuyerPromotionService_ListActivePromotions_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_BuyerPromotionService_GetSegmentProducts_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -279,6 +375,36 @@ func RegisterIdentificationServiceHandlerServer(ctx context.Context, mux *runtim
 	return nil
 }
 
+// RegisterBuyerEventServiceHandlerServer registers the http handlers for service BuyerEventService to "mux".
+// UnaryRPC     :call BuyerEventServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterBuyerEventServiceHandlerFromEndpoint instead.
+// GRPC interceptors will not work for this type of registration. To use interceptors, you must use the "runtime.WithMiddlewares" option in the "runtime.NewServeMux" call.
+func RegisterBuyerEventServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server BuyerEventServiceServer) error {
+	mux.Handle(http.MethodPost, pattern_BuyerEventService_TrackEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/wildberries.buyer.BuyerEventService/TrackEvents", runtime.WithHTTPPathPattern("/events"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_BuyerEventService_TrackEvents_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_BuyerEventService_TrackEvents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
 // RegisterBuyerPromotionServiceHandlerFromEndpoint is same as RegisterBuyerPromotionServiceHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
 func RegisterBuyerPromotionServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
@@ -332,6 +458,23 @@ func RegisterBuyerPromotionServiceHandlerClient(ctx context.Context, mux *runtim
 		}
 		forward_BuyerPromotionService_GetCurrentPromotion_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_BuyerPromotionService_ListActivePromotions_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/wildberries.buyer.BuyerPromotionService/ListActivePromotions", runtime.WithHTTPPathPattern("/promotions/active"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_BuyerPromotionService_ListActivePromotions_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_BuyerPromotionService_ListActivePromotions_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_BuyerPromotionService_GetSegmentProducts_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -353,13 +496,15 @@ func RegisterBuyerPromotionServiceHandlerClient(ctx context.Context, mux *runtim
 }
 
 var (
-	pattern_BuyerPromotionService_GetCurrentPromotion_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"promotions", "current"}, ""))
-	pattern_BuyerPromotionService_GetSegmentProducts_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 1, 0, 4, 1, 5, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"promotions", "promotion_id", "segments", "segment_id", "products"}, ""))
+	pattern_BuyerPromotionService_GetCurrentPromotion_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"promotions", "current"}, ""))
+	pattern_BuyerPromotionService_ListActivePromotions_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"promotions", "active"}, ""))
+	pattern_BuyerPromotionService_GetSegmentProducts_0   = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 1, 0, 4, 1, 5, 1, 2, 2, 1, 0, 4, 1, 5, 3, 2, 4}, []string{"promotions", "promotion_id", "segments", "segment_id", "products"}, ""))
 )
 
 var (
-	forward_BuyerPromotionService_GetCurrentPromotion_0 = runtime.ForwardResponseMessage
-	forward_BuyerPromotionService_GetSegmentProducts_0  = runtime.ForwardResponseMessage
+	forward_BuyerPromotionService_GetCurrentPromotion_0  = runtime.ForwardResponseMessage
+	forward_BuyerPromotionService_ListActivePromotions_0 = runtime.ForwardResponseMessage
+	forward_BuyerPromotionService_GetSegmentProducts_0   = runtime.ForwardResponseMessage
 )
 
 // RegisterIdentificationServiceHandlerFromEndpoint is same as RegisterIdentificationServiceHandler but
@@ -444,3 +589,67 @@ var (
 	forward_IdentificationService_StartIdentification_0 = runtime.ForwardResponseMessage
 	forward_IdentificationService_Answer_0              = runtime.ForwardResponseMessage
 )
+
+// RegisterBuyerEventServiceHandlerFromEndpoint is same as RegisterBuyerEventServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterBuyerEventServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Errorf("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+	return RegisterBuyerEventServiceHandler(ctx, mux, conn)
+}
+
+// RegisterBuyerEventServiceHandler registers the http handlers for service BuyerEventService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterBuyerEventServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterBuyerEventServiceHandlerClient(ctx, mux, NewBuyerEventServiceClient(conn))
+}
+
+// RegisterBuyerEventServiceHandlerClient registers the http handlers for service BuyerEventService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "BuyerEventServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "BuyerEventServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "BuyerEventServiceClient" to call the correct interceptors. This client ignores the HTTP middlewares.
+func RegisterBuyerEventServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client BuyerEventServiceClient) error {
+	mux.Handle(http.MethodPost, pattern_BuyerEventService_TrackEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/wildberries.buyer.BuyerEventService/TrackEvents", runtime.WithHTTPPathPattern("/events"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_BuyerEventService_TrackEvents_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_BuyerEventService_TrackEvents_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	return nil
+}
+
+var (
+	pattern_BuyerEventService_TrackEvents_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"events"}, ""))
+)
+
+var (
+	forward_BuyerEventService_TrackEvents_0 = runtime.ForwardResponseMessage
+)
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BuyerPromotionService_GetCurrentPromotion_FullMethodName = "/wildberries.buyer.BuyerPromotionService/GetCurrentPromotion"
-	BuyerPromotionService_GetSegmentProducts_FullMethodName  = "/wildberries.buyer.BuyerPromotionService/GetSegmentProducts"
+	BuyerPromotionService_GetCurrentPromotion_FullMethodName  = "/wildberries.buyer.BuyerPromotionService/GetCurrentPromotion"
+	BuyerPromotionService_ListActivePromotions_FullMethodName = "/wildberries.buyer.BuyerPromotionService/ListActivePromotions"
+	BuyerPromotionService_GetSegmentProducts_FullMethodName   = "/wildberries.buyer.BuyerPromotionService/GetSegmentProducts"
 )
 
 // BuyerPromotionServiceClient is the client API for BuyerPromotionService service.
@@ -30,6 +31,7 @@ const (
 // --- Buyer API Service ---
 type BuyerPromotionServiceClient interface {
 	GetCurrentPromotion(ctx context.Context, in *GetCurrentPromotionRequest, opts ...grpc.CallOption) (*GetCurrentPromotionResponse, error)
+	ListActivePromotions(ctx context.Context, in *ListActivePromotionsRequest, opts ...grpc.CallOption) (*ListActivePromotionsResponse, error)
 	GetSegmentProducts(ctx context.Context, in *GetSegmentProductsRequest, opts ...grpc.CallOption) (*GetSegmentProductsResponse, error)
 }
 
@@ -51,6 +53,16 @@ func (c *buyerPromotionServiceClient) GetCurrentPromotion(ctx context.Context, i
 	return out, nil
 }
 
+func (c *buyerPromotionServiceClient) ListActivePromotions(ctx context.Context, in *ListActivePromotionsRequest, opts ...grpc.CallOption) (*ListActivePromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListActivePromotionsResponse)
+	err := c.cc.Invoke(ctx, BuyerPromotionService_ListActivePromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *buyerPromotionServiceClient) GetSegmentProducts(ctx context.Context, in *GetSegmentProductsRequest, opts ...grpc.CallOption) (*GetSegmentProductsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSegmentProductsResponse)
@@ -68,6 +80,7 @@ func (c *buyerPromotionServiceClient) GetSegmentProducts(ctx context.Context, in
 // --- Buyer API Service ---
 type BuyerPromotionServiceServer interface {
 	GetCurrentPromotion(context.Context, *GetCurrentPromotionRequest) (*GetCurrentPromotionResponse, error)
+	ListActivePromotions(context.Context, *ListActivePromotionsRequest) (*ListActivePromotionsResponse, error)
 	GetSegmentProducts(context.Context, *GetSegmentProductsRequest) (*GetSegmentProductsResponse, error)
 	mustEmbedUnimplementedBuyerPromotionServiceServer()
 }
@@ -82,6 +95,9 @@ type UnimplementedBuyerPromotionServiceServer struct{}
 func (UnimplementedBuyerPromotionServiceServer) GetCurrentPromotion(context.Context, *GetCurrentPromotionRequest) (*GetCurrentPromotionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCurrentPromotion not implemented")
 }
+func (UnimplementedBuyerPromotionServiceServer) ListActivePromotions(context.Context, *ListActivePromotionsRequest) (*ListActivePromotionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListActivePromotions not implemented")
+}
 func (UnimplementedBuyerPromotionServiceServer) GetSegmentProducts(context.Context, *GetSegmentProductsRequest) (*GetSegmentProductsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSegmentProducts not implemented")
 }
@@ -124,6 +140,24 @@ func _BuyerPromotionService_GetCurrentPromotion_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BuyerPromotionService_ListActivePromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActivePromotionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuyerPromotionServiceServer).ListActivePromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BuyerPromotionService_ListActivePromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuyerPromotionServiceServer).ListActivePromotions(ctx, req.(*ListActivePromotionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BuyerPromotionService_GetSegmentProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSegmentProductsRequest)
 	if err := dec(in); err != nil {
@@ -153,6 +187,10 @@ var BuyerPromotionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrentPromotion",
 			Handler:    _BuyerPromotionService_GetCurrentPromotion_Handler,
 		},
+		{
+			MethodName: "ListActivePromotions",
+			Handler:    _BuyerPromotionService_ListActivePromotions_Handler,
+		},
 		{
 			MethodName: "GetSegmentProducts",
 			Handler:    _BuyerPromotionService_GetSegmentProducts_Handler,
@@ -301,3 +339,105 @@ var IdentificationService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "buyer.proto",
 }
+
+const (
+	BuyerEventService_TrackEvents_FullMethodName = "/wildberries.buyer.BuyerEventService/TrackEvents"
+)
+
+// BuyerEventServiceClient is the client API for BuyerEventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BuyerEventServiceClient interface {
+	TrackEvents(ctx context.Context, in *TrackEventsRequest, opts ...grpc.CallOption) (*TrackEventsResponse, error)
+}
+
+type buyerEventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBuyerEventServiceClient(cc grpc.ClientConnInterface) BuyerEventServiceClient {
+	return &buyerEventServiceClient{cc}
+}
+
+func (c *buyerEventServiceClient) TrackEvents(ctx context.Context, in *TrackEventsRequest, opts ...grpc.CallOption) (*TrackEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrackEventsResponse)
+	err := c.cc.Invoke(ctx, BuyerEventService_TrackEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BuyerEventServiceServer is the server API for BuyerEventService service.
+// All implementations must embed UnimplementedBuyerEventServiceServer
+// for forward compatibility.
+type BuyerEventServiceServer interface {
+	TrackEvents(context.Context, *TrackEventsRequest) (*TrackEventsResponse, error)
+	mustEmbedUnimplementedBuyerEventServiceServer()
+}
+
+// UnimplementedBuyerEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBuyerEventServiceServer struct{}
+
+func (UnimplementedBuyerEventServiceServer) TrackEvents(context.Context, *TrackEventsRequest) (*TrackEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TrackEvents not implemented")
+}
+func (UnimplementedBuyerEventServiceServer) mustEmbedUnimplementedBuyerEventServiceServer() {}
+func (UnimplementedBuyerEventServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeBuyerEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BuyerEventServiceServer will
+// result in compilation errors.
+type UnsafeBuyerEventServiceServer interface {
+	mustEmbedUnimplementedBuyerEventServiceServer()
+}
+
+func RegisterBuyerEventServiceServer(s grpc.ServiceRegistrar, srv BuyerEventServiceServer) {
+	// If the following call panics, it indicates UnimplementedBuyerEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BuyerEventService_ServiceDesc, srv)
+}
+
+func _BuyerEventService_TrackEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BuyerEventServiceServer).TrackEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BuyerEventService_TrackEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BuyerEventServiceServer).TrackEvents(ctx, req.(*TrackEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BuyerEventService_ServiceDesc is the grpc.ServiceDesc for BuyerEventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BuyerEventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wildberries.buyer.BuyerEventService",
+	HandlerType: (*BuyerEventServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TrackEvents",
+			Handler:    _BuyerEventService_TrackEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "buyer.proto",
+}
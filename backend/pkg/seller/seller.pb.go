@@ -305,15 +305,17 @@ func (x *GetActionSegmentsRequest) GetActionId() int64 {
 }
 
 type ActionSegment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Population    int64                  `protobuf:"varint,4,opt,name=population,proto3" json:"population,omitempty"`
-	BookedSlots   int64                  `protobuf:"varint,5,opt,name=booked_slots,json=bookedSlots,proto3" json:"booked_slots,omitempty"`
-	TotalSlots    int64                  `protobuf:"varint,6,opt,name=total_slots,json=totalSlots,proto3" json:"total_slots,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category         string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Population       int64                  `protobuf:"varint,4,opt,name=population,proto3" json:"population,omitempty"` // оценка числа покупателей сегмента
+	BookedSlots      int64                  `protobuf:"varint,5,opt,name=booked_slots,json=bookedSlots,proto3" json:"booked_slots,omitempty"`
+	TotalSlots       int64                  `protobuf:"varint,6,opt,name=total_slots,json=totalSlots,proto3" json:"total_slots,omitempty"`
+	PopulationShare  float64                `protobuf:"fixed64,7,opt,name=population_share,json=populationShare,proto3" json:"population_share,omitempty"`  // доля покупателей акции, 0..1
+	PopulationSource string                 `protobuf:"bytes,8,opt,name=population_source,json=populationSource,proto3" json:"population_source,omitempty"` // observed | historical | simulation
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ActionSegment) Reset() {
@@ -388,6 +390,20 @@ func (x *ActionSegment) GetTotalSlots() int64 {
 	return 0
 }
 
+func (x *ActionSegment) GetPopulationShare() float64 {
+	if x != nil {
+		return x.PopulationShare
+	}
+	return 0
+}
+
+func (x *ActionSegment) GetPopulationSource() string {
+	if x != nil {
+		return x.PopulationSource
+	}
+	return ""
+}
+
 type GetActionSegmentsResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ActionSegments []*ActionSegment       `protobuf:"bytes,1,rep,name=action_segments,json=actionSegments,proto3" json:"action_segments,omitempty"`
@@ -616,7 +632,7 @@ func (x *GetSellerActionsResponse) GetActions() []*SellerActionSummary {
 // --- GET /seller/statistics — статистика для селлера ---
 type GetSellerStatisticsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SellerId      int64                  `protobuf:"varint,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	SellerId      int64                  `protobuf:"varint,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"` // 0 — только общие счётчики
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -659,13 +675,22 @@ func (x *GetSellerStatisticsRequest) GetSellerId() int64 {
 }
 
 type GetSellerStatisticsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ActivePromotions int64                  `protobuf:"varint,1,opt,name=active_promotions,json=activePromotions,proto3" json:"active_promotions,omitempty"`
-	FreeSlots        int64                  `protobuf:"varint,2,opt,name=free_slots,json=freeSlots,proto3" json:"free_slots,omitempty"`
-	OccupiedSlots    int64                  `protobuf:"varint,3,opt,name=occupied_slots,json=occupiedSlots,proto3" json:"occupied_slots,omitempty"`
-	TotalViews       int64                  `protobuf:"varint,4,opt,name=total_views,json=totalViews,proto3" json:"total_views,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state              protoimpl.MessageState       `protogen:"open.v1"`
+	ActivePromotions   int64                        `protobuf:"varint,1,opt,name=active_promotions,json=activePromotions,proto3" json:"active_promotions,omitempty"`
+	FreeSlots          int64                        `protobuf:"varint,2,opt,name=free_slots,json=freeSlots,proto3" json:"free_slots,omitempty"`
+	OccupiedSlots      int64                        `protobuf:"varint,3,opt,name=occupied_slots,json=occupiedSlots,proto3" json:"occupied_slots,omitempty"` // все занятые слоты, как и free_slots — по всем селлерам
+	TotalViews         int64                        `protobuf:"varint,4,opt,name=total_views,json=totalViews,proto3" json:"total_views,omitempty"`
+	ModerationSlots    int64                        `protobuf:"varint,5,opt,name=moderation_slots,json=moderationSlots,proto3" json:"moderation_slots,omitempty"`
+	CommittedAmount    int64                        `protobuf:"varint,6,opt,name=committed_amount,json=committedAmount,proto3" json:"committed_amount,omitempty"` // стоимость выигранных слотов и слотов на модерации
+	ActiveBets         int64                        `protobuf:"varint,7,opt,name=active_bets,json=activeBets,proto3" json:"active_bets,omitempty"`
+	ActiveBetsAmount   int64                        `protobuf:"varint,8,opt,name=active_bets_amount,json=activeBetsAmount,proto3" json:"active_bets_amount,omitempty"` // лучшие ставки селлера в идущих аукционах
+	WinRate            float64                      `protobuf:"fixed64,9,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`                             // 0..1
+	ProductImpressions int64                        `protobuf:"varint,10,opt,name=product_impressions,json=productImpressions,proto3" json:"product_impressions,omitempty"`
+	ProductClicks      int64                        `protobuf:"varint,11,opt,name=product_clicks,json=productClicks,proto3" json:"product_clicks,omitempty"`
+	Promotions         []*SellerPromotionStatistics `protobuf:"bytes,12,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	WonSlots           int64                        `protobuf:"varint,13,opt,name=won_slots,json=wonSlots,proto3" json:"won_slots,omitempty"` // слоты, занятые селлером
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetSellerStatisticsResponse) Reset() {
@@ -726,6 +751,439 @@ func (x *GetSellerStatisticsResponse) GetTotalViews() int64 {
 	return 0
 }
 
+func (x *GetSellerStatisticsResponse) GetModerationSlots() int64 {
+	if x != nil {
+		return x.ModerationSlots
+	}
+	return 0
+}
+
+func (x *GetSellerStatisticsResponse) GetCommittedAmount() int64 {
+	if x != nil {
+		return x.CommittedAmount
+	}
+	return 0
+}
+
+func (x *GetSellerStatisticsResponse) GetActiveBets() int64 {
+	if x != nil {
+		return x.ActiveBets
+	}
+	return 0
+}
+
+func (x *GetSellerStatisticsResponse) GetActiveBetsAmount() int64 {
+	if x != nil {
+		return x.ActiveBetsAmount
+	}
+	return 0
+}
+
+func (x *GetSellerStatisticsResponse) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *GetSellerStatisticsResponse) GetProductImpressions() int64 {
+	if x != nil {
+		return x.ProductImpressions
+	}
+	return 0
+}
+
+func (x *GetSellerStatisticsResponse) GetProductClicks() int64 {
+	if x != nil {
+		return x.ProductClicks
+	}
+	return 0
+}
+
+func (x *GetSellerStatisticsResponse) GetPromotions() []*SellerPromotionStatistics {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+func (x *GetSellerStatisticsResponse) GetWonSlots() int64 {
+	if x != nil {
+		return x.WonSlots
+	}
+	return 0
+}
+
+type SellerPromotionStatistics struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId        int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	PromotionName      string                 `protobuf:"bytes,2,opt,name=promotion_name,json=promotionName,proto3" json:"promotion_name,omitempty"`
+	PromotionStatus    string                 `protobuf:"bytes,3,opt,name=promotion_status,json=promotionStatus,proto3" json:"promotion_status,omitempty"`
+	WonSlots           int64                  `protobuf:"varint,4,opt,name=won_slots,json=wonSlots,proto3" json:"won_slots,omitempty"`
+	ModerationSlots    int64                  `protobuf:"varint,5,opt,name=moderation_slots,json=moderationSlots,proto3" json:"moderation_slots,omitempty"`
+	CommittedAmount    int64                  `protobuf:"varint,6,opt,name=committed_amount,json=committedAmount,proto3" json:"committed_amount,omitempty"`
+	ActiveBets         int64                  `protobuf:"varint,7,opt,name=active_bets,json=activeBets,proto3" json:"active_bets,omitempty"`
+	ActiveBetsAmount   int64                  `protobuf:"varint,8,opt,name=active_bets_amount,json=activeBetsAmount,proto3" json:"active_bets_amount,omitempty"`
+	WinRate            float64                `protobuf:"fixed64,9,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	ProductImpressions int64                  `protobuf:"varint,10,opt,name=product_impressions,json=productImpressions,proto3" json:"product_impressions,omitempty"`
+	ProductClicks      int64                  `protobuf:"varint,11,opt,name=product_clicks,json=productClicks,proto3" json:"product_clicks,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SellerPromotionStatistics) Reset() {
+	*x = SellerPromotionStatistics{}
+	mi := &file_seller_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellerPromotionStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellerPromotionStatistics) ProtoMessage() {}
+
+func (x *SellerPromotionStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_seller_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellerPromotionStatistics.ProtoReflect.Descriptor instead.
+func (*SellerPromotionStatistics) Descriptor() ([]byte, []int) {
+	return file_seller_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SellerPromotionStatistics) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *SellerPromotionStatistics) GetPromotionName() string {
+	if x != nil {
+		return x.PromotionName
+	}
+	return ""
+}
+
+func (x *SellerPromotionStatistics) GetPromotionStatus() string {
+	if x != nil {
+		return x.PromotionStatus
+	}
+	return ""
+}
+
+func (x *SellerPromotionStatistics) GetWonSlots() int64 {
+	if x != nil {
+		return x.WonSlots
+	}
+	return 0
+}
+
+func (x *SellerPromotionStatistics) GetModerationSlots() int64 {
+	if x != nil {
+		return x.ModerationSlots
+	}
+	return 0
+}
+
+func (x *SellerPromotionStatistics) GetCommittedAmount() int64 {
+	if x != nil {
+		return x.CommittedAmount
+	}
+	return 0
+}
+
+func (x *SellerPromotionStatistics) GetActiveBets() int64 {
+	if x != nil {
+		return x.ActiveBets
+	}
+	return 0
+}
+
+func (x *SellerPromotionStatistics) GetActiveBetsAmount() int64 {
+	if x != nil {
+		return x.ActiveBetsAmount
+	}
+	return 0
+}
+
+func (x *SellerPromotionStatistics) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *SellerPromotionStatistics) GetProductImpressions() int64 {
+	if x != nil {
+		return x.ProductImpressions
+	}
+	return 0
+}
+
+func (x *SellerPromotionStatistics) GetProductClicks() int64 {
+	if x != nil {
+		return x.ProductClicks
+	}
+	return 0
+}
+
+// --- GET /seller/slots/performance — эффективность товаров селлера в слотах ---
+// CSV: GET /seller/slots/performance.csv с теми же параметрами
+type GetSlotPerformanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SellerId      int64                  `protobuf:"varint,1,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	PromotionId   int64                  `protobuf:"varint,2,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"` // 0 — все акции
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlotPerformanceRequest) Reset() {
+	*x = GetSlotPerformanceRequest{}
+	mi := &file_seller_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlotPerformanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlotPerformanceRequest) ProtoMessage() {}
+
+func (x *GetSlotPerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seller_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlotPerformanceRequest.ProtoReflect.Descriptor instead.
+func (*GetSlotPerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_seller_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSlotPerformanceRequest) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *GetSlotPerformanceRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+type SlotPerformance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	PromotionName string                 `protobuf:"bytes,2,opt,name=promotion_name,json=promotionName,proto3" json:"promotion_name,omitempty"`
+	SegmentId     int64                  `protobuf:"varint,3,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	SegmentName   string                 `protobuf:"bytes,4,opt,name=segment_name,json=segmentName,proto3" json:"segment_name,omitempty"`
+	SlotId        int64                  `protobuf:"varint,5,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	Position      int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	PricingType   string                 `protobuf:"bytes,7,opt,name=pricing_type,json=pricingType,proto3" json:"pricing_type,omitempty"`
+	ProductId     int64                  `protobuf:"varint,8,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,9,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Price         int64                  `protobuf:"varint,10,opt,name=price,proto3" json:"price,omitempty"` // оплаченная цена слота
+	Impressions   int64                  `protobuf:"varint,11,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	Clicks        int64                  `protobuf:"varint,12,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	Ctr           float64                `protobuf:"fixed64,13,opt,name=ctr,proto3" json:"ctr,omitempty"`                                         // clicks / impressions
+	CostPerClick  float64                `protobuf:"fixed64,14,opt,name=cost_per_click,json=costPerClick,proto3" json:"cost_per_click,omitempty"` // price / clicks; 0 — кликов не было
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotPerformance) Reset() {
+	*x = SlotPerformance{}
+	mi := &file_seller_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotPerformance) ProtoMessage() {}
+
+func (x *SlotPerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_seller_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotPerformance.ProtoReflect.Descriptor instead.
+func (*SlotPerformance) Descriptor() ([]byte, []int) {
+	return file_seller_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SlotPerformance) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *SlotPerformance) GetPromotionName() string {
+	if x != nil {
+		return x.PromotionName
+	}
+	return ""
+}
+
+func (x *SlotPerformance) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *SlotPerformance) GetSegmentName() string {
+	if x != nil {
+		return x.SegmentName
+	}
+	return ""
+}
+
+func (x *SlotPerformance) GetSlotId() int64 {
+	if x != nil {
+		return x.SlotId
+	}
+	return 0
+}
+
+func (x *SlotPerformance) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SlotPerformance) GetPricingType() string {
+	if x != nil {
+		return x.PricingType
+	}
+	return ""
+}
+
+func (x *SlotPerformance) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SlotPerformance) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *SlotPerformance) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SlotPerformance) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *SlotPerformance) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *SlotPerformance) GetCtr() float64 {
+	if x != nil {
+		return x.Ctr
+	}
+	return 0
+}
+
+func (x *SlotPerformance) GetCostPerClick() float64 {
+	if x != nil {
+		return x.CostPerClick
+	}
+	return 0
+}
+
+type GetSlotPerformanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SlotPerformance     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSlotPerformanceResponse) Reset() {
+	*x = GetSlotPerformanceResponse{}
+	mi := &file_seller_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSlotPerformanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlotPerformanceResponse) ProtoMessage() {}
+
+func (x *GetSlotPerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seller_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlotPerformanceResponse.ProtoReflect.Descriptor instead.
+func (*GetSlotPerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_seller_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSlotPerformanceResponse) GetItems() []*SlotPerformance {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 // --- POST /seller/promotions/{id}/increment-view ---
 type IncrementPromotionViewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -736,7 +1194,7 @@ type IncrementPromotionViewRequest struct {
 
 func (x *IncrementPromotionViewRequest) Reset() {
 	*x = IncrementPromotionViewRequest{}
-	mi := &file_seller_proto_msgTypes[11]
+	mi := &file_seller_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +1206,7 @@ func (x *IncrementPromotionViewRequest) String() string {
 func (*IncrementPromotionViewRequest) ProtoMessage() {}
 
 func (x *IncrementPromotionViewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_seller_proto_msgTypes[11]
+	mi := &file_seller_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,7 +1219,7 @@ func (x *IncrementPromotionViewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncrementPromotionViewRequest.ProtoReflect.Descriptor instead.
 func (*IncrementPromotionViewRequest) Descriptor() ([]byte, []int) {
-	return file_seller_proto_rawDescGZIP(), []int{11}
+	return file_seller_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *IncrementPromotionViewRequest) GetPromotionId() int64 {
@@ -780,7 +1238,7 @@ type IncrementPromotionViewResponse struct {
 
 func (x *IncrementPromotionViewResponse) Reset() {
 	*x = IncrementPromotionViewResponse{}
-	mi := &file_seller_proto_msgTypes[12]
+	mi := &file_seller_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -792,7 +1250,7 @@ func (x *IncrementPromotionViewResponse) String() string {
 func (*IncrementPromotionViewResponse) ProtoMessage() {}
 
 func (x *IncrementPromotionViewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_seller_proto_msgTypes[12]
+	mi := &file_seller_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +1263,7 @@ func (x *IncrementPromotionViewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncrementPromotionViewResponse.ProtoReflect.Descriptor instead.
 func (*IncrementPromotionViewResponse) Descriptor() ([]byte, []int) {
-	return file_seller_proto_rawDescGZIP(), []int{12}
+	return file_seller_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *IncrementPromotionViewResponse) GetSuccess() bool {
@@ -827,7 +1285,7 @@ type GetSellerBetsListRequest struct {
 
 func (x *GetSellerBetsListRequest) Reset() {
 	*x = GetSellerBetsListRequest{}
-	mi := &file_seller_proto_msgTypes[13]
+	mi := &file_seller_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -839,7 +1297,7 @@ func (x *GetSellerBetsListRequest) String() string {
 func (*GetSellerBetsListRequest) ProtoMessage() {}
 
 func (x *GetSellerBetsListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_seller_proto_msgTypes[13]
+	mi := &file_seller_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +1310,7 @@ func (x *GetSellerBetsListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSellerBetsListRequest.ProtoReflect.Descriptor instead.
 func (*GetSellerBetsListRequest) Descriptor() ([]byte, []int) {
-	return file_seller_proto_rawDescGZIP(), []int{13}
+	return file_seller_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetSellerBetsListRequest) GetPromotionId() int64 {
@@ -892,7 +1350,7 @@ type SellerBetItem struct {
 
 func (x *SellerBetItem) Reset() {
 	*x = SellerBetItem{}
-	mi := &file_seller_proto_msgTypes[14]
+	mi := &file_seller_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -904,7 +1362,7 @@ func (x *SellerBetItem) String() string {
 func (*SellerBetItem) ProtoMessage() {}
 
 func (x *SellerBetItem) ProtoReflect() protoreflect.Message {
-	mi := &file_seller_proto_msgTypes[14]
+	mi := &file_seller_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,7 +1375,7 @@ func (x *SellerBetItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SellerBetItem.ProtoReflect.Descriptor instead.
 func (*SellerBetItem) Descriptor() ([]byte, []int) {
-	return file_seller_proto_rawDescGZIP(), []int{14}
+	return file_seller_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SellerBetItem) GetId() int64 {
@@ -985,7 +1443,7 @@ type GetSellerBetsListResponse struct {
 
 func (x *GetSellerBetsListResponse) Reset() {
 	*x = GetSellerBetsListResponse{}
-	mi := &file_seller_proto_msgTypes[15]
+	mi := &file_seller_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -997,7 +1455,7 @@ func (x *GetSellerBetsListResponse) String() string {
 func (*GetSellerBetsListResponse) ProtoMessage() {}
 
 func (x *GetSellerBetsListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_seller_proto_msgTypes[15]
+	mi := &file_seller_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1468,7 @@ func (x *GetSellerBetsListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSellerBetsListResponse.ProtoReflect.Descriptor instead.
 func (*GetSellerBetsListResponse) Descriptor() ([]byte, []int) {
-	return file_seller_proto_rawDescGZIP(), []int{15}
+	return file_seller_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetSellerBetsListResponse) GetItems() []*SellerBetItem {
@@ -1034,7 +1492,7 @@ type MakeBetRequest struct {
 
 func (x *MakeBetRequest) Reset() {
 	*x = MakeBetRequest{}
-	mi := &file_seller_proto_msgTypes[16]
+	mi := &file_seller_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1046,7 +1504,7 @@ func (x *MakeBetRequest) String() string {
 func (*MakeBetRequest) ProtoMessage() {}
 
 func (x *MakeBetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_seller_proto_msgTypes[16]
+	mi := &file_seller_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,7 +1517,7 @@ func (x *MakeBetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MakeBetRequest.ProtoReflect.Descriptor instead.
 func (*MakeBetRequest) Descriptor() ([]byte, []int) {
-	return file_seller_proto_rawDescGZIP(), []int{16}
+	return file_seller_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MakeBetRequest) GetSellerId() int64 {
@@ -1107,7 +1565,7 @@ type MakeBetResponse struct {
 
 func (x *MakeBetResponse) Reset() {
 	*x = MakeBetResponse{}
-	mi := &file_seller_proto_msgTypes[17]
+	mi := &file_seller_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1119,7 +1577,7 @@ func (x *MakeBetResponse) String() string {
 func (*MakeBetResponse) ProtoMessage() {}
 
 func (x *MakeBetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_seller_proto_msgTypes[17]
+	mi := &file_seller_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,7 +1590,7 @@ func (x *MakeBetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MakeBetResponse.ProtoReflect.Descriptor instead.
 func (*MakeBetResponse) Descriptor() ([]byte, []int) {
-	return file_seller_proto_rawDescGZIP(), []int{17}
+	return file_seller_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MakeBetResponse) GetSuccess() bool {
@@ -1160,7 +1618,7 @@ type RemoveBetRequest struct {
 
 func (x *RemoveBetRequest) Reset() {
 	*x = RemoveBetRequest{}
-	mi := &file_seller_proto_msgTypes[18]
+	mi := &file_seller_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1172,7 +1630,7 @@ func (x *RemoveBetRequest) String() string {
 func (*RemoveBetRequest) ProtoMessage() {}
 
 func (x *RemoveBetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_seller_proto_msgTypes[18]
+	mi := &file_seller_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1185,7 +1643,7 @@ func (x *RemoveBetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveBetRequest.ProtoReflect.Descriptor instead.
 func (*RemoveBetRequest) Descriptor() ([]byte, []int) {
-	return file_seller_proto_rawDescGZIP(), []int{18}
+	return file_seller_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RemoveBetRequest) GetSlotId() int64 {
@@ -1211,7 +1669,7 @@ type RemoveBetResponse struct {
 
 func (x *RemoveBetResponse) Reset() {
 	*x = RemoveBetResponse{}
-	mi := &file_seller_proto_msgTypes[19]
+	mi := &file_seller_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1223,7 +1681,7 @@ func (x *RemoveBetResponse) String() string {
 func (*RemoveBetResponse) ProtoMessage() {}
 
 func (x *RemoveBetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_seller_proto_msgTypes[19]
+	mi := &file_seller_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1694,7 @@ func (x *RemoveBetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveBetResponse.ProtoReflect.Descriptor instead.
 func (*RemoveBetResponse) Descriptor() ([]byte, []int) {
-	return file_seller_proto_rawDescGZIP(), []int{19}
+	return file_seller_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RemoveBetResponse) GetSuccess() bool {
@@ -1246,6 +1704,207 @@ func (x *RemoveBetResponse) GetSuccess() bool {
 	return false
 }
 
+// --- GET /seller/bets/recommend — рекомендация ставки для позиции ---
+type RecommendBidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   int64                  `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	SegmentId     int64                  `protobuf:"varint,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // 1 — первая позиция; по умолчанию 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendBidRequest) Reset() {
+	*x = RecommendBidRequest{}
+	mi := &file_seller_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendBidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendBidRequest) ProtoMessage() {}
+
+func (x *RecommendBidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seller_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendBidRequest.ProtoReflect.Descriptor instead.
+func (*RecommendBidRequest) Descriptor() ([]byte, []int) {
+	return file_seller_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RecommendBidRequest) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *RecommendBidRequest) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *RecommendBidRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type RecommendBidResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RecommendedBid    int64                  `protobuf:"varint,1,opt,name=recommended_bid,json=recommendedBid,proto3" json:"recommended_bid,omitempty"`
+	MinAllowedBid     int64                  `protobuf:"varint,2,opt,name=min_allowed_bid,json=minAllowedBid,proto3" json:"min_allowed_bid,omitempty"` // минимальная ставка, которую примет MakeBet сейчас
+	PositionBid       int64                  `protobuf:"varint,3,opt,name=position_bid,json=positionBid,proto3" json:"position_bid,omitempty"`         // ставка, которая сейчас держит позицию; 0 — позиция свободна
+	BidStep           int64                  `protobuf:"varint,4,opt,name=bid_step,json=bidStep,proto3" json:"bid_step,omitempty"`
+	CompetingOffers   int32                  `protobuf:"varint,5,opt,name=competing_offers,json=competingOffers,proto3" json:"competing_offers,omitempty"`
+	HistoricalMedian  int64                  `protobuf:"varint,6,opt,name=historical_median,json=historicalMedian,proto3" json:"historical_median,omitempty"` // медиана цены закрытия позиции в завершённых аукционах
+	HistoricalMax     int64                  `protobuf:"varint,7,opt,name=historical_max,json=historicalMax,proto3" json:"historical_max,omitempty"`
+	HistoricalSamples int64                  `protobuf:"varint,8,opt,name=historical_samples,json=historicalSamples,proto3" json:"historical_samples,omitempty"`
+	HistorySource     string                 `protobuf:"bytes,9,opt,name=history_source,json=historySource,proto3" json:"history_source,omitempty"` // category | market | none
+	TimeLeft          string                 `protobuf:"bytes,10,opt,name=time_left,json=timeLeft,proto3" json:"time_left,omitempty"`
+	TimeLeftShare     float64                `protobuf:"fixed64,11,opt,name=time_left_share,json=timeLeftShare,proto3" json:"time_left_share,omitempty"` // 0..1
+	AuctionFinished   bool                   `protobuf:"varint,12,opt,name=auction_finished,json=auctionFinished,proto3" json:"auction_finished,omitempty"`
+	Position          int32                  `protobuf:"varint,13,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecommendBidResponse) Reset() {
+	*x = RecommendBidResponse{}
+	mi := &file_seller_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendBidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendBidResponse) ProtoMessage() {}
+
+func (x *RecommendBidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seller_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendBidResponse.ProtoReflect.Descriptor instead.
+func (*RecommendBidResponse) Descriptor() ([]byte, []int) {
+	return file_seller_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecommendBidResponse) GetRecommendedBid() int64 {
+	if x != nil {
+		return x.RecommendedBid
+	}
+	return 0
+}
+
+func (x *RecommendBidResponse) GetMinAllowedBid() int64 {
+	if x != nil {
+		return x.MinAllowedBid
+	}
+	return 0
+}
+
+func (x *RecommendBidResponse) GetPositionBid() int64 {
+	if x != nil {
+		return x.PositionBid
+	}
+	return 0
+}
+
+func (x *RecommendBidResponse) GetBidStep() int64 {
+	if x != nil {
+		return x.BidStep
+	}
+	return 0
+}
+
+func (x *RecommendBidResponse) GetCompetingOffers() int32 {
+	if x != nil {
+		return x.CompetingOffers
+	}
+	return 0
+}
+
+func (x *RecommendBidResponse) GetHistoricalMedian() int64 {
+	if x != nil {
+		return x.HistoricalMedian
+	}
+	return 0
+}
+
+func (x *RecommendBidResponse) GetHistoricalMax() int64 {
+	if x != nil {
+		return x.HistoricalMax
+	}
+	return 0
+}
+
+func (x *RecommendBidResponse) GetHistoricalSamples() int64 {
+	if x != nil {
+		return x.HistoricalSamples
+	}
+	return 0
+}
+
+func (x *RecommendBidResponse) GetHistorySource() string {
+	if x != nil {
+		return x.HistorySource
+	}
+	return ""
+}
+
+func (x *RecommendBidResponse) GetTimeLeft() string {
+	if x != nil {
+		return x.TimeLeft
+	}
+	return ""
+}
+
+func (x *RecommendBidResponse) GetTimeLeftShare() float64 {
+	if x != nil {
+		return x.TimeLeftShare
+	}
+	return 0
+}
+
+func (x *RecommendBidResponse) GetAuctionFinished() bool {
+	if x != nil {
+		return x.AuctionFinished
+	}
+	return false
+}
+
+func (x *RecommendBidResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 var File_seller_proto protoreflect.FileDescriptor
 
 const file_seller_proto_rawDesc = "" +
@@ -1274,7 +1933,7 @@ const file_seller_proto_rawDesc = "" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
 	"\bper_page\x18\x04 \x01(\x05R\aperPage\"7\n" +
 	"\x18GetActionSegmentsRequest\x12\x1b\n" +
-	"\taction_id\x18\x01 \x01(\x03R\bactionId\"\xb3\x01\n" +
+	"\taction_id\x18\x01 \x01(\x03R\bactionId\"\x8b\x02\n" +
 	"\rActionSegment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -1284,7 +1943,9 @@ const file_seller_proto_rawDesc = "" +
 	"population\x12!\n" +
 	"\fbooked_slots\x18\x05 \x01(\x03R\vbookedSlots\x12\x1f\n" +
 	"\vtotal_slots\x18\x06 \x01(\x03R\n" +
-	"totalSlots\"g\n" +
+	"totalSlots\x12)\n" +
+	"\x10population_share\x18\a \x01(\x01R\x0fpopulationShare\x12+\n" +
+	"\x11population_source\x18\b \x01(\tR\x10populationSource\"g\n" +
 	"\x19GetActionSegmentsResponse\x12J\n" +
 	"\x0faction_segments\x18\x01 \x03(\v2!.wildberries.seller.ActionSegmentR\x0eactionSegments\"6\n" +
 	"\x17GetSellerActionsRequest\x12\x1b\n" +
@@ -1300,14 +1961,64 @@ const file_seller_proto_rawDesc = "" +
 	"\x18GetSellerActionsResponse\x12A\n" +
 	"\aactions\x18\x01 \x03(\v2'.wildberries.seller.SellerActionSummaryR\aactions\"9\n" +
 	"\x1aGetSellerStatisticsRequest\x12\x1b\n" +
-	"\tseller_id\x18\x01 \x01(\x03R\bsellerId\"\xb1\x01\n" +
+	"\tseller_id\x18\x01 \x01(\x03R\bsellerId\"\xb5\x04\n" +
 	"\x1bGetSellerStatisticsResponse\x12+\n" +
 	"\x11active_promotions\x18\x01 \x01(\x03R\x10activePromotions\x12\x1d\n" +
 	"\n" +
 	"free_slots\x18\x02 \x01(\x03R\tfreeSlots\x12%\n" +
 	"\x0eoccupied_slots\x18\x03 \x01(\x03R\roccupiedSlots\x12\x1f\n" +
 	"\vtotal_views\x18\x04 \x01(\x03R\n" +
-	"totalViews\"B\n" +
+	"totalViews\x12)\n" +
+	"\x10moderation_slots\x18\x05 \x01(\x03R\x0fmoderationSlots\x12)\n" +
+	"\x10committed_amount\x18\x06 \x01(\x03R\x0fcommittedAmount\x12\x1f\n" +
+	"\vactive_bets\x18\a \x01(\x03R\n" +
+	"activeBets\x12,\n" +
+	"\x12active_bets_amount\x18\b \x01(\x03R\x10activeBetsAmount\x12\x19\n" +
+	"\bwin_rate\x18\t \x01(\x01R\awinRate\x12/\n" +
+	"\x13product_impressions\x18\n" +
+	" \x01(\x03R\x12productImpressions\x12%\n" +
+	"\x0eproduct_clicks\x18\v \x01(\x03R\rproductClicks\x12M\n" +
+	"\n" +
+	"promotions\x18\f \x03(\v2-.wildberries.seller.SellerPromotionStatisticsR\n" +
+	"promotions\x12\x1b\n" +
+	"\twon_slots\x18\r \x01(\x03R\bwonSlots\"\xc5\x03\n" +
+	"\x19SellerPromotionStatistics\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12%\n" +
+	"\x0epromotion_name\x18\x02 \x01(\tR\rpromotionName\x12)\n" +
+	"\x10promotion_status\x18\x03 \x01(\tR\x0fpromotionStatus\x12\x1b\n" +
+	"\twon_slots\x18\x04 \x01(\x03R\bwonSlots\x12)\n" +
+	"\x10moderation_slots\x18\x05 \x01(\x03R\x0fmoderationSlots\x12)\n" +
+	"\x10committed_amount\x18\x06 \x01(\x03R\x0fcommittedAmount\x12\x1f\n" +
+	"\vactive_bets\x18\a \x01(\x03R\n" +
+	"activeBets\x12,\n" +
+	"\x12active_bets_amount\x18\b \x01(\x03R\x10activeBetsAmount\x12\x19\n" +
+	"\bwin_rate\x18\t \x01(\x01R\awinRate\x12/\n" +
+	"\x13product_impressions\x18\n" +
+	" \x01(\x03R\x12productImpressions\x12%\n" +
+	"\x0eproduct_clicks\x18\v \x01(\x03R\rproductClicks\"[\n" +
+	"\x19GetSlotPerformanceRequest\x12\x1b\n" +
+	"\tseller_id\x18\x01 \x01(\x03R\bsellerId\x12!\n" +
+	"\fpromotion_id\x18\x02 \x01(\x03R\vpromotionId\"\xbf\x03\n" +
+	"\x0fSlotPerformance\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12%\n" +
+	"\x0epromotion_name\x18\x02 \x01(\tR\rpromotionName\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x03 \x01(\x03R\tsegmentId\x12!\n" +
+	"\fsegment_name\x18\x04 \x01(\tR\vsegmentName\x12\x17\n" +
+	"\aslot_id\x18\x05 \x01(\x03R\x06slotId\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12!\n" +
+	"\fpricing_type\x18\a \x01(\tR\vpricingType\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\b \x01(\x03R\tproductId\x12!\n" +
+	"\fproduct_name\x18\t \x01(\tR\vproductName\x12\x14\n" +
+	"\x05price\x18\n" +
+	" \x01(\x03R\x05price\x12 \n" +
+	"\vimpressions\x18\v \x01(\x03R\vimpressions\x12\x16\n" +
+	"\x06clicks\x18\f \x01(\x03R\x06clicks\x12\x10\n" +
+	"\x03ctr\x18\r \x01(\x01R\x03ctr\x12$\n" +
+	"\x0ecost_per_click\x18\x0e \x01(\x01R\fcostPerClick\"W\n" +
+	"\x1aGetSlotPerformanceResponse\x129\n" +
+	"\x05items\x18\x01 \x03(\v2#.wildberries.seller.SlotPerformanceR\x05items\"B\n" +
 	"\x1dIncrementPromotionViewRequest\x12!\n" +
 	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\":\n" +
 	"\x1eIncrementPromotionViewResponse\x12\x18\n" +
@@ -1342,27 +2053,50 @@ const file_seller_proto_rawDesc = "" +
 	"\aslot_id\x18\x01 \x01(\x03R\x06slotId\x12\x1b\n" +
 	"\tseller_id\x18\x02 \x01(\x03R\bsellerId\"-\n" +
 	"\x11RemoveBetResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xe7\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"s\n" +
+	"\x13RecommendBidRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x03R\vpromotionId\x12\x1d\n" +
+	"\n" +
+	"segment_id\x18\x02 \x01(\x03R\tsegmentId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"\x86\x04\n" +
+	"\x14RecommendBidResponse\x12'\n" +
+	"\x0frecommended_bid\x18\x01 \x01(\x03R\x0erecommendedBid\x12&\n" +
+	"\x0fmin_allowed_bid\x18\x02 \x01(\x03R\rminAllowedBid\x12!\n" +
+	"\fposition_bid\x18\x03 \x01(\x03R\vpositionBid\x12\x19\n" +
+	"\bbid_step\x18\x04 \x01(\x03R\abidStep\x12)\n" +
+	"\x10competing_offers\x18\x05 \x01(\x05R\x0fcompetingOffers\x12+\n" +
+	"\x11historical_median\x18\x06 \x01(\x03R\x10historicalMedian\x12%\n" +
+	"\x0ehistorical_max\x18\a \x01(\x03R\rhistoricalMax\x12-\n" +
+	"\x12historical_samples\x18\b \x01(\x03R\x11historicalSamples\x12%\n" +
+	"\x0ehistory_source\x18\t \x01(\tR\rhistorySource\x12\x1b\n" +
+	"\ttime_left\x18\n" +
+	" \x01(\tR\btimeLeft\x12&\n" +
+	"\x0ftime_left_share\x18\v \x01(\x01R\rtimeLeftShare\x12)\n" +
+	"\x10auction_finished\x18\f \x01(\bR\x0fauctionFinished\x12\x1a\n" +
+	"\bposition\x18\r \x01(\x05R\bposition2\xe7\x02\n" +
 	"\x14SellerProductService\x12\xce\x02\n" +
 	"\x0eListProductsBy\x12).wildberries.seller.ListProductsByRequest\x1a*.wildberries.seller.ListProductsByResponse\"\xe4\x01\x92A\xc7\x01\n" +
-	"\bProducts\x12?Получить список продуктов селлера\x1ajПолучает список продуктов селлера по заданным параметрам*\x0eListProductsBy\x82\xd3\xe4\x93\x02\x13\x12\x11/products/list-by2\xaf\n" +
-	"\n" +
+	"\bProducts\x12?Получить список продуктов селлера\x1ajПолучает список продуктов селлера по заданным параметрам*\x0eListProductsBy\x82\xd3\xe4\x93\x02\x13\x12\x11/products/list-by2\xb0\r\n" +
 	"\x14SellerActionsService\x12\xbf\x02\n" +
 	"\x10GetSellerActions\x12+.wildberries.seller.GetSellerActionsRequest\x1a,.wildberries.seller.GetSellerActionsResponse\"\xcf\x01\x92A\xb4\x01\n" +
 	"\aActions\x12DПолучить доступные акции для селлера\x1aQПолучает список доступных акций для селлера*\x10GetSellerActions\x82\xd3\xe4\x93\x02\x11\x12\x0f/seller/actions\x12\xf6\x01\n" +
 	"\x11GetActionSegments\x12,.wildberries.seller.GetActionSegmentsRequest\x1a-.wildberries.seller.GetActionSegmentsResponse\"\x83\x01\x92A]\n" +
 	"\aActions\x12<Получить инфу по сегментам акции\x1a\x01-*\x11GetActionSegments\x82\xd3\xe4\x93\x02\x1d\x12\x1b/seller/actions/{action_id}\x12\xd1\x02\n" +
 	"\x13GetSellerStatistics\x12..wildberries.seller.GetSellerStatisticsRequest\x1a/.wildberries.seller.GetSellerStatisticsResponse\"\xd8\x01\x92A\xba\x01\n" +
-	"\aActions\x12;Получить статистику для селлера\x1a]Получает статистику по акциям, слотам и просмотрам*\x13GetSellerStatistics\x82\xd3\xe4\x93\x02\x14\x12\x12/seller/statistics\x12\x87\x03\n" +
+	"\aActions\x12;Получить статистику для селлера\x1a]Получает статистику по акциям, слотам и просмотрам*\x13GetSellerStatistics\x82\xd3\xe4\x93\x02\x14\x12\x12/seller/statistics\x12\xfe\x02\n" +
+	"\x12GetSlotPerformance\x12-.wildberries.seller.GetSlotPerformanceRequest\x1a..wildberries.seller.GetSlotPerformanceResponse\"\x88\x02\x92A\xe3\x01\n" +
+	"\aActions\x12GПолучить эффективность слотов селлера\x1a{Показы, клики, CTR и стоимость клика товаров селлера в занятых слотах*\x12GetSlotPerformance\x82\xd3\xe4\x93\x02\x1b\x12\x19/seller/slots/performance\x12\x87\x03\n" +
 	"\x16IncrementPromotionView\x121.wildberries.seller.IncrementPromotionViewRequest\x1a2.wildberries.seller.IncrementPromotionViewResponse\"\x85\x02\x92A\xc6\x01\n" +
-	"\aActions\x12AУвеличить счётчик просмотров акции\x1a`Увеличивает счётчик просмотров при переходе в акцию*\x16IncrementPromotionView\x82\xd3\xe4\x93\x025:\x01*\"0/seller/promotions/{promotion_id}/increment-view2\x9c\x06\n" +
+	"\aActions\x12AУвеличить счётчик просмотров акции\x1a`Увеличивает счётчик просмотров при переходе в акцию*\x16IncrementPromotionView\x82\xd3\xe4\x93\x025:\x01*\"0/seller/promotions/{promotion_id}/increment-view2\xc3\t\n" +
 	"\x11SellerBetsService\x12\xca\x02\n" +
 	"\x11GetSellerBetsList\x12,.wildberries.seller.GetSellerBetsListRequest\x1a-.wildberries.seller.GetSellerBetsListResponse\"\xd7\x01\x92A\xba\x01\n" +
 	"\x04Bets\x129Получить список ставок селлера\x1adПолучает список ставок селлера по заданным параметрам*\x11GetSellerBetsList\x82\xd3\xe4\x93\x02\x13\x12\x11/seller/bets/list\x12\xd6\x01\n" +
 	"\aMakeBet\x12\".wildberries.seller.MakeBetRequest\x1a#.wildberries.seller.MakeBetResponse\"\x81\x01\x92Ab\n" +
 	"\x04Bets\x12\x1bСделать ставку\x1a4Создает новую ставку на слот*\aMakeBet\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/seller/bets/make\x12\xe0\x01\n" +
 	"\tRemoveBet\x12$.wildberries.seller.RemoveBetRequest\x1a%.wildberries.seller.RemoveBetResponse\"\x85\x01\x92Ad\n" +
-	"\x04Bets\x12\x1bУдалить ставку\x1a4Удаляет существующую ставку*\tRemoveBet\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/seller/bets/removeB\xbb\x01\x92A\x98\x01\x12_\n" +
+	"\x04Bets\x12\x1bУдалить ставку\x1a4Удаляет существующую ставку*\tRemoveBet\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/seller/bets/remove\x12\xa4\x03\n" +
+	"\fRecommendBid\x12'.wildberries.seller.RecommendBidRequest\x1a(.wildberries.seller.RecommendBidResponse\"\xc0\x02\x92A\x9e\x02\n" +
+	"\x04Bets\x12'Рекомендовать ставку\x1a\xde\x01Оценивает ставку для позиции в сегменте по текущим ставкам, шагу, оставшемуся времени и ценам закрытия прошлых аукционов*\fRecommendBid\x82\xd3\xe4\x93\x02\x18\x12\x16/seller/bets/recommendB\xbb\x01\x92A\x98\x01\x12_\n" +
 	"\x13Seller сервис\x12AСервис селлера для работы с акциями2\x051.0.0\x1a\x0elocalhost:8080*\x01\x012\x10application/json:\x10application/jsonZ\x1dwildberries/pkg/seller;sellerb\x06proto3"
 
 var (
@@ -1377,7 +2111,7 @@ func file_seller_proto_rawDescGZIP() []byte {
 	return file_seller_proto_rawDescData
 }
 
-var file_seller_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_seller_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_seller_proto_goTypes = []any{
 	(*ListProductsByRequest)(nil),          // 0: wildberries.seller.ListProductsByRequest
 	(*ProductListItem)(nil),                // 1: wildberries.seller.ProductListItem
@@ -1390,42 +2124,54 @@ var file_seller_proto_goTypes = []any{
 	(*GetSellerActionsResponse)(nil),       // 8: wildberries.seller.GetSellerActionsResponse
 	(*GetSellerStatisticsRequest)(nil),     // 9: wildberries.seller.GetSellerStatisticsRequest
 	(*GetSellerStatisticsResponse)(nil),    // 10: wildberries.seller.GetSellerStatisticsResponse
-	(*IncrementPromotionViewRequest)(nil),  // 11: wildberries.seller.IncrementPromotionViewRequest
-	(*IncrementPromotionViewResponse)(nil), // 12: wildberries.seller.IncrementPromotionViewResponse
-	(*GetSellerBetsListRequest)(nil),       // 13: wildberries.seller.GetSellerBetsListRequest
-	(*SellerBetItem)(nil),                  // 14: wildberries.seller.SellerBetItem
-	(*GetSellerBetsListResponse)(nil),      // 15: wildberries.seller.GetSellerBetsListResponse
-	(*MakeBetRequest)(nil),                 // 16: wildberries.seller.MakeBetRequest
-	(*MakeBetResponse)(nil),                // 17: wildberries.seller.MakeBetResponse
-	(*RemoveBetRequest)(nil),               // 18: wildberries.seller.RemoveBetRequest
-	(*RemoveBetResponse)(nil),              // 19: wildberries.seller.RemoveBetResponse
+	(*SellerPromotionStatistics)(nil),      // 11: wildberries.seller.SellerPromotionStatistics
+	(*GetSlotPerformanceRequest)(nil),      // 12: wildberries.seller.GetSlotPerformanceRequest
+	(*SlotPerformance)(nil),                // 13: wildberries.seller.SlotPerformance
+	(*GetSlotPerformanceResponse)(nil),     // 14: wildberries.seller.GetSlotPerformanceResponse
+	(*IncrementPromotionViewRequest)(nil),  // 15: wildberries.seller.IncrementPromotionViewRequest
+	(*IncrementPromotionViewResponse)(nil), // 16: wildberries.seller.IncrementPromotionViewResponse
+	(*GetSellerBetsListRequest)(nil),       // 17: wildberries.seller.GetSellerBetsListRequest
+	(*SellerBetItem)(nil),                  // 18: wildberries.seller.SellerBetItem
+	(*GetSellerBetsListResponse)(nil),      // 19: wildberries.seller.GetSellerBetsListResponse
+	(*MakeBetRequest)(nil),                 // 20: wildberries.seller.MakeBetRequest
+	(*MakeBetResponse)(nil),                // 21: wildberries.seller.MakeBetResponse
+	(*RemoveBetRequest)(nil),               // 22: wildberries.seller.RemoveBetRequest
+	(*RemoveBetResponse)(nil),              // 23: wildberries.seller.RemoveBetResponse
+	(*RecommendBidRequest)(nil),            // 24: wildberries.seller.RecommendBidRequest
+	(*RecommendBidResponse)(nil),           // 25: wildberries.seller.RecommendBidResponse
 }
 var file_seller_proto_depIdxs = []int32{
 	1,  // 0: wildberries.seller.ListProductsByResponse.items:type_name -> wildberries.seller.ProductListItem
 	4,  // 1: wildberries.seller.GetActionSegmentsResponse.action_segments:type_name -> wildberries.seller.ActionSegment
 	7,  // 2: wildberries.seller.GetSellerActionsResponse.actions:type_name -> wildberries.seller.SellerActionSummary
-	14, // 3: wildberries.seller.GetSellerBetsListResponse.items:type_name -> wildberries.seller.SellerBetItem
-	0,  // 4: wildberries.seller.SellerProductService.ListProductsBy:input_type -> wildberries.seller.ListProductsByRequest
-	6,  // 5: wildberries.seller.SellerActionsService.GetSellerActions:input_type -> wildberries.seller.GetSellerActionsRequest
-	3,  // 6: wildberries.seller.SellerActionsService.GetActionSegments:input_type -> wildberries.seller.GetActionSegmentsRequest
-	9,  // 7: wildberries.seller.SellerActionsService.GetSellerStatistics:input_type -> wildberries.seller.GetSellerStatisticsRequest
-	11, // 8: wildberries.seller.SellerActionsService.IncrementPromotionView:input_type -> wildberries.seller.IncrementPromotionViewRequest
-	13, // 9: wildberries.seller.SellerBetsService.GetSellerBetsList:input_type -> wildberries.seller.GetSellerBetsListRequest
-	16, // 10: wildberries.seller.SellerBetsService.MakeBet:input_type -> wildberries.seller.MakeBetRequest
-	18, // 11: wildberries.seller.SellerBetsService.RemoveBet:input_type -> wildberries.seller.RemoveBetRequest
-	2,  // 12: wildberries.seller.SellerProductService.ListProductsBy:output_type -> wildberries.seller.ListProductsByResponse
-	8,  // 13: wildberries.seller.SellerActionsService.GetSellerActions:output_type -> wildberries.seller.GetSellerActionsResponse
-	5,  // 14: wildberries.seller.SellerActionsService.GetActionSegments:output_type -> wildberries.seller.GetActionSegmentsResponse
-	10, // 15: wildberries.seller.SellerActionsService.GetSellerStatistics:output_type -> wildberries.seller.GetSellerStatisticsResponse
-	12, // 16: wildberries.seller.SellerActionsService.IncrementPromotionView:output_type -> wildberries.seller.IncrementPromotionViewResponse
-	15, // 17: wildberries.seller.SellerBetsService.GetSellerBetsList:output_type -> wildberries.seller.GetSellerBetsListResponse
-	17, // 18: wildberries.seller.SellerBetsService.MakeBet:output_type -> wildberries.seller.MakeBetResponse
-	19, // 19: wildberries.seller.SellerBetsService.RemoveBet:output_type -> wildberries.seller.RemoveBetResponse
-	12, // [12:20] is the sub-list for method output_type
-	4,  // [4:12] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	11, // 3: wildberries.seller.GetSellerStatisticsResponse.promotions:type_name -> wildberries.seller.SellerPromotionStatistics
+	13, // 4: wildberries.seller.GetSlotPerformanceResponse.items:type_name -> wildberries.seller.SlotPerformance
+	18, // 5: wildberries.seller.GetSellerBetsListResponse.items:type_name -> wildberries.seller.SellerBetItem
+	0,  // 6: wildberries.seller.SellerProductService.ListProductsBy:input_type -> wildberries.seller.ListProductsByRequest
+	6,  // 7: wildberries.seller.SellerActionsService.GetSellerActions:input_type -> wildberries.seller.GetSellerActionsRequest
+	3,  // 8: wildberries.seller.SellerActionsService.GetActionSegments:input_type -> wildberries.seller.GetActionSegmentsRequest
+	9,  // 9: wildberries.seller.SellerActionsService.GetSellerStatistics:input_type -> wildberries.seller.GetSellerStatisticsRequest
+	12, // 10: wildberries.seller.SellerActionsService.GetSlotPerformance:input_type -> wildberries.seller.GetSlotPerformanceRequest
+	15, // 11: wildberries.seller.SellerActionsService.IncrementPromotionView:input_type -> wildberries.seller.IncrementPromotionViewRequest
+	17, // 12: wildberries.seller.SellerBetsService.GetSellerBetsList:input_type -> wildberries.seller.GetSellerBetsListRequest
+	20, // 13: wildberries.seller.SellerBetsService.MakeBet:input_type -> wildberries.seller.MakeBetRequest
+	22, // 14: wildberries.seller.SellerBetsService.RemoveBet:input_type -> wildberries.seller.RemoveBetRequest
+	24, // 15: wildberries.seller.SellerBetsService.RecommendBid:input_type -> wildberries.seller.RecommendBidRequest
+	2,  // 16: wildberries.seller.SellerProductService.ListProductsBy:output_type -> wildberries.seller.ListProductsByResponse
+	8,  // 17: wildberries.seller.SellerActionsService.GetSellerActions:output_type -> wildberries.seller.GetSellerActionsResponse
+	5,  // 18: wildberries.seller.SellerActionsService.GetActionSegments:output_type -> wildberries.seller.GetActionSegmentsResponse
+	10, // 19: wildberries.seller.SellerActionsService.GetSellerStatistics:output_type -> wildberries.seller.GetSellerStatisticsResponse
+	14, // 20: wildberries.seller.SellerActionsService.GetSlotPerformance:output_type -> wildberries.seller.GetSlotPerformanceResponse
+	16, // 21: wildberries.seller.SellerActionsService.IncrementPromotionView:output_type -> wildberries.seller.IncrementPromotionViewResponse
+	19, // 22: wildberries.seller.SellerBetsService.GetSellerBetsList:output_type -> wildberries.seller.GetSellerBetsListResponse
+	21, // 23: wildberries.seller.SellerBetsService.MakeBet:output_type -> wildberries.seller.MakeBetResponse
+	23, // 24: wildberries.seller.SellerBetsService.RemoveBet:output_type -> wildberries.seller.RemoveBetResponse
+	25, // 25: wildberries.seller.SellerBetsService.RecommendBid:output_type -> wildberries.seller.RecommendBidResponse
+	16, // [16:26] is the sub-list for method output_type
+	6,  // [6:16] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_seller_proto_init() }
@@ -1440,7 +2186,7 @@ func file_seller_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_seller_proto_rawDesc), len(file_seller_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -179,6 +179,41 @@ func local_request_SellerActionsService_GetSellerStatistics_0(ctx context.Contex
 	return msg, metadata, err
 }
 
+var filter_SellerActionsService_GetSlotPerformance_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_SellerActionsService_GetSlotPerformance_0(ctx context.Context, marshaler runtime.Marshaler, client SellerActionsServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetSlotPerformanceRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_SellerActionsService_GetSlotPerformance_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.GetSlotPerformance(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_SellerActionsService_GetSlotPerformance_0(ctx context.Context, marshaler runtime.Marshaler, server SellerActionsServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq GetSlotPerformanceRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_SellerActionsService_GetSlotPerformance_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.GetSlotPerformance(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_SellerActionsService_IncrementPromotionView_0(ctx context.Context, marshaler runtime.Marshaler, client SellerActionsServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq IncrementPromotionViewRequest
@@ -313,6 +348,41 @@ func local_request_SellerBetsService_RemoveBet_0(ctx context.Context, marshaler
 	return msg, metadata, err
 }
 
+var filter_SellerBetsService_RecommendBid_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_SellerBetsService_RecommendBid_0(ctx context.Context, marshaler runtime.Marshaler, client SellerBetsServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq RecommendBidRequest
+		metadata runtime.ServerMetadata
+	)
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+	}
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_SellerBetsService_RecommendBid_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.RecommendBid(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_SellerBetsService_RecommendBid_0(ctx context.Context, marshaler runtime.Marshaler, server SellerBetsServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq RecommendBidRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_SellerBetsService_RecommendBid_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.RecommendBid(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterSellerProductServiceHandlerServer registers the http handlers for service SellerProductService to "mux".
 // UnaryRPC     :call SellerProductServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -409,6 +479,26 @@ func RegisterSellerActionsServiceHandlerServer(ctx context.Context, mux *runtime
 		}
 		forward_SellerActionsService_GetSellerStatistics_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_SellerActionsService_GetSlotPerformance_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/wildberries.seller.SellerActionsService/GetSlotPerformance", runtime.WithHTTPPathPattern("/seller/slots/performance"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_SellerActionsService_GetSlotPerformance_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_SellerActionsService_GetSlotPerformance_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_SellerActionsService_IncrementPromotionView_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -499,6 +589,26 @@ func RegisterSellerBetsServiceHandlerServer(ctx context.Context, mux *runtime.Se
 		}
 		forward_SellerBetsService_RemoveBet_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_SellerBetsService_RecommendBid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/wildberries.seller.SellerBetsService/RecommendBid", runtime.WithHTTPPathPattern("/seller/bets/recommend"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_SellerBetsService_RecommendBid_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_SellerBetsService_RecommendBid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 
 	return nil
 }
@@ -654,6 +764,23 @@ func RegisterSellerActionsServiceHandlerClient(ctx context.Context, mux *runtime
 		}
 		forward_SellerActionsService_GetSellerStatistics_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_SellerActionsService_GetSlotPerformance_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/wildberries.seller.SellerActionsService/GetSlotPerformance", runtime.WithHTTPPathPattern("/seller/slots/performance"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_SellerActionsService_GetSlotPerformance_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_SellerActionsService_GetSlotPerformance_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodPost, pattern_SellerActionsService_IncrementPromotionView_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -678,6 +805,7 @@ var (
 	pattern_SellerActionsService_GetSellerActions_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"seller", "actions"}, ""))
 	pattern_SellerActionsService_GetActionSegments_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2}, []string{"seller", "actions", "action_id"}, ""))
 	pattern_SellerActionsService_GetSellerStatistics_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"seller", "statistics"}, ""))
+	pattern_SellerActionsService_GetSlotPerformance_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"seller", "slots", "performance"}, ""))
 	pattern_SellerActionsService_IncrementPromotionView_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3}, []string{"seller", "promotions", "promotion_id", "increment-view"}, ""))
 )
 
@@ -685,6 +813,7 @@ var (
 	forward_SellerActionsService_GetSellerActions_0       = runtime.ForwardResponseMessage
 	forward_SellerActionsService_GetActionSegments_0      = runtime.ForwardResponseMessage
 	forward_SellerActionsService_GetSellerStatistics_0    = runtime.ForwardResponseMessage
+	forward_SellerActionsService_GetSlotPerformance_0     = runtime.ForwardResponseMessage
 	forward_SellerActionsService_IncrementPromotionView_0 = runtime.ForwardResponseMessage
 )
 
@@ -775,6 +904,23 @@ func RegisterSellerBetsServiceHandlerClient(ctx context.Context, mux *runtime.Se
 		}
 		forward_SellerBetsService_RemoveBet_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_SellerBetsService_RecommendBid_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/wildberries.seller.SellerBetsService/RecommendBid", runtime.WithHTTPPathPattern("/seller/bets/recommend"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_SellerBetsService_RecommendBid_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_SellerBetsService_RecommendBid_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	return nil
 }
 
@@ -782,10 +928,12 @@ var (
 	pattern_SellerBetsService_GetSellerBetsList_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"seller", "bets", "list"}, ""))
 	pattern_SellerBetsService_MakeBet_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"seller", "bets", "make"}, ""))
 	pattern_SellerBetsService_RemoveBet_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"seller", "bets", "remove"}, ""))
+	pattern_SellerBetsService_RecommendBid_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"seller", "bets", "recommend"}, ""))
 )
 
 var (
 	forward_SellerBetsService_GetSellerBetsList_0 = runtime.ForwardResponseMessage
 	forward_SellerBetsService_MakeBet_0           = runtime.ForwardResponseMessage
 	forward_SellerBetsService_RemoveBet_0         = runtime.ForwardResponseMessage
+	forward_SellerBetsService_RecommendBid_0      = runtime.ForwardResponseMessage
 )
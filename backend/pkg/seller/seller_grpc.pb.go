@@ -128,6 +128,7 @@ const (
 	SellerActionsService_GetSellerActions_FullMethodName       = "/wildberries.seller.SellerActionsService/GetSellerActions"
 	SellerActionsService_GetActionSegments_FullMethodName      = "/wildberries.seller.SellerActionsService/GetActionSegments"
 	SellerActionsService_GetSellerStatistics_FullMethodName    = "/wildberries.seller.SellerActionsService/GetSellerStatistics"
+	SellerActionsService_GetSlotPerformance_FullMethodName     = "/wildberries.seller.SellerActionsService/GetSlotPerformance"
 	SellerActionsService_IncrementPromotionView_FullMethodName = "/wildberries.seller.SellerActionsService/IncrementPromotionView"
 )
 
@@ -138,6 +139,7 @@ type SellerActionsServiceClient interface {
 	GetSellerActions(ctx context.Context, in *GetSellerActionsRequest, opts ...grpc.CallOption) (*GetSellerActionsResponse, error)
 	GetActionSegments(ctx context.Context, in *GetActionSegmentsRequest, opts ...grpc.CallOption) (*GetActionSegmentsResponse, error)
 	GetSellerStatistics(ctx context.Context, in *GetSellerStatisticsRequest, opts ...grpc.CallOption) (*GetSellerStatisticsResponse, error)
+	GetSlotPerformance(ctx context.Context, in *GetSlotPerformanceRequest, opts ...grpc.CallOption) (*GetSlotPerformanceResponse, error)
 	IncrementPromotionView(ctx context.Context, in *IncrementPromotionViewRequest, opts ...grpc.CallOption) (*IncrementPromotionViewResponse, error)
 }
 
@@ -179,6 +181,16 @@ func (c *sellerActionsServiceClient) GetSellerStatistics(ctx context.Context, in
 	return out, nil
 }
 
+func (c *sellerActionsServiceClient) GetSlotPerformance(ctx context.Context, in *GetSlotPerformanceRequest, opts ...grpc.CallOption) (*GetSlotPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSlotPerformanceResponse)
+	err := c.cc.Invoke(ctx, SellerActionsService_GetSlotPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sellerActionsServiceClient) IncrementPromotionView(ctx context.Context, in *IncrementPromotionViewRequest, opts ...grpc.CallOption) (*IncrementPromotionViewResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IncrementPromotionViewResponse)
@@ -196,6 +208,7 @@ type SellerActionsServiceServer interface {
 	GetSellerActions(context.Context, *GetSellerActionsRequest) (*GetSellerActionsResponse, error)
 	GetActionSegments(context.Context, *GetActionSegmentsRequest) (*GetActionSegmentsResponse, error)
 	GetSellerStatistics(context.Context, *GetSellerStatisticsRequest) (*GetSellerStatisticsResponse, error)
+	GetSlotPerformance(context.Context, *GetSlotPerformanceRequest) (*GetSlotPerformanceResponse, error)
 	IncrementPromotionView(context.Context, *IncrementPromotionViewRequest) (*IncrementPromotionViewResponse, error)
 	mustEmbedUnimplementedSellerActionsServiceServer()
 }
@@ -216,6 +229,9 @@ func (UnimplementedSellerActionsServiceServer) GetActionSegments(context.Context
 func (UnimplementedSellerActionsServiceServer) GetSellerStatistics(context.Context, *GetSellerStatisticsRequest) (*GetSellerStatisticsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSellerStatistics not implemented")
 }
+func (UnimplementedSellerActionsServiceServer) GetSlotPerformance(context.Context, *GetSlotPerformanceRequest) (*GetSlotPerformanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSlotPerformance not implemented")
+}
 func (UnimplementedSellerActionsServiceServer) IncrementPromotionView(context.Context, *IncrementPromotionViewRequest) (*IncrementPromotionViewResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method IncrementPromotionView not implemented")
 }
@@ -294,6 +310,24 @@ func _SellerActionsService_GetSellerStatistics_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SellerActionsService_GetSlotPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSlotPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SellerActionsServiceServer).GetSlotPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SellerActionsService_GetSlotPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SellerActionsServiceServer).GetSlotPerformance(ctx, req.(*GetSlotPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SellerActionsService_IncrementPromotionView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IncrementPromotionViewRequest)
 	if err := dec(in); err != nil {
@@ -331,6 +365,10 @@ var SellerActionsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSellerStatistics",
 			Handler:    _SellerActionsService_GetSellerStatistics_Handler,
 		},
+		{
+			MethodName: "GetSlotPerformance",
+			Handler:    _SellerActionsService_GetSlotPerformance_Handler,
+		},
 		{
 			MethodName: "IncrementPromotionView",
 			Handler:    _SellerActionsService_IncrementPromotionView_Handler,
@@ -344,6 +382,7 @@ const (
 	SellerBetsService_GetSellerBetsList_FullMethodName = "/wildberries.seller.SellerBetsService/GetSellerBetsList"
 	SellerBetsService_MakeBet_FullMethodName           = "/wildberries.seller.SellerBetsService/MakeBet"
 	SellerBetsService_RemoveBet_FullMethodName         = "/wildberries.seller.SellerBetsService/RemoveBet"
+	SellerBetsService_RecommendBid_FullMethodName      = "/wildberries.seller.SellerBetsService/RecommendBid"
 )
 
 // SellerBetsServiceClient is the client API for SellerBetsService service.
@@ -353,6 +392,7 @@ type SellerBetsServiceClient interface {
 	GetSellerBetsList(ctx context.Context, in *GetSellerBetsListRequest, opts ...grpc.CallOption) (*GetSellerBetsListResponse, error)
 	MakeBet(ctx context.Context, in *MakeBetRequest, opts ...grpc.CallOption) (*MakeBetResponse, error)
 	RemoveBet(ctx context.Context, in *RemoveBetRequest, opts ...grpc.CallOption) (*RemoveBetResponse, error)
+	RecommendBid(ctx context.Context, in *RecommendBidRequest, opts ...grpc.CallOption) (*RecommendBidResponse, error)
 }
 
 type sellerBetsServiceClient struct {
@@ -393,6 +433,16 @@ func (c *sellerBetsServiceClient) RemoveBet(ctx context.Context, in *RemoveBetRe
 	return out, nil
 }
 
+func (c *sellerBetsServiceClient) RecommendBid(ctx context.Context, in *RecommendBidRequest, opts ...grpc.CallOption) (*RecommendBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendBidResponse)
+	err := c.cc.Invoke(ctx, SellerBetsService_RecommendBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SellerBetsServiceServer is the server API for SellerBetsService service.
 // All implementations must embed UnimplementedSellerBetsServiceServer
 // for forward compatibility.
@@ -400,6 +450,7 @@ type SellerBetsServiceServer interface {
 	GetSellerBetsList(context.Context, *GetSellerBetsListRequest) (*GetSellerBetsListResponse, error)
 	MakeBet(context.Context, *MakeBetRequest) (*MakeBetResponse, error)
 	RemoveBet(context.Context, *RemoveBetRequest) (*RemoveBetResponse, error)
+	RecommendBid(context.Context, *RecommendBidRequest) (*RecommendBidResponse, error)
 	mustEmbedUnimplementedSellerBetsServiceServer()
 }
 
@@ -419,6 +470,9 @@ func (UnimplementedSellerBetsServiceServer) MakeBet(context.Context, *MakeBetReq
 func (UnimplementedSellerBetsServiceServer) RemoveBet(context.Context, *RemoveBetRequest) (*RemoveBetResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveBet not implemented")
 }
+func (UnimplementedSellerBetsServiceServer) RecommendBid(context.Context, *RecommendBidRequest) (*RecommendBidResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecommendBid not implemented")
+}
 func (UnimplementedSellerBetsServiceServer) mustEmbedUnimplementedSellerBetsServiceServer() {}
 func (UnimplementedSellerBetsServiceServer) testEmbeddedByValue()                           {}
 
@@ -494,6 +548,24 @@ func _SellerBetsService_RemoveBet_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SellerBetsService_RecommendBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SellerBetsServiceServer).RecommendBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SellerBetsService_RecommendBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SellerBetsServiceServer).RecommendBid(ctx, req.(*RecommendBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SellerBetsService_ServiceDesc is the grpc.ServiceDesc for SellerBetsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -513,6 +585,10 @@ var SellerBetsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveBet",
 			Handler:    _SellerBetsService_RemoveBet_Handler,
 		},
+		{
+			MethodName: "RecommendBid",
+			Handler:    _SellerBetsService_RecommendBid_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "seller.proto",